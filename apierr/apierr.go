@@ -0,0 +1,15 @@
+// Package apierr holds what the REST and GraphQL APIs share about errors: the
+// codes returned in a REST ErrorResponse and in GraphQL extensions.code, and
+// the request IDs that correlate error responses with server logs.
+package apierr
+
+// Code classifies an API error for clients
+type Code string
+
+const (
+	NotFound      Code = "NOT_FOUND"
+	Forbidden     Code = "FORBIDDEN"
+	InvalidInput  Code = "INVALID_INPUT"
+	RateLimited   Code = "RATE_LIMITED"
+	InternalError Code = "INTERNAL_ERROR"
+)
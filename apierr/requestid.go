@@ -0,0 +1,62 @@
+package apierr
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the request ID on requests and responses
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds caller-supplied request IDs, which end up in logs
+const maxRequestIDLength = 64
+
+type requestIDKey struct{}
+
+// RequestIDMiddleware gives every request an ID, stored in its context and
+// echoed in the X-Request-ID response header. A caller's X-Request-ID is kept
+// when it is a valid request ID; otherwise a new one is generated. Requests
+// that already carry an ID in their context, because an outer handler ran this
+// middleware, keep it.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requestID := RequestIDFromContext(r.Context()); requestID != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		requestID := r.Header.Get(RequestIDHeader)
+		if !ValidRequestID(requestID) {
+			requestID = uuid.New().String()[:8]
+		}
+		r.Header.Set(RequestIDHeader, requestID)
+		w.Header().Set(RequestIDHeader, requestID)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, requestID)))
+	})
+}
+
+// RequestIDFromContext returns the request ID set by RequestIDMiddleware, or
+// "" outside a request
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// ValidRequestID reports whether id is safe to log and echo: 1 to 64 ASCII
+// letters, digits, dots, underscores or hyphens
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '.', c == '_', c == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
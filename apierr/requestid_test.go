@@ -0,0 +1,74 @@
+package apierr
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValidRequestID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"3f2a9c1d", true},
+		{"req_2026-01-02.001", true},
+		{strings.Repeat("a", 64), true},
+		{"", false},
+		{strings.Repeat("a", 65), false},
+		{"abc def", false},
+		{"abc\ninjected log line", false},
+		{"<script>", false},
+		{"ünïcode", false},
+	}
+	for _, tt := range tests {
+		if got := ValidRequestID(tt.id); got != tt.want {
+			t.Errorf("ValidRequestID(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var seen string
+	handler := RequestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+	}))
+
+	serve := func(header string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/", nil)
+		if header != "" {
+			req.Header.Set(RequestIDHeader, header)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// A valid caller ID is kept and echoed
+	rec := serve("client-42")
+	if seen != "client-42" || rec.Header().Get(RequestIDHeader) != "client-42" {
+		t.Errorf("request ID = %q, header %q; want client-42", seen, rec.Header().Get(RequestIDHeader))
+	}
+
+	// Missing and unsafe IDs are replaced with a generated one
+	for _, header := range []string{"", "bad id\r\nX-Injected: 1", strings.Repeat("x", 200)} {
+		rec := serve(header)
+		if seen == header || !ValidRequestID(seen) || rec.Header().Get(RequestIDHeader) != seen {
+			t.Errorf("caller ID %q: request ID = %q, header %q", header, seen, rec.Header().Get(RequestIDHeader))
+		}
+	}
+
+	// An ID assigned by an outer handler is reused rather than replaced
+	outer := RequestIDMiddleware(handler)
+	req := httptest.NewRequest("GET", "/", nil)
+	rec = httptest.NewRecorder()
+	outer.ServeHTTP(rec, req)
+	if seen == "" || rec.Header().Get(RequestIDHeader) != seen {
+		t.Errorf("nested request ID = %q, header %q", seen, rec.Header().Get(RequestIDHeader))
+	}
+
+	if id := RequestIDFromContext(req.Context()); id != "" {
+		t.Errorf("RequestIDFromContext() outside the middleware = %q", id)
+	}
+}
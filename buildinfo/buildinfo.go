@@ -0,0 +1,23 @@
+// Package buildinfo holds version information injected at build time:
+//
+//	go build -ldflags "-X github.com/serverlesscloud/bian-go/buildinfo.Version=1.4.0 \
+//	  -X github.com/serverlesscloud/bian-go/buildinfo.Commit=$(git rev-parse --short HEAD)"
+package buildinfo
+
+import "fmt"
+
+var (
+	// Version is the service release
+	Version = "dev"
+
+	// Commit is the source revision the binary was built from
+	Commit = "unknown"
+
+	// BIANVersion is the BIAN Service Landscape release the domains follow
+	BIANVersion = "13.0.0"
+)
+
+// String describes the build, e.g. "1.4.0 (commit abc1234, BIAN 13.0.0)"
+func String() string {
+	return fmt.Sprintf("%s (commit %s, BIAN %s)", Version, Commit, BIANVersion)
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// change is one difference between two BIAN releases of a service domain
+type change struct {
+	Domain   string
+	Breaking bool
+	Detail   string
+}
+
+// diffSpecs compares two releases. Changes that can break generated code or
+// existing clients are breaking: removed domains, operations, schemas,
+// properties or enum values, changed types or signatures, and newly
+// required properties. Additions are not.
+func diffSpecs(old, new map[string]*spec) []change {
+	var changes []change
+	for _, domain := range sortedKeys(old) {
+		if new[domain] == nil {
+			changes = append(changes, change{Domain: domain, Breaking: true, Detail: "service domain removed"})
+			continue
+		}
+		changes = append(changes, diffDomain(old[domain], new[domain])...)
+	}
+	for _, domain := range sortedKeys(new) {
+		if old[domain] == nil {
+			changes = append(changes, change{Domain: domain, Detail: "service domain added"})
+		}
+	}
+	return changes
+}
+
+func diffDomain(old, new *spec) []change {
+	var changes []change
+	add := func(breaking bool, format string, args ...interface{}) {
+		changes = append(changes, change{Domain: old.Domain, Breaking: breaking, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	oldOps, newOps := operationIndex(old), operationIndex(new)
+	for _, key := range sortedKeys(oldOps) {
+		oldOp, newOp := oldOps[key], newOps[key]
+		if newOp == nil {
+			add(true, "operation %s removed", key)
+			continue
+		}
+		if oldOp.OperationID != newOp.OperationID {
+			add(true, "operation %s renamed from %s to %s", key, oldOp.OperationID, newOp.OperationID)
+		}
+		if before, after := signature(oldOp), signature(newOp); before != after {
+			add(true, "operation %s signature changed from %s to %s", key, before, after)
+		}
+	}
+	for _, key := range sortedKeys(newOps) {
+		if oldOps[key] == nil {
+			add(false, "operation %s (%s) added", key, newOps[key].OperationID)
+		}
+	}
+
+	oldSchemas, newSchemas := old.Components.Schemas, new.Components.Schemas
+	for _, name := range sortedKeys(oldSchemas) {
+		before, after := oldSchemas[name], newSchemas[name]
+		if after == nil {
+			add(true, "schema %s removed", name)
+			continue
+		}
+		if typeName(before) != typeName(after) {
+			add(true, "schema %s changed from %s to %s", name, typeName(before), typeName(after))
+			continue
+		}
+		diffSchema(add, name, before, after)
+	}
+	for _, name := range sortedKeys(newSchemas) {
+		if oldSchemas[name] == nil {
+			add(false, "schema %s added", name)
+		}
+	}
+	return changes
+}
+
+// diffSchema compares the properties and enum values of a schema, recursing
+// into inline objects
+func diffSchema(add func(bool, string, ...interface{}), where string, before, after *schema) {
+	oldRequired, newRequired := requiredSet(before), requiredSet(after)
+	for _, prop := range sortedKeys(before.Properties) {
+		path := where + "." + prop
+		newProp := after.Properties[prop]
+		if newProp == nil {
+			add(true, "property %s removed", path)
+			continue
+		}
+		if typeName(before.Properties[prop]) != typeName(newProp) {
+			add(true, "property %s changed from %s to %s", path, typeName(before.Properties[prop]), typeName(newProp))
+			continue
+		}
+		if !oldRequired[prop] && newRequired[prop] {
+			add(true, "property %s is now required", path)
+		}
+		if oldRequired[prop] && !newRequired[prop] {
+			add(false, "property %s is now optional", path)
+		}
+		if before.Properties[prop].Ref == "" {
+			diffSchema(add, path, before.Properties[prop], newProp)
+		}
+	}
+	for _, prop := range sortedKeys(after.Properties) {
+		if before.Properties[prop] == nil {
+			add(newRequired[prop], "property %s.%s added%s", where, prop, map[bool]string{true: " as required"}[newRequired[prop]])
+		}
+	}
+
+	oldValues, newValues := make(map[string]bool), make(map[string]bool)
+	for _, value := range before.Enum {
+		oldValues[value] = true
+	}
+	for _, value := range after.Enum {
+		newValues[value] = true
+		if !oldValues[value] {
+			add(false, "enum %s value %s added", where, value)
+		}
+	}
+	for _, value := range before.Enum {
+		if !newValues[value] {
+			add(true, "enum %s value %s removed", where, value)
+		}
+	}
+}
+
+// operationIndex keys a spec's operations by "METHOD path"
+func operationIndex(s *spec) map[string]*operation {
+	ops := make(map[string]*operation)
+	for path, item := range s.Paths {
+		for method, op := range item.operations() {
+			// Compare path-level parameters as part of each operation
+			merged := *op
+			merged.Parameters = item.parameters(op)
+			ops[method+" "+path] = &merged
+		}
+	}
+	return ops
+}
+
+// signature summarises an operation's parameters and bodies
+func signature(op *operation) string {
+	var params []string
+	for _, param := range op.Parameters {
+		required := ""
+		if param.Required {
+			required = "!"
+		}
+		params = append(params, param.In+":"+param.Name+required)
+	}
+	sort.Strings(params)
+
+	request := "none"
+	if body := op.requestSchema(); body != nil {
+		request = typeName(body)
+	}
+	status, body := op.responseSchema()
+	response := status
+	if body != nil {
+		response += " " + typeName(body)
+	}
+	return fmt.Sprintf("(%s; body %s) -> %s", strings.Join(params, ", "), request, response)
+}
+
+// typeName describes a schema's type without its properties
+func typeName(sc *schema) string {
+	switch {
+	case sc == nil:
+		return "any"
+	case sc.Ref != "":
+		return refName(sc.Ref)
+	case sc.Type == "array":
+		return "[]" + typeName(sc.Items)
+	case sc.isObject():
+		return "object"
+	case sc.Format != "":
+		return sc.Type + "(" + sc.Format + ")"
+	case sc.Type == "":
+		return "any"
+	}
+	return sc.Type
+}
+
+func requiredSet(sc *schema) map[string]bool {
+	set := make(map[string]bool, len(sc.Required))
+	for _, name := range sc.Required {
+		set[name] = true
+	}
+	return set
+}
+
+// writeReport writes the changes as a Markdown report
+func writeReport(w io.Writer, oldVersion, newVersion string, changes []change) {
+	var breaking, other []change
+	for _, c := range changes {
+		if c.Breaking {
+			breaking = append(breaking, c)
+		} else {
+			other = append(other, c)
+		}
+	}
+
+	fmt.Fprintf(w, "# BIAN %s to %s\n", oldVersion, newVersion)
+	for _, section := range []struct {
+		title   string
+		changes []change
+	}{
+		{"Breaking changes", breaking},
+		{"Other changes", other},
+	} {
+		fmt.Fprintf(w, "\n## %s (%d)\n\n", section.title, len(section.changes))
+		if len(section.changes) == 0 {
+			fmt.Fprintln(w, "None.")
+		}
+		for _, c := range section.changes {
+			fmt.Fprintf(w, "- %s: %s\n", c.Domain, c.Detail)
+		}
+	}
+}
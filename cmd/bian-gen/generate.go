@@ -0,0 +1,510 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// canonicalModels maps BIAN control record and behaviour qualifier schemas
+// to the models types they are translated from, for mapping stubs
+var canonicalModels = map[string]interface{}{
+	"CurrentAccountFacility":    models.Account{},
+	"Payments":                  models.Transaction{},
+	"PaymentExecutionProcedure": models.Transaction{},
+	"AccountBalance":            models.Balance{},
+	"CustomerConsent":           models.Consent{},
+}
+
+// initialisms are upper-cased in Go identifiers
+var initialisms = map[string]bool{
+	"id": true, "url": true, "iban": true, "bic": true, "bsb": true, "api": true, "http": true,
+}
+
+// goType is a generated struct or enum
+type goType struct {
+	Name   string
+	Doc    string
+	Enum   []string
+	Fields []goField
+}
+
+type goField struct {
+	Name string
+	Type string
+	Tag  string
+	Doc  string
+}
+
+// goMethod is a generated service interface method
+type goMethod struct {
+	Name    string
+	Doc     []string
+	Params  []string
+	Results string
+}
+
+// generator emits the Go package for one service domain
+type generator struct {
+	spec    *spec
+	version string
+	types   map[string]*goType
+	methods []goMethod
+}
+
+// packageName returns the Go package for a service domain, e.g. currentaccount
+func packageName(domain string) string {
+	return strings.ToLower(goName(domain))
+}
+
+// generate returns the types.go and service.go sources for a service domain
+func generate(s *spec, version string) (map[string][]byte, error) {
+	g := &generator{spec: s, version: version, types: make(map[string]*goType)}
+
+	for _, name := range sortedKeys(s.Components.Schemas) {
+		if sc := s.Components.Schemas[name]; isNamed(sc) {
+			g.declare(goName(name), sc)
+		}
+	}
+	if err := g.collectMethods(); err != nil {
+		return nil, err
+	}
+
+	types, err := g.render(true, g.renderTypes)
+	if err != nil {
+		return nil, fmt.Errorf("%s types: %w", s.Domain, err)
+	}
+	service, err := g.render(false, g.renderService)
+	if err != nil {
+		return nil, fmt.Errorf("%s service: %w", s.Domain, err)
+	}
+	return map[string][]byte{"types.go": types, "service.go": service}, nil
+}
+
+// isNamed reports whether a schema becomes its own Go type rather than a builtin
+func isNamed(sc *schema) bool {
+	return sc.isObject() || len(sc.Enum) > 0
+}
+
+// declare adds a struct or enum type for a schema, once per name
+func (g *generator) declare(name string, sc *schema) {
+	if g.types[name] != nil {
+		return
+	}
+	t := &goType{Name: name, Doc: sc.Description}
+	g.types[name] = t
+
+	if !sc.isObject() {
+		t.Enum = sc.Enum
+		return
+	}
+
+	properties, required := g.properties(sc)
+	for _, prop := range sortedKeys(properties) {
+		field := goField{Name: goName(prop), Doc: properties[prop].Description}
+		field.Type = g.typeOf(properties[prop], name+field.Name)
+		if required[prop] {
+			field.Tag = fmt.Sprintf("`json:%q`", prop)
+		} else {
+			field.Tag = fmt.Sprintf("`json:%q`", prop+",omitempty")
+			if g.isStruct(field.Type) {
+				field.Type = "*" + field.Type
+			}
+		}
+		t.Fields = append(t.Fields, field)
+	}
+}
+
+// properties merges a schema's own properties with those of its allOf members
+func (g *generator) properties(sc *schema) (map[string]*schema, map[string]bool) {
+	properties := make(map[string]*schema)
+	required := make(map[string]bool)
+	for _, member := range sc.AllOf {
+		if member.Ref != "" {
+			member = g.spec.Components.Schemas[refName(member.Ref)]
+		}
+		memberProperties, memberRequired := g.properties(member)
+		for name, prop := range memberProperties {
+			properties[name] = prop
+		}
+		for name := range memberRequired {
+			required[name] = true
+		}
+	}
+	for name, prop := range sc.Properties {
+		properties[name] = prop
+	}
+	for _, name := range sc.Required {
+		required[name] = true
+	}
+	return properties, required
+}
+
+// isStruct reports whether a field type needs a pointer to be optional
+func (g *generator) isStruct(typ string) bool {
+	if t := g.types[typ]; t != nil {
+		return t.Enum == nil
+	}
+	return typ == "time.Time" || typ == "decimal.Decimal"
+}
+
+// typeOf returns the Go type for a schema, declaring inline objects and
+// enums under name. References to primitive components resolve to builtins.
+func (g *generator) typeOf(sc *schema, name string) string {
+	switch {
+	case sc == nil:
+		return "json.RawMessage"
+	case sc.Ref != "":
+		component := refName(sc.Ref)
+		target := g.spec.Components.Schemas[component]
+		if isNamed(target) {
+			g.declare(goName(component), target)
+			return goName(component)
+		}
+		return g.typeOf(target, goName(component))
+	case isNamed(sc):
+		g.declare(name, sc)
+		return name
+	}
+
+	switch sc.Type {
+	case "array":
+		return "[]" + g.typeOf(sc.Items, name+"Item")
+	case "string":
+		switch sc.Format {
+		case "date-time":
+			return "time.Time"
+		case "decimal":
+			return "decimal.Decimal"
+		}
+		return "string"
+	case "integer":
+		if sc.Format == "int32" {
+			return "int32"
+		}
+		return "int64"
+	case "number":
+		// Never float64: BIAN numbers are amounts and rates
+		return "decimal.Decimal"
+	case "boolean":
+		return "bool"
+	}
+	return "json.RawMessage"
+}
+
+// pathParams matches {name} segments of a path template
+var pathParams = regexp.MustCompile(`\{([^}]+)\}`)
+
+// collectMethods builds a service method for every operation
+func (g *generator) collectMethods() error {
+	for _, path := range sortedKeys(g.spec.Paths) {
+		item := g.spec.Paths[path]
+		ops := item.operations()
+		for _, method := range []string{"GET", "POST", "PUT", "PATCH", "DELETE"} {
+			op := ops[method]
+			if op == nil {
+				continue
+			}
+			if op.OperationID == "" {
+				return fmt.Errorf("%s %s %s has no operationId", g.spec.File, method, path)
+			}
+
+			m := goMethod{
+				Name:   goName(op.OperationID),
+				Doc:    []string{fmt.Sprintf("%s handles %s %s.", goName(op.OperationID), method, path)},
+				Params: []string{"ctx context.Context"},
+			}
+			if op.Summary != "" {
+				m.Doc = append(m.Doc, strings.TrimSuffix(op.Summary, ".")+".")
+			}
+
+			params := item.parameters(op)
+			for _, match := range pathParams.FindAllStringSubmatch(path, -1) {
+				typ := "string"
+				for _, param := range params {
+					if param.In == "path" && param.Name == match[1] && param.Schema != nil {
+						typ = g.typeOf(param.Schema, m.Name+goName(param.Name))
+					}
+				}
+				m.Params = append(m.Params, lowerGoName(match[1])+" "+typ)
+			}
+			if query := g.queryParams(m.Name+"Params", params); query != "" {
+				m.Params = append(m.Params, "params "+query)
+			}
+			if body := op.requestSchema(); body != nil {
+				m.Params = append(m.Params, "body "+g.reference(g.typeOf(body, m.Name+"Request")))
+			}
+
+			m.Results = "error"
+			if _, body := op.responseSchema(); body != nil {
+				m.Results = "(" + g.reference(g.typeOf(body, m.Name+"Response")) + ", error)"
+			}
+			g.methods = append(g.methods, m)
+		}
+	}
+	return nil
+}
+
+// queryParams declares a struct for an operation's query parameters, if it has any
+func (g *generator) queryParams(name string, params []*parameter) string {
+	properties := make(map[string]*schema)
+	var required []string
+	for _, param := range params {
+		if param.In != "query" {
+			continue
+		}
+		prop := &schema{Type: "string", Description: param.Description}
+		if param.Schema != nil {
+			copied := *param.Schema
+			copied.Description = param.Description
+			prop = &copied
+		}
+		properties[param.Name] = prop
+		if param.Required {
+			required = append(required, param.Name)
+		}
+	}
+	if len(properties) == 0 {
+		return ""
+	}
+	g.declare(name, &schema{Type: "object", Properties: properties, Required: required})
+	return name
+}
+
+// reference returns a pointer to struct types and other types unchanged
+func (g *generator) reference(typ string) string {
+	if g.isStruct(typ) {
+		return "*" + typ
+	}
+	return typ
+}
+
+// render formats a generated file: the header, package clause and the
+// imports the declarations written by body use
+func (g *generator) render(packageDoc bool, body func(*bytes.Buffer)) ([]byte, error) {
+	var decls bytes.Buffer
+	body(&decls)
+
+	var std, thirdParty []string
+	for pkg, use := range map[string]string{
+		"context":                       "context.Context",
+		"encoding/json":                 "json.RawMessage",
+		"time":                          "time.Time",
+		"github.com/shopspring/decimal": "decimal.Decimal",
+	} {
+		if !strings.Contains(decls.String(), use) {
+			continue
+		}
+		if strings.Contains(pkg, ".") {
+			thirdParty = append(thirdParty, fmt.Sprintf("%q", pkg))
+		} else {
+			std = append(std, fmt.Sprintf("%q", pkg))
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(thirdParty)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by bian-gen from BIAN %s %s. DO NOT EDIT.\n\n", g.version, g.spec.File)
+	if packageDoc {
+		fmt.Fprintf(&buf, "// Package %s contains the types and service interface of the BIAN %s\n// %s service domain.\n",
+			packageName(g.spec.Domain), g.version, g.spec.Domain)
+	}
+	fmt.Fprintf(&buf, "package %s\n", packageName(g.spec.Domain))
+	switch imports := append(std, thirdParty...); {
+	case len(imports) == 1:
+		fmt.Fprintf(&buf, "\nimport %s\n", imports[0])
+	case len(imports) > 1:
+		groups := strings.Join(std, "\n")
+		if len(std) > 0 && len(thirdParty) > 0 {
+			groups += "\n\n"
+		}
+		groups += strings.Join(thirdParty, "\n")
+		fmt.Fprintf(&buf, "\nimport (\n%s\n)\n", groups)
+	}
+	buf.Write(decls.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+func (g *generator) renderTypes(buf *bytes.Buffer) {
+	for _, name := range sortedKeys(g.types) {
+		t := g.types[name]
+		buf.WriteString("\n")
+		writeDoc(buf, "", t.Name, t.Doc)
+
+		if t.Enum != nil {
+			fmt.Fprintf(buf, "type %s string\n\n", t.Name)
+			fmt.Fprintf(buf, "// %s values\nconst (\n", t.Name)
+			for _, value := range t.Enum {
+				fmt.Fprintf(buf, "\t%s%s %s = %q\n", t.Name, goName(value), t.Name, value)
+			}
+			buf.WriteString(")\n")
+			continue
+		}
+
+		fmt.Fprintf(buf, "type %s struct {\n", t.Name)
+		for _, field := range t.Fields {
+			if field.Doc != "" {
+				writeDoc(buf, "\t", "", field.Doc)
+			}
+			fmt.Fprintf(buf, "\t%s %s %s\n", field.Name, field.Type, field.Tag)
+		}
+		buf.WriteString("}\n")
+	}
+}
+
+func (g *generator) renderService(buf *bytes.Buffer) {
+	fmt.Fprintf(buf, "\n// Service is the %s service domain.\n", g.spec.Domain)
+	if g.spec.Info.Description != "" {
+		buf.WriteString("//\n")
+		writeDoc(buf, "", "", g.spec.Info.Description)
+	}
+	buf.WriteString("type Service interface {\n")
+	for i, m := range g.methods {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		for _, line := range m.Doc {
+			fmt.Fprintf(buf, "\t// %s\n", line)
+		}
+		fmt.Fprintf(buf, "\t%s(%s) %s\n", m.Name, strings.Join(m.Params, ", "), m.Results)
+	}
+	buf.WriteString("}\n")
+}
+
+// writeDoc writes a doc comment. Named declarations lead with their name and
+// follow with the spec description, if any.
+func writeDoc(buf *bytes.Buffer, indent, name, doc string) {
+	doc = strings.Join(strings.Fields(doc), " ")
+	if name != "" {
+		fmt.Fprintf(buf, "%s// %s is generated from the %s schema.\n", indent, name, name)
+		if doc == "" {
+			return
+		}
+		fmt.Fprintf(buf, "%s//\n", indent)
+	}
+	for _, line := range wrap(doc, 76) {
+		fmt.Fprintf(buf, "%s// %s\n", indent, line)
+	}
+}
+
+// wrap breaks text into lines of at most width characters
+func wrap(text string, width int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// mappingStub returns hand-editable conversions between the domain's
+// control records and their canonical models, or nil if none apply
+func mappingStub(s *spec, version string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Mapping stubs generated by bian-gen from BIAN %s %s.\n", version, s.File)
+	buf.WriteString("// Complete the field mappings by hand; bian-gen never overwrites this file.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", packageName(s.Domain))
+	buf.WriteString("import \"github.com/serverlesscloud/bian-go/models\"\n")
+
+	stubs := 0
+	for _, name := range sortedKeys(s.Components.Schemas) {
+		model, ok := canonicalModels[name]
+		if !ok || !s.Components.Schemas[name].isObject() {
+			continue
+		}
+		stubs++
+		typeName := goName(name)
+		modelType := reflect.TypeOf(model)
+		modelName := "models." + modelType.Name()
+
+		g := &generator{spec: s, types: make(map[string]*goType)}
+		g.declare(typeName, s.Components.Schemas[name])
+
+		fmt.Fprintf(&buf, "\n// %sFromModel maps a %s to a %s\n", typeName, modelName, typeName)
+		fmt.Fprintf(&buf, "func %sFromModel(m *%s) *%s {\n\treturn &%s{\n", typeName, modelName, typeName, typeName)
+		for _, field := range g.types[typeName].Fields {
+			fmt.Fprintf(&buf, "\t\t// %s: ,\n", field.Name)
+		}
+		buf.WriteString("\t}\n}\n")
+
+		fmt.Fprintf(&buf, "\n// %sToModel maps a %s to a %s\n", typeName, typeName, modelName)
+		fmt.Fprintf(&buf, "func %sToModel(cr *%s) *%s {\n\treturn &%s{\n", typeName, typeName, modelName, modelName)
+		for i := 0; i < modelType.NumField(); i++ {
+			fmt.Fprintf(&buf, "\t\t// %s: ,\n", modelType.Field(i).Name)
+		}
+		buf.WriteString("\t}\n}\n")
+	}
+	if stubs == 0 {
+		return nil, nil
+	}
+	return format.Source(buf.Bytes())
+}
+
+// goName converts a spec name such as cr-reference-id or ProductInstanceReference
+// to an exported Go identifier
+func goName(name string) string {
+	var out strings.Builder
+	for _, part := range splitName(name) {
+		if initialisms[strings.ToLower(part)] {
+			out.WriteString(strings.ToUpper(part))
+			continue
+		}
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		out.WriteString(string(runes))
+	}
+	if out.Len() == 0 || unicode.IsDigit(rune(out.String()[0])) {
+		return "X" + out.String()
+	}
+	return out.String()
+}
+
+// lowerGoName converts a spec name to an unexported Go identifier
+func lowerGoName(name string) string {
+	parts := splitName(name)
+	if len(parts) == 0 {
+		return "x"
+	}
+	first := []rune(parts[0])
+	if initialisms[strings.ToLower(parts[0])] {
+		first = []rune(strings.ToLower(parts[0]))
+	} else {
+		first[0] = unicode.ToLower(first[0])
+	}
+	ident := string(first)
+	if len(parts) > 1 {
+		ident += goName(strings.Join(parts[1:], "-"))
+	}
+	if unicode.IsDigit(first[0]) {
+		ident = "x" + ident
+	}
+	if token.IsKeyword(ident) {
+		ident += "_"
+	}
+	return ident
+}
+
+func splitName(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
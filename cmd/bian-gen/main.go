@@ -0,0 +1,137 @@
+// Command bian-gen generates Go types and service interfaces from vendored
+// BIAN semantic API OpenAPI files, and reports breaking changes between
+// BIAN releases.
+//
+// Usage:
+//
+//	bian-gen generate [-version v13.0.0] [-specs third_party/bian] [-out domains/bian]
+//	bian-gen diff [-fail-on-breaking] third_party/bian/v12.0.0 third_party/bian/v13.0.0
+//
+// generate writes domains/bian/{domain}/types.go and service.go for every
+// OpenAPI file in {specs}/{version}, replacing earlier output, and creates a
+// mapping.go stub converting control records to and from models types when
+// one does not exist yet. The version defaults to the contents of .bian-version.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// errBreaking is returned by diff -fail-on-breaking when breaking changes are found
+var errBreaking = errors.New("breaking changes found")
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "bian-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errors.New("usage: bian-gen generate|diff [flags]")
+	}
+
+	switch args[0] {
+	case "generate":
+		flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+		version := flags.String("version", "", "BIAN release to generate from (default: contents of -version-file)")
+		versionFile := flags.String("version-file", ".bian-version", "file holding the BIAN release")
+		specs := flags.String("specs", "third_party/bian", "directory of vendored BIAN releases")
+		out := flags.String("out", "domains/bian", "output directory")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+		if *version == "" {
+			data, err := os.ReadFile(*versionFile)
+			if err != nil {
+				return err
+			}
+			*version = strings.TrimSpace(string(data))
+		}
+		return generateAll(filepath.Join(*specs, *version), *version, *out, stdout)
+
+	case "diff":
+		flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+		failOnBreaking := flags.Bool("fail-on-breaking", false, "exit with an error when breaking changes are found")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+		if flags.NArg() != 2 {
+			return errors.New("usage: bian-gen diff [-fail-on-breaking] OLD_DIR NEW_DIR")
+		}
+		oldDir, newDir := flags.Arg(0), flags.Arg(1)
+		old, err := loadSpecs(oldDir)
+		if err != nil {
+			return err
+		}
+		new, err := loadSpecs(newDir)
+		if err != nil {
+			return err
+		}
+
+		changes := diffSpecs(old, new)
+		writeReport(stdout, filepath.Base(oldDir), filepath.Base(newDir), changes)
+		for _, c := range changes {
+			if c.Breaking && *failOnBreaking {
+				return errBreaking
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown command %q", args[0])
+}
+
+// generateAll writes the package for every service domain in dir
+func generateAll(dir, version, out string, stdout io.Writer) error {
+	specs, err := loadSpecs(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, domain := range sortedKeys(specs) {
+		files, err := generate(specs[domain], version)
+		if err != nil {
+			return err
+		}
+		pkgDir := filepath.Join(out, packageName(domain))
+		if err := os.MkdirAll(pkgDir, 0o755); err != nil {
+			return err
+		}
+		for _, name := range sortedKeys(files) {
+			if err := writeIfChanged(filepath.Join(pkgDir, name), files[name]); err != nil {
+				return err
+			}
+		}
+
+		mappingPath := filepath.Join(pkgDir, "mapping.go")
+		if _, err := os.Stat(mappingPath); errors.Is(err, os.ErrNotExist) {
+			stub, err := mappingStub(specs[domain], version)
+			if err != nil {
+				return err
+			}
+			if stub != nil {
+				if err := os.WriteFile(mappingPath, stub, 0o644); err != nil {
+					return err
+				}
+			}
+		}
+		fmt.Fprintf(stdout, "%s -> %s\n", specs[domain].File, pkgDir)
+	}
+	return nil
+}
+
+// writeIfChanged leaves unchanged files untouched so build caches stay warm
+func writeIfChanged(path string, data []byte) error {
+	if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, data) {
+		return nil
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestGeneratedCodeUpToDate fails when domains/bian was not regenerated
+// after changing the vendored specs, .bian-version or the generator
+func TestGeneratedCodeUpToDate(t *testing.T) {
+	data, err := os.ReadFile("../../.bian-version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	version := strings.TrimSpace(string(data))
+
+	specs, err := loadSpecs(filepath.Join("../../third_party/bian", version))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for domain, s := range specs {
+		files, err := generate(s, version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, want := range files {
+			path := filepath.Join("../../domains/bian", packageName(domain), name)
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Errorf("%s: %v", path, err)
+				continue
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s is stale; run go generate ./domains/bian", path)
+			}
+		}
+	}
+}
+
+func TestDiffSpecs(t *testing.T) {
+	old, err := loadSpecs("testdata/v12.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := loadSpecs("testdata/v13.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]bool)
+	for _, c := range diffSpecs(old, new) {
+		got[c.Domain+": "+c.Detail] = c.Breaking
+	}
+
+	want := map[string]bool{
+		"Obsolete: service domain removed": true,
+		"CurrentAccount: operation GET /CurrentAccount/{cr-reference-id}/Retrieve renamed from RetrieveCurrentAccount to RetrieveCurrentAccountFacility": true,
+		"CurrentAccount: operation GET /CurrentAccount/{cr-reference-id}/Deposits/{bq-reference-id}/Retrieve removed":                                    true,
+		"CurrentAccount: operation GET /CurrentAccount/{cr-reference-id}/Payments/{bq-reference-id}/Retrieve (RetrieveCurrentAccountPayments) added":     false,
+		"CurrentAccount: schema Deposits removed":                                                    true,
+		"CurrentAccount: schema Payments added":                                                      false,
+		"CurrentAccount: property CurrentAccountFacility.OverdraftLimit removed":                     true,
+		"CurrentAccount: property CurrentAccountFacility.ProductName changed from string to integer": true,
+		"CurrentAccount: property CurrentAccountFacility.AccountCurrency added as required":          true,
+		"CurrentAccount: property CurrentAccountFacility.CustomerReference added":                    false,
+		"CurrentAccount: enum AccountType value Basic removed":                                       true,
+		"CurrentAccount: enum AccountType value Investment added":                                    false,
+	}
+	for detail, breaking := range want {
+		gotBreaking, ok := got[detail]
+		if !ok {
+			t.Errorf("missing change %q", detail)
+		} else if gotBreaking != breaking {
+			t.Errorf("%q breaking = %v, want %v", detail, gotBreaking, breaking)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d changes, want %d: %v", len(got), len(want), got)
+	}
+}
+
+func TestDiffFailOnBreaking(t *testing.T) {
+	var report bytes.Buffer
+	err := run([]string{"diff", "-fail-on-breaking", "testdata/v12.0.0", "testdata/v13.0.0"}, &report)
+	if !errors.Is(err, errBreaking) {
+		t.Errorf("err = %v, want errBreaking", err)
+	}
+	if !strings.Contains(report.String(), "## Breaking changes (8)") {
+		t.Errorf("report missing breaking section:\n%s", report.String())
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := []struct {
+		in, exported, unexported string
+	}{
+		{"cr-reference-id", "CrReferenceID", "crReferenceID"},
+		{"ProductInstanceReference", "ProductInstanceReference", "productInstanceReference"},
+		{"IBAN", "IBAN", "iban"},
+		{"type", "Type", "type_"},
+		{"3DSecure", "X3DSecure", "x3DSecure"},
+	}
+	for _, tt := range tests {
+		if got := goName(tt.in); got != tt.exported {
+			t.Errorf("goName(%q) = %q, want %q", tt.in, got, tt.exported)
+		}
+		if got := lowerGoName(tt.in); got != tt.unexported {
+			t.Errorf("lowerGoName(%q) = %q, want %q", tt.in, got, tt.unexported)
+		}
+	}
+}
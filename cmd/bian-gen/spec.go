@@ -0,0 +1,268 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// spec is the subset of an OpenAPI 3.0 document that BIAN semantic API
+// files use
+type spec struct {
+	// Domain is the service domain, taken from the file name
+	Domain string `json:"-"`
+	File   string `json:"-"`
+
+	OpenAPI string `json:"openapi"`
+	Info    struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Version     string `json:"version"`
+	} `json:"info"`
+	Paths      map[string]*pathItem `json:"paths"`
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type pathItem struct {
+	Get    *operation `json:"get"`
+	Put    *operation `json:"put"`
+	Post   *operation `json:"post"`
+	Patch  *operation `json:"patch"`
+	Delete *operation `json:"delete"`
+
+	Parameters []*parameter `json:"parameters"`
+}
+
+// operations returns the path's operations keyed by upper-case method
+func (p *pathItem) operations() map[string]*operation {
+	ops := make(map[string]*operation)
+	for method, op := range map[string]*operation{
+		"GET": p.Get, "PUT": p.Put, "POST": p.Post, "PATCH": p.Patch, "DELETE": p.Delete,
+	} {
+		if op != nil {
+			ops[method] = op
+		}
+	}
+	return ops
+}
+
+type operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Description string               `json:"description"`
+	Parameters  []*parameter         `json:"parameters"`
+	RequestBody *requestBody         `json:"requestBody"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*mediaType `json:"content"`
+}
+
+type response struct {
+	Description string                `json:"description"`
+	Content     map[string]*mediaType `json:"content"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref         string             `json:"$ref"`
+	Type        string             `json:"type"`
+	Format      string             `json:"format"`
+	Description string             `json:"description"`
+	Enum        []string           `json:"enum"`
+	Properties  map[string]*schema `json:"properties"`
+	Required    []string           `json:"required"`
+	Items       *schema            `json:"items"`
+	AllOf       []*schema          `json:"allOf"`
+}
+
+// isObject reports whether the schema describes a struct
+func (s *schema) isObject() bool {
+	return s.Type == "object" || len(s.Properties) > 0 || len(s.AllOf) > 0
+}
+
+// refName returns the component name a local $ref points to
+func refName(ref string) string {
+	return strings.TrimPrefix(ref, "#/components/schemas/")
+}
+
+// loadSpecs reads every .yaml, .yml and .json file in dir, keyed by service domain
+func loadSpecs(dir string) (map[string]*spec, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := make(map[string]*spec)
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+		s, err := loadSpec(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		specs[s.Domain] = s
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no OpenAPI files in %s", dir)
+	}
+	return specs, nil
+}
+
+func loadSpec(path string) (*spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if ext := filepath.Ext(path); ext == ".yaml" || ext == ".yml" {
+		if data, err = yaml.YAMLToJSON(data); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	var s spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	s.File = filepath.Base(path)
+	s.Domain = strings.TrimSuffix(s.File, filepath.Ext(s.File))
+	if err := s.checkRefs(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// checkRefs rejects references to missing or non-local schemas
+func (s *spec) checkRefs() error {
+	var check func(where string, sc *schema) error
+	check = func(where string, sc *schema) error {
+		if sc == nil {
+			return nil
+		}
+		if sc.Ref != "" {
+			if !strings.HasPrefix(sc.Ref, "#/components/schemas/") {
+				return fmt.Errorf("%s: unsupported $ref %s", where, sc.Ref)
+			}
+			if s.Components.Schemas[refName(sc.Ref)] == nil {
+				return fmt.Errorf("%s: unresolved $ref %s", where, sc.Ref)
+			}
+		}
+		for name, prop := range sc.Properties {
+			if err := check(where+"."+name, prop); err != nil {
+				return err
+			}
+		}
+		for _, member := range sc.AllOf {
+			if err := check(where, member); err != nil {
+				return err
+			}
+		}
+		return check(where+"[]", sc.Items)
+	}
+
+	for name, sc := range s.Components.Schemas {
+		if err := check(name, sc); err != nil {
+			return err
+		}
+	}
+	for path, item := range s.Paths {
+		for method, op := range item.operations() {
+			where := method + " " + path
+			for _, sc := range op.schemas() {
+				if err := check(where, sc); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// schemas returns the operation's request and response body schemas
+func (op *operation) schemas() []*schema {
+	var schemas []*schema
+	if body := op.requestSchema(); body != nil {
+		schemas = append(schemas, body)
+	}
+	if _, body := op.responseSchema(); body != nil {
+		schemas = append(schemas, body)
+	}
+	return schemas
+}
+
+// requestSchema returns the JSON request body schema, if any
+func (op *operation) requestSchema() *schema {
+	if op.RequestBody == nil || op.RequestBody.Content["application/json"] == nil {
+		return nil
+	}
+	return op.RequestBody.Content["application/json"].Schema
+}
+
+// responseSchema returns the lowest 2xx status and its JSON body schema, if any
+func (op *operation) responseSchema() (string, *schema) {
+	statuses := make([]string, 0, len(op.Responses))
+	for status := range op.Responses {
+		if strings.HasPrefix(status, "2") {
+			statuses = append(statuses, status)
+		}
+	}
+	sort.Strings(statuses)
+	for _, status := range statuses {
+		if media := op.Responses[status].Content["application/json"]; media != nil {
+			return status, media.Schema
+		}
+	}
+	if len(statuses) > 0 {
+		return statuses[0], nil
+	}
+	return "", nil
+}
+
+// parameters returns the path item and operation parameters, operation
+// parameters overriding path item ones of the same name and location
+func (p *pathItem) parameters(op *operation) []*parameter {
+	var params []*parameter
+	seen := make(map[string]bool)
+	for _, param := range op.Parameters {
+		seen[param.In+" "+param.Name] = true
+		params = append(params, param)
+	}
+	for _, param := range p.Parameters {
+		if !seen[param.In+" "+param.Name] {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// sortedKeys returns a map's keys in order, for deterministic output
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,86 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// BalanceAggregator computes a customer's net position across accounts held in
+// different currencies by converting each current balance into a reporting currency
+type BalanceAggregator struct {
+	customerService CustomerService
+	accountService  AccountService
+	fxService       FXService
+}
+
+// NewBalanceAggregator creates a new balance aggregator
+func NewBalanceAggregator(customerService CustomerService, accountService AccountService, fxService FXService) *BalanceAggregator {
+	return &BalanceAggregator{
+		customerService: customerService,
+		accountService:  accountService,
+		fxService:       fxService,
+	}
+}
+
+// AggregateCustomerBalances returns the customer's current balances converted to
+// reportingCurrency together with their total
+func (a *BalanceAggregator) AggregateCustomerBalances(ctx context.Context, customerID, reportingCurrency string) (*models.CustomerBalanceSummary, error) {
+	reportingCurrency = strings.ToUpper(reportingCurrency)
+	total, err := models.NewMoneyFromMinorUnits(0, reportingCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := a.customerService.RetrieveCustomerAccounts(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &models.CustomerBalanceSummary{
+		CustomerID:        customerID,
+		ReportingCurrency: reportingCurrency,
+		Accounts:          make([]models.AccountBalanceSummary, 0, len(accounts)),
+		Timestamp:         time.Now(),
+	}
+
+	for _, account := range accounts {
+		balance, err := a.accountService.RetrieveCurrentAccountBalance(ctx, account.ID)
+		if err != nil {
+			// A listed account without a balance is a provider fault, not a missing customer
+			if errors.Is(err, ErrNotFound) {
+				return nil, fmt.Errorf("retrieving balance for account %s: %v", account.ID, err)
+			}
+			return nil, fmt.Errorf("retrieving balance for account %s: %w", account.ID, err)
+		}
+
+		entry := models.AccountBalanceSummary{
+			AccountID: account.ID,
+			Balance:   balance.Amount,
+		}
+
+		if balance.Amount.Currency == reportingCurrency {
+			entry.ConvertedBalance = balance.Amount
+		} else {
+			converted, rate, err := a.fxService.ConvertAmount(ctx, balance.Amount, reportingCurrency)
+			if err != nil {
+				return nil, fmt.Errorf("converting balance for account %s: %w", account.ID, err)
+			}
+			entry.ConvertedBalance = converted
+			entry.ExchangeRate = rate
+		}
+
+		total, err = total.Add(entry.ConvertedBalance)
+		if err != nil {
+			return nil, err
+		}
+		summary.Accounts = append(summary.Accounts, entry)
+	}
+
+	summary.Total = total
+	return summary, nil
+}
@@ -0,0 +1,114 @@
+package domains_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/providers/fx"
+	"github.com/shopspring/decimal"
+)
+
+// holdings serves one customer's accounts and their current balances
+type holdings struct {
+	accounts []string
+	balances map[string]models.Money
+}
+
+func (h holdings) RetrieveCustomerAccounts(ctx context.Context, customerID string) ([]*models.Account, error) {
+	if customerID != "cust-001" {
+		return nil, fmt.Errorf("customer %w: %s", domains.ErrNotFound, customerID)
+	}
+	accounts := make([]*models.Account, len(h.accounts))
+	for i, id := range h.accounts {
+		accounts[i] = &models.Account{ID: id}
+	}
+	return accounts, nil
+}
+
+func (h holdings) RetrieveCurrentAccount(ctx context.Context, accountID string) (*models.Account, error) {
+	return &models.Account{ID: accountID}, nil
+}
+
+func (h holdings) RetrieveCurrentAccountBalance(ctx context.Context, accountID string) (*models.Balance, error) {
+	amount, ok := h.balances[accountID]
+	if !ok {
+		return nil, fmt.Errorf("account %w: %s", domains.ErrNotFound, accountID)
+	}
+	return &models.Balance{BalanceType: models.BalanceTypeCurrent, Amount: amount, Timestamp: time.Now()}, nil
+}
+
+func money(amount, currency string) models.Money {
+	m, err := models.NewMoneyFromString(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func TestBalanceAggregator_Rounding(t *testing.T) {
+	accounts := holdings{
+		accounts: []string{"acc-aud", "acc-usd", "acc-jpy"},
+		balances: map[string]models.Money{
+			"acc-aud": money("100.00", "AUD"),
+			"acc-usd": money("10.01", "USD"),
+			"acc-jpy": money("1000", "JPY"),
+		},
+	}
+	aggregator := domains.NewBalanceAggregator(accounts, accounts, fx.NewDefaultStaticProvider())
+
+	summary, err := aggregator.AggregateCustomerBalances(context.Background(), "cust-001", "aud")
+	if err != nil {
+		t.Fatalf("AggregateCustomerBalances() error = %v", err)
+	}
+	if summary.ReportingCurrency != "AUD" || len(summary.Accounts) != 3 {
+		t.Fatalf("summary = %+v", summary)
+	}
+
+	// Each balance is rounded to cents on conversion and the total is the
+	// exact sum of the rounded amounts
+	want := map[string]string{
+		"acc-aud": "100",   // unconverted, no rate
+		"acc-usd": "15.25", // 10.01 x 1.5230 = 15.24523
+		"acc-jpy": "10.16", // 1000 / 149.85 x 1.5230 = 10.1635
+	}
+	for _, entry := range summary.Accounts {
+		if got := entry.ConvertedBalance.Amount.String(); got != want[entry.AccountID] || entry.ConvertedBalance.Currency != "AUD" {
+			t.Errorf("%s converted to %s %s, want %s AUD", entry.AccountID, got, entry.ConvertedBalance.Currency, want[entry.AccountID])
+		}
+		if (entry.ExchangeRate == nil) != (entry.AccountID == "acc-aud") {
+			t.Errorf("%s exchange rate = %v", entry.AccountID, entry.ExchangeRate)
+		}
+	}
+	if !summary.Total.Amount.Equal(decimal.RequireFromString("125.41")) {
+		t.Errorf("total = %s, want 125.41", summary.Total.Amount)
+	}
+}
+
+func TestBalanceAggregator_Errors(t *testing.T) {
+	ctx := context.Background()
+	accounts := holdings{
+		accounts: []string{"acc-aud", "acc-usd"},
+		balances: map[string]models.Money{"acc-aud": money("100.00", "AUD"), "acc-usd": money("10.01", "USD")},
+	}
+	aggregator := domains.NewBalanceAggregator(accounts, accounts, fx.NewDefaultStaticProvider())
+
+	if _, err := aggregator.AggregateCustomerBalances(ctx, "cust-999", "AUD"); !errors.Is(err, domains.ErrNotFound) {
+		t.Errorf("unknown customer error = %v, want ErrNotFound", err)
+	}
+	if _, err := aggregator.AggregateCustomerBalances(ctx, "cust-001", "KWD"); !errors.Is(err, domains.ErrRateUnavailable) {
+		t.Errorf("unpriced currency error = %v, want ErrRateUnavailable", err)
+	}
+
+	// A listed account without a balance is a provider fault, not a missing customer
+	accounts.accounts = append(accounts.accounts, "acc-closed")
+	aggregator = domains.NewBalanceAggregator(accounts, accounts, fx.NewDefaultStaticProvider())
+	_, err := aggregator.AggregateCustomerBalances(ctx, "cust-001", "AUD")
+	if err == nil || errors.Is(err, domains.ErrNotFound) {
+		t.Errorf("missing balance error = %v, want a non-NotFound error", err)
+	}
+}
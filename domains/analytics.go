@@ -0,0 +1,352 @@
+package domains
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/shopspring/decimal"
+)
+
+// InsightsOptions selects the date range and period length for account insights.
+// Dates are calendar days in UTC and both are inclusive.
+type InsightsOptions struct {
+	FromDate *time.Time           `json:"fromDate,omitempty"` // Default: three months before ToDate
+	ToDate   *time.Time           `json:"toDate,omitempty"`   // Default: today
+	Period   models.InsightPeriod `json:"period,omitempty"`   // Default: MONTH
+}
+
+// MaxInsightPeriods is the largest number of periods one insights request may cover
+const MaxInsightPeriods = 366
+
+// Validate checks the period and that the date range is ordered and not too long
+func (o InsightsOptions) Validate() error {
+	var v models.FieldErrors
+	if o.Period != "" && !o.Period.IsValid() {
+		v.Add("period", "must be DAY, WEEK or MONTH")
+		return v.Err()
+	}
+
+	from, to, period := o.resolve(time.Now())
+	switch {
+	case to.Before(from):
+		v.Add("toDate", "must not be before fromDate")
+	case periodCount(from, to, period) > MaxInsightPeriods:
+		v.Add("fromDate", "range covers more than %d %s periods", MaxInsightPeriods, period)
+	}
+	return v.Err()
+}
+
+// resolve applies the defaults and truncates both dates to UTC days
+func (o InsightsOptions) resolve(now time.Time) (from, to time.Time, period models.InsightPeriod) {
+	to = utcDay(now)
+	if o.ToDate != nil {
+		to = utcDay(*o.ToDate)
+	}
+	from = to.AddDate(0, -3, 0)
+	if o.FromDate != nil {
+		from = utcDay(*o.FromDate)
+	}
+	period = o.Period
+	if period == "" {
+		period = models.InsightPeriodMonth
+	}
+	return from, to, period
+}
+
+// AnalyticsService defines operations for account spending and cash-flow insights following BIAN Customer Behavior Insights service domain.
+// This interface implements a subset of BIAN v13.0.0 operations focused on read-only insight retrieval.
+//
+// BIAN Alignment:
+// - RetrieveAccountInsights maps to BIAN "Retrieve Customer Behavior Insights" operation (account cash flow)
+type AnalyticsService interface {
+	// RetrieveAccountInsights summarises an account's transactions over a date range.
+	//
+	// BIAN Operation: Retrieve Customer Behavior Insights
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - accountID: Unique identifier for the account
+	//   - opts: Date range and period length
+	//
+	// Returns:
+	//   - Cash flow in total, per period and by transaction type, category and merchant
+	//   - Error if account not found, invalid parameters, or internal error
+	RetrieveAccountInsights(ctx context.Context, accountID string, opts InsightsOptions) (*models.AccountInsights, error)
+}
+
+// TransactionAnalytics implements AnalyticsService by aggregating the
+// transaction history of a TransactionService. Wrap the TransactionService in
+// an EnrichingTransactionService to group transactions by category.
+type TransactionAnalytics struct {
+	transactionService TransactionService
+}
+
+// Ensure TransactionAnalytics implements the analytics interface
+var _ AnalyticsService = (*TransactionAnalytics)(nil)
+
+// NewTransactionAnalytics creates analytics over a transaction service
+func NewTransactionAnalytics(transactionService TransactionService) *TransactionAnalytics {
+	return &TransactionAnalytics{transactionService: transactionService}
+}
+
+// RetrieveAccountInsights validates the options, then aggregates every transaction posted in the range
+func (a *TransactionAnalytics) RetrieveAccountInsights(ctx context.Context, accountID string, opts InsightsOptions) (*models.AccountInsights, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	from, to, period := opts.resolve(time.Now())
+
+	transactions, err := a.history(ctx, accountID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	starts := periodStarts(from, to, period)
+	perPeriod := make([]cashFlows, len(starts))
+	for i := range perPeriod {
+		perPeriod[i] = cashFlows{}
+	}
+	totals := cashFlows{}
+	byType := map[string]cashFlows{}
+	byCategory := map[string]cashFlows{}
+	byMerchant := map[string]cashFlows{}
+
+	for _, tx := range transactions {
+		day := utcDay(tx.PostingDate)
+		if day.Before(from) || day.After(to) {
+			continue
+		}
+		// The last period starting on or before the posting date
+		i := sort.Search(len(starts), func(i int) bool { return starts[i].After(day) }) - 1
+		perPeriod[i].add(tx.Amount)
+		totals.add(tx.Amount)
+		group(byType, string(tx.TransactionType)).add(tx.Amount)
+
+		category := string(tx.Category)
+		if category == "" {
+			category = models.UncategorisedKey
+		}
+		group(byCategory, category).add(tx.Amount)
+
+		merchant := tx.CleanMerchantName
+		if merchant == "" {
+			merchant = strings.TrimSpace(tx.MerchantName)
+		}
+		if merchant != "" {
+			group(byMerchant, merchant).add(tx.Amount)
+		}
+	}
+
+	insights := &models.AccountInsights{
+		AccountID:      accountID,
+		FromDate:       from,
+		ToDate:         to,
+		Period:         period,
+		Totals:         totals.summary(),
+		PeriodAverages: totals.averages(len(starts)),
+		Periods:        make([]models.PeriodInsight, len(starts)),
+		ByType:         groups(byType, false),
+		ByCategory:     groups(byCategory, false),
+		ByMerchant:     groups(byMerchant, true),
+	}
+	for i, start := range starts {
+		// Periods are clipped to the requested range
+		end := nextPeriod(start, period).AddDate(0, 0, -1)
+		if end.After(to) {
+			end = to
+		}
+		if start.Before(from) {
+			start = from
+		}
+		insights.Periods[i] = models.PeriodInsight{
+			StartDate: start,
+			EndDate:   end,
+			CashFlow:  perPeriod[i].summary(),
+		}
+	}
+	return insights, nil
+}
+
+// history retrieves every transaction posted from the start of from to the end of to
+func (a *TransactionAnalytics) history(ctx context.Context, accountID string, from, to time.Time) ([]*models.Transaction, error) {
+	endOfDay := to.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	opts := HistoryOptions{FromDate: &from, ToDate: &endOfDay, Limit: MaxHistoryLimit}
+
+	var transactions []*models.Transaction
+	for {
+		page, err := a.transactionService.RetrievePaymentTransactionHistory(ctx, accountID, opts)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, page...)
+		if len(page) < opts.Limit {
+			return transactions, nil
+		}
+		opts.Offset += len(page)
+	}
+}
+
+// flow accumulates the cash flow of one currency
+type flow struct {
+	count   int
+	inflow  decimal.Decimal
+	outflow decimal.Decimal
+}
+
+// cashFlows accumulates cash flow per currency
+type cashFlows map[string]*flow
+
+func (c cashFlows) add(amount models.Money) {
+	f, exists := c[amount.Currency]
+	if !exists {
+		f = &flow{}
+		c[amount.Currency] = f
+	}
+	f.count++
+	if amount.IsNegative() {
+		f.outflow = f.outflow.Sub(amount.Amount)
+	} else {
+		f.inflow = f.inflow.Add(amount.Amount)
+	}
+}
+
+// currencies returns the currencies seen, sorted
+func (c cashFlows) currencies() []string {
+	currencies := make([]string, 0, len(c))
+	for currency := range c {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// count returns the number of transactions across all currencies
+func (c cashFlows) count() int {
+	n := 0
+	for _, f := range c {
+		n += f.count
+	}
+	return n
+}
+
+// summary returns the cash flow per currency
+func (c cashFlows) summary() []models.CashFlow {
+	summary := make([]models.CashFlow, 0, len(c))
+	for _, currency := range c.currencies() {
+		f := c[currency]
+		net := f.inflow.Sub(f.outflow)
+		summary = append(summary, models.CashFlow{
+			Currency: currency,
+			Count:    f.count,
+			Inflow:   models.Money{Amount: f.inflow, Currency: currency},
+			Outflow:  models.Money{Amount: f.outflow, Currency: currency},
+			Net:      models.Money{Amount: net, Currency: currency},
+			Average:  models.Money{Amount: net.Div(decimal.NewFromInt(int64(f.count))), Currency: currency}.Round(),
+		})
+	}
+	return summary
+}
+
+// averages returns the cash flow per currency averaged over n periods
+func (c cashFlows) averages(n int) []models.PeriodAverage {
+	averages := make([]models.PeriodAverage, 0, len(c))
+	periods := decimal.NewFromInt(int64(n))
+	for _, currency := range c.currencies() {
+		f := c[currency]
+		average := func(amount decimal.Decimal) models.Money {
+			return models.Money{Amount: amount.Div(periods), Currency: currency}.Round()
+		}
+		averages = append(averages, models.PeriodAverage{
+			Currency: currency,
+			Inflow:   average(f.inflow),
+			Outflow:  average(f.outflow),
+			Net:      average(f.inflow.Sub(f.outflow)),
+		})
+	}
+	return averages
+}
+
+// group returns the cash flows for key, creating them on first use
+func group(groups map[string]cashFlows, key string) cashFlows {
+	flows, exists := groups[key]
+	if !exists {
+		flows = cashFlows{}
+		groups[key] = flows
+	}
+	return flows
+}
+
+// groups summarises each group, sorted by key or, when mostFrequentFirst,
+// by descending transaction count and then key
+func groups(flows map[string]cashFlows, mostFrequentFirst bool) []models.InsightGroup {
+	keys := make([]string, 0, len(flows))
+	for key := range flows {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if mostFrequentFirst {
+			if countI, countJ := flows[keys[i]].count(), flows[keys[j]].count(); countI != countJ {
+				return countI > countJ
+			}
+		}
+		return keys[i] < keys[j]
+	})
+
+	result := make([]models.InsightGroup, len(keys))
+	for i, key := range keys {
+		result[i] = models.InsightGroup{Key: key, CashFlow: flows[key].summary()}
+	}
+	return result
+}
+
+// periodStart returns the first day of the period containing day: the day
+// itself, the Monday of its ISO week or the first of its month
+func periodStart(day time.Time, period models.InsightPeriod) time.Time {
+	switch period {
+	case models.InsightPeriodWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case models.InsightPeriodMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day
+}
+
+// nextPeriod returns the first day of the period after the one starting on start
+func nextPeriod(start time.Time, period models.InsightPeriod) time.Time {
+	switch period {
+	case models.InsightPeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case models.InsightPeriodMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// periodStarts returns the start of every period overlapping from to to
+func periodStarts(from, to time.Time, period models.InsightPeriod) []time.Time {
+	var starts []time.Time
+	for start := periodStart(from, period); !start.After(to); start = nextPeriod(start, period) {
+		starts = append(starts, start)
+	}
+	return starts
+}
+
+// periodCount returns the number of periods overlapping from to to without listing them
+func periodCount(from, to time.Time, period models.InsightPeriod) int {
+	first, last := periodStart(from, period), periodStart(to, period)
+	switch period {
+	case models.InsightPeriodMonth:
+		return (last.Year()-first.Year())*12 + int(last.Month()-first.Month()) + 1
+	case models.InsightPeriodWeek:
+		return int(last.Sub(first).Hours()/24)/7 + 1
+	}
+	return int(last.Sub(first).Hours()/24) + 1
+}
+
+// utcDay returns midnight UTC on t's calendar date in UTC
+func utcDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,157 @@
+// Mappings between the BIAN v13.0.0 CurrentAccount control records and the
+// models types. Started from a bian-gen stub; bian-gen never overwrites this file.
+
+package currentaccount
+
+import (
+	"strings"
+
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/models/identifiers"
+)
+
+var accountTypes = map[models.AccountType]AccountType{
+	models.AccountTypeChecking:   AccountTypeChecking,
+	models.AccountTypeSavings:    AccountTypeSavings,
+	models.AccountTypeCreditCard: AccountTypeCreditCard,
+	models.AccountTypeInvestment: AccountTypeInvestment,
+}
+
+var accountStatuses = map[models.AccountStatus]AccountStatus{
+	models.AccountStatusOpen:      AccountStatusOpen,
+	models.AccountStatusClosed:    AccountStatusClosed,
+	models.AccountStatusSuspended: AccountStatusSuspended,
+}
+
+var transactionTypes = map[models.TransactionType]PaymentTransactionType{
+	models.TransactionTypeDebit:    PaymentTransactionTypeDebit,
+	models.TransactionTypeCredit:   PaymentTransactionTypeCredit,
+	models.TransactionTypeTransfer: PaymentTransactionTypeTransfer,
+	models.TransactionTypePayment:  PaymentTransactionTypePayment,
+	models.TransactionTypeFee:      PaymentTransactionTypeFee,
+}
+
+// modelValue returns the key mapped to v, or the zero key when none is
+func modelValue[K, V comparable](values map[K]V, v V) K {
+	for key, value := range values {
+		if value == v {
+			return key
+		}
+	}
+	var zero K
+	return zero
+}
+
+// CurrentAccountFacilityFromModel maps a models.Account to a CurrentAccountFacility.
+// An IBAN or BSB identifier is reported as such, a BSB as "062-000 123456789";
+// otherwise the account number is reported as a BBAN. CustomerReference and
+// PositionLimitSettings have no models.Account counterpart and are left empty.
+func CurrentAccountFacilityFromModel(m *models.Account) *CurrentAccountFacility {
+	cr := &CurrentAccountFacility{
+		AccountCurrency:          m.Currency,
+		AccountDateClosed:        m.CloseDate,
+		AccountStatus:            accountStatuses[m.Status],
+		AccountType:              accountTypes[m.AccountType],
+		ProductInstanceReference: m.ID,
+		ProductName:              m.ProductName,
+	}
+	if !m.OpenDate.IsZero() {
+		opened := m.OpenDate
+		cr.AccountDateOpened = &opened
+	}
+	cr.AccountIdentification = accountIdentification(m)
+	return cr
+}
+
+// accountIdentification identifies the account by IBAN, BSB or BBAN, or
+// returns nil when it has no account number
+func accountIdentification(m *models.Account) *AccountIdentification {
+	if id := m.Identifier; id != nil {
+		switch id.Scheme {
+		case identifiers.SchemeIBAN:
+			return &AccountIdentification{AccountIdentification: id.IBAN, AccountIdentificationType: AccountIdentificationTypeIBAN}
+		case identifiers.SchemeBSB:
+			return &AccountIdentification{AccountIdentification: id.BankCode + " " + id.AccountNumber, AccountIdentificationType: AccountIdentificationTypeBSB}
+		}
+	}
+	if m.AccountNumber == "" {
+		return nil
+	}
+	return &AccountIdentification{AccountIdentification: m.AccountNumber, AccountIdentificationType: AccountIdentificationTypeBBAN}
+}
+
+// CurrentAccountFacilityToModel maps a CurrentAccountFacility to a models.Account.
+// Nickname, ProductID and identifiers other than IBAN and BSB are not carried
+// by the control record; enum values without a models counterpart map to the
+// empty value.
+func CurrentAccountFacilityToModel(cr *CurrentAccountFacility) *models.Account {
+	m := &models.Account{
+		ID:          cr.ProductInstanceReference,
+		AccountType: modelValue(accountTypes, cr.AccountType),
+		ProductName: cr.ProductName,
+		Status:      modelValue(accountStatuses, cr.AccountStatus),
+		CloseDate:   cr.AccountDateClosed,
+		Currency:    cr.AccountCurrency,
+	}
+	if cr.AccountDateOpened != nil {
+		m.OpenDate = *cr.AccountDateOpened
+	}
+	if id := cr.AccountIdentification; id != nil {
+		m.AccountNumber = id.AccountIdentification
+		switch id.AccountIdentificationType {
+		case AccountIdentificationTypeIBAN:
+			m.Identifier = &models.AccountIdentifier{Scheme: identifiers.SchemeIBAN, IBAN: id.AccountIdentification}
+		case AccountIdentificationTypeBSB:
+			if bsb, number, ok := strings.Cut(id.AccountIdentification, " "); ok {
+				m.AccountNumber = number
+				m.Identifier = &models.AccountIdentifier{Scheme: identifiers.SchemeBSB, BankCode: bsb, AccountNumber: number}
+			}
+		}
+	}
+	return m
+}
+
+// PaymentsFromModel maps a models.Transaction to a Payments, referenced by the
+// transaction ID. The models types do not record the payee, so
+// PayeeBankReference and PayeeReference are left empty.
+func PaymentsFromModel(m *models.Transaction) *Payments {
+	cr := &Payments{
+		PaymentTransactionAmount: Amount{
+			AmountCurrency: m.Amount.Currency,
+			AmountValue:    m.Amount.Amount,
+		},
+		PaymentTransactionDescription: m.Description,
+		PaymentTransactionReference:   m.ID,
+		PaymentTransactionType:        transactionTypes[m.TransactionType],
+	}
+	if !m.PostingDate.IsZero() {
+		posted := m.PostingDate
+		cr.PaymentTransactionDate = &posted
+	}
+	if !m.ValueDate.IsZero() {
+		value := m.ValueDate
+		cr.PaymentTransactionValueDate = &value
+	}
+	return cr
+}
+
+// PaymentsToModel maps a Payments to a models.Transaction. Payments do not
+// carry the account, so callers set AccountID.
+func PaymentsToModel(cr *Payments) *models.Transaction {
+	m := &models.Transaction{
+		ID:              cr.PaymentTransactionReference,
+		TransactionType: modelValue(transactionTypes, cr.PaymentTransactionType),
+		Amount: models.Money{
+			Amount:   cr.PaymentTransactionAmount.AmountValue,
+			Currency: cr.PaymentTransactionAmount.AmountCurrency,
+		},
+		Description: cr.PaymentTransactionDescription,
+	}
+	if cr.PaymentTransactionDate != nil {
+		m.PostingDate = *cr.PaymentTransactionDate
+	}
+	if cr.PaymentTransactionValueDate != nil {
+		m.ValueDate = *cr.PaymentTransactionValueDate
+	}
+	return m
+}
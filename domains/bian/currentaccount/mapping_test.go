@@ -0,0 +1,76 @@
+package currentaccount
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/models/identifiers"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+func TestCurrentAccountFacilityMapping(t *testing.T) {
+	provider := mock.NewProvider()
+	account, err := provider.RetrieveCurrentAccount(context.Background(), "acc-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cr := CurrentAccountFacilityFromModel(account)
+	if cr.ProductInstanceReference != "acc-001" || cr.AccountType != AccountTypeChecking || cr.AccountStatus != AccountStatusOpen {
+		t.Errorf("CurrentAccountFacilityFromModel() = %+v", cr)
+	}
+	if id := cr.AccountIdentification; id.AccountIdentificationType != AccountIdentificationTypeBSB || id.AccountIdentification != "062-000 123456789" {
+		t.Errorf("AccountIdentification = %+v, want the BSB and account number", id)
+	}
+	// Only the fields the control record carries come back
+	want := *account
+	want.Nickname, want.ProductID = "", ""
+	if got := CurrentAccountFacilityToModel(cr); !reflect.DeepEqual(*got, want) {
+		t.Errorf("round trip = %+v, want %+v", *got, want)
+	}
+
+	// IBAN identifiers survive the round trip
+	iban := &models.Account{
+		ID:            "acc-010",
+		AccountNumber: "GB82WEST12345698765432",
+		Identifier:    &models.AccountIdentifier{Scheme: identifiers.SchemeIBAN, IBAN: "GB82WEST12345698765432"},
+		AccountType:   models.AccountTypeSavings,
+		Status:        models.AccountStatusSuspended,
+		Currency:      "GBP",
+	}
+	cr = CurrentAccountFacilityFromModel(iban)
+	if cr.AccountIdentification.AccountIdentificationType != AccountIdentificationTypeIBAN {
+		t.Errorf("AccountIdentification = %+v, want an IBAN", cr.AccountIdentification)
+	}
+	if got := CurrentAccountFacilityToModel(cr); !reflect.DeepEqual(got, iban) {
+		t.Errorf("round trip = %+v, want %+v", got, iban)
+	}
+}
+
+func TestPaymentsMapping(t *testing.T) {
+	provider := mock.NewProvider()
+	tx, err := provider.RetrievePaymentTransaction(context.Background(), "tx-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cr := PaymentsFromModel(tx)
+	if cr.PaymentTransactionReference != "tx-001" || cr.PaymentTransactionType != PaymentTransactionTypeDebit || !cr.PaymentTransactionAmount.AmountValue.Equal(tx.Amount.Amount) {
+		t.Errorf("PaymentsFromModel() = %+v", cr)
+	}
+
+	// Only the fields Payments carries come back
+	wantTx := &models.Transaction{
+		ID:              tx.ID,
+		TransactionType: tx.TransactionType,
+		Amount:          tx.Amount,
+		Description:     tx.Description,
+		PostingDate:     tx.PostingDate,
+		ValueDate:       tx.ValueDate,
+	}
+	if got := PaymentsToModel(cr); !reflect.DeepEqual(got, wantTx) {
+		t.Errorf("round trip = %+v, want %+v", got, wantTx)
+	}
+}
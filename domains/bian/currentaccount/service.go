@@ -0,0 +1,27 @@
+// Code generated by bian-gen from BIAN v13.0.0 CurrentAccount.yaml. DO NOT EDIT.
+
+package currentaccount
+
+import "context"
+
+// Service is the CurrentAccount service domain.
+//
+// Fulfill the arrangement for a current account, which provides a range of
+// payment and cash services and may have an associated overdraft facility.
+type Service interface {
+	// InitiateCurrentAccountPayments handles POST /CurrentAccount/{cr-reference-id}/Payments/Initiate.
+	// Initiate a payment from the current account.
+	InitiateCurrentAccountPayments(ctx context.Context, crReferenceID string, body *Payments) (*Payments, error)
+
+	// RetrieveCurrentAccountPayments handles GET /CurrentAccount/{cr-reference-id}/Payments/{bq-reference-id}/Retrieve.
+	// Retrieve details about a payment on the current account.
+	RetrieveCurrentAccountPayments(ctx context.Context, crReferenceID string, bqReferenceID string) (*Payments, error)
+
+	// RetrieveCurrentAccountFacility handles GET /CurrentAccount/{cr-reference-id}/Retrieve.
+	// Retrieve details about a current account arrangement.
+	RetrieveCurrentAccountFacility(ctx context.Context, crReferenceID string) (*CurrentAccountFacility, error)
+
+	// UpdateCurrentAccountFacility handles PUT /CurrentAccount/{cr-reference-id}/Update.
+	// Update details of a current account arrangement.
+	UpdateCurrentAccountFacility(ctx context.Context, crReferenceID string, body *CurrentAccountFacility) (*CurrentAccountFacility, error)
+}
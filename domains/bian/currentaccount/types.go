@@ -0,0 +1,110 @@
+// Code generated by bian-gen from BIAN v13.0.0 CurrentAccount.yaml. DO NOT EDIT.
+
+// Package currentaccount contains the types and service interface of the BIAN v13.0.0
+// CurrentAccount service domain.
+package currentaccount
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// AccountIdentification is generated from the AccountIdentification schema.
+//
+// Identifies an account under an identification scheme
+type AccountIdentification struct {
+	AccountIdentification     string                    `json:"AccountIdentification,omitempty"`
+	AccountIdentificationType AccountIdentificationType `json:"AccountIdentificationType,omitempty"`
+}
+
+// AccountIdentificationType is generated from the AccountIdentificationType schema.
+type AccountIdentificationType string
+
+// AccountIdentificationType values
+const (
+	AccountIdentificationTypeBBAN AccountIdentificationType = "BBAN"
+	AccountIdentificationTypeIBAN AccountIdentificationType = "IBAN"
+	AccountIdentificationTypeUPIC AccountIdentificationType = "UPIC"
+	AccountIdentificationTypeBSB  AccountIdentificationType = "BSB"
+)
+
+// AccountStatus is generated from the AccountStatus schema.
+type AccountStatus string
+
+// AccountStatus values
+const (
+	AccountStatusOpen      AccountStatus = "Open"
+	AccountStatusClosed    AccountStatus = "Closed"
+	AccountStatusSuspended AccountStatus = "Suspended"
+)
+
+// AccountType is generated from the AccountType schema.
+type AccountType string
+
+// AccountType values
+const (
+	AccountTypeChecking   AccountType = "Checking"
+	AccountTypeSavings    AccountType = "Savings"
+	AccountTypeCreditCard AccountType = "CreditCard"
+	AccountTypeInvestment AccountType = "Investment"
+)
+
+// Amount is generated from the Amount schema.
+//
+// A monetary amount in a currency
+type Amount struct {
+	AmountCurrency string          `json:"AmountCurrency"`
+	AmountValue    decimal.Decimal `json:"AmountValue"`
+}
+
+// CurrentAccountFacility is generated from the CurrentAccountFacility schema.
+//
+// The arrangement governing a current account
+type CurrentAccountFacility struct {
+	AccountCurrency       string                 `json:"AccountCurrency"`
+	AccountDateClosed     *time.Time             `json:"AccountDateClosed,omitempty"`
+	AccountDateOpened     *time.Time             `json:"AccountDateOpened,omitempty"`
+	AccountIdentification *AccountIdentification `json:"AccountIdentification,omitempty"`
+	AccountStatus         AccountStatus          `json:"AccountStatus,omitempty"`
+	AccountType           AccountType            `json:"AccountType"`
+	CustomerReference     string                 `json:"CustomerReference,omitempty"`
+	// Limits applied to the account position
+	PositionLimitSettings    *CurrentAccountFacilityPositionLimitSettings `json:"PositionLimitSettings,omitempty"`
+	ProductInstanceReference string                                       `json:"ProductInstanceReference"`
+	ProductName              string                                       `json:"ProductName,omitempty"`
+}
+
+// CurrentAccountFacilityPositionLimitSettings is generated from the CurrentAccountFacilityPositionLimitSettings schema.
+//
+// Limits applied to the account position
+type CurrentAccountFacilityPositionLimitSettings struct {
+	PositionLimitType  string  `json:"PositionLimitType,omitempty"`
+	PositionLimitValue *Amount `json:"PositionLimitValue,omitempty"`
+}
+
+// PaymentTransactionType is generated from the PaymentTransactionType schema.
+type PaymentTransactionType string
+
+// PaymentTransactionType values
+const (
+	PaymentTransactionTypeDebit    PaymentTransactionType = "Debit"
+	PaymentTransactionTypeCredit   PaymentTransactionType = "Credit"
+	PaymentTransactionTypeTransfer PaymentTransactionType = "Transfer"
+	PaymentTransactionTypePayment  PaymentTransactionType = "Payment"
+	PaymentTransactionTypeFee      PaymentTransactionType = "Fee"
+)
+
+// Payments is generated from the Payments schema.
+//
+// A payment made from or received into the current account
+type Payments struct {
+	PayeeBankReference            string                 `json:"PayeeBankReference,omitempty"`
+	PayeeReference                string                 `json:"PayeeReference,omitempty"`
+	PaymentTransactionAmount      Amount                 `json:"PaymentTransactionAmount"`
+	PaymentTransactionDate        *time.Time             `json:"PaymentTransactionDate,omitempty"`
+	PaymentTransactionDescription string                 `json:"PaymentTransactionDescription,omitempty"`
+	PaymentTransactionReference   string                 `json:"PaymentTransactionReference,omitempty"`
+	PaymentTransactionType        PaymentTransactionType `json:"PaymentTransactionType,omitempty"`
+	PaymentTransactionValueDate   *time.Time             `json:"PaymentTransactionValueDate,omitempty"`
+}
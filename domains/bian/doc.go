@@ -0,0 +1,6 @@
+// Package bian holds the packages generated from the vendored BIAN semantic
+// API OpenAPI files in third_party/bian, one per service domain. Run
+// go generate after changing .bian-version or the vendored files.
+package bian
+
+//go:generate go run ../../cmd/bian-gen generate -version-file ../../.bian-version -specs ../../third_party/bian -out .
@@ -0,0 +1,86 @@
+// Mappings between the BIAN v13.0.0 PaymentExecution control records and the
+// models types. Started from a bian-gen stub; bian-gen never overwrites this file.
+
+package paymentexecution
+
+import "github.com/serverlesscloud/bian-go/models"
+
+// transactionTypes are the PaymentTransactionType values of the BIAN
+// CurrentAccount domain, which PaymentExecution leaves as a free string
+var transactionTypes = map[models.TransactionType]string{
+	models.TransactionTypeDebit:    "Debit",
+	models.TransactionTypeCredit:   "Credit",
+	models.TransactionTypeTransfer: "Transfer",
+	models.TransactionTypePayment:  "Payment",
+	models.TransactionTypeFee:      "Fee",
+}
+
+// transactionType returns the models type for a PaymentTransactionType value
+func transactionType(value string) models.TransactionType {
+	for key, v := range transactionTypes {
+		if v == value {
+			return key
+		}
+	}
+	return ""
+}
+
+// PaymentExecutionProcedureFromModel maps a posted models.Transaction to an
+// Executed PaymentExecutionProcedure, referenced by the transaction ID. The
+// account pays outgoing (negative) amounts and receives incoming ones; the
+// models types do not record the counterparty, so its references are left empty.
+func PaymentExecutionProcedureFromModel(m *models.Transaction) *PaymentExecutionProcedure {
+	cr := &PaymentExecutionProcedure{
+		PaymentExecutionStatus: PaymentExecutionStatusExecuted,
+		PaymentTransaction: PaymentExecutionProcedurePaymentTransaction{
+			PaymentTransactionAmount: Amount{
+				AmountCurrency: m.Amount.Currency,
+				AmountValue:    m.Amount.Amount,
+			},
+			PaymentTransactionDescription: m.Description,
+			PaymentTransactionReference:   m.ID,
+			PaymentTransactionType:        transactionTypes[m.TransactionType],
+		},
+	}
+	if m.Amount.IsNegative() {
+		cr.PayerProductInstanceReference = m.AccountID
+	} else {
+		cr.PayeeProductInstanceReference = m.AccountID
+	}
+	if !m.PostingDate.IsZero() {
+		posted := m.PostingDate
+		cr.PaymentTransaction.PaymentTransactionDate = &posted
+	}
+	if !m.ValueDate.IsZero() {
+		value := m.ValueDate
+		cr.PaymentTransaction.PaymentTransactionValueDate = &value
+	}
+	return cr
+}
+
+// PaymentExecutionProcedureToModel maps a PaymentExecutionProcedure to a
+// models.Transaction on the paying account, or the receiving account when no
+// payer is given.
+func PaymentExecutionProcedureToModel(cr *PaymentExecutionProcedure) *models.Transaction {
+	tx := cr.PaymentTransaction
+	m := &models.Transaction{
+		ID:              tx.PaymentTransactionReference,
+		TransactionType: transactionType(tx.PaymentTransactionType),
+		Amount: models.Money{
+			Amount:   tx.PaymentTransactionAmount.AmountValue,
+			Currency: tx.PaymentTransactionAmount.AmountCurrency,
+		},
+		Description: tx.PaymentTransactionDescription,
+		AccountID:   cr.PayerProductInstanceReference,
+	}
+	if m.AccountID == "" {
+		m.AccountID = cr.PayeeProductInstanceReference
+	}
+	if tx.PaymentTransactionDate != nil {
+		m.PostingDate = *tx.PaymentTransactionDate
+	}
+	if tx.PaymentTransactionValueDate != nil {
+		m.ValueDate = *tx.PaymentTransactionValueDate
+	}
+	return m
+}
@@ -0,0 +1,54 @@
+package paymentexecution
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+func TestPaymentExecutionProcedureMapping(t *testing.T) {
+	provider := mock.NewProvider()
+
+	tests := []struct {
+		id        string
+		wantPayer string
+		wantPayee string
+	}{
+		{"tx-001", "acc-001", ""}, // debit
+		{"tx-002", "", "acc-001"}, // credit
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			tx, err := provider.RetrievePaymentTransaction(context.Background(), tt.id)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cr := PaymentExecutionProcedureFromModel(tx)
+			if cr.PayerProductInstanceReference != tt.wantPayer || cr.PayeeProductInstanceReference != tt.wantPayee {
+				t.Errorf("payer = %q, payee = %q; want %q, %q", cr.PayerProductInstanceReference, cr.PayeeProductInstanceReference, tt.wantPayer, tt.wantPayee)
+			}
+			if cr.PaymentExecutionStatus != PaymentExecutionStatusExecuted || cr.PaymentTransaction.PaymentTransactionReference != tt.id {
+				t.Errorf("procedure = %+v, want Executed with reference %s", cr, tt.id)
+			}
+
+			// Only the fields the procedure carries come back
+			want := &models.Transaction{
+				ID:              tx.ID,
+				TransactionType: tx.TransactionType,
+				Amount:          tx.Amount,
+				Description:     tx.Description,
+				PostingDate:     tx.PostingDate,
+				ValueDate:       tx.ValueDate,
+				AccountID:       tx.AccountID,
+			}
+			if got := PaymentExecutionProcedureToModel(cr); !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Code generated by bian-gen from BIAN v13.0.0 PaymentExecution.yaml. DO NOT EDIT.
+
+package paymentexecution
+
+import "context"
+
+// Service is the PaymentExecution service domain.
+//
+// Execute the payment transactions requested by other service domains,
+// applying any clearing and settlement mechanism required.
+type Service interface {
+	// InitiatePaymentExecutionProcedure handles POST /PaymentExecution/Initiate.
+	// Initiate a payment execution procedure.
+	InitiatePaymentExecutionProcedure(ctx context.Context, body *PaymentExecutionProcedure) (*PaymentExecutionProcedure, error)
+
+	// RetrievePaymentExecutionProcedure handles GET /PaymentExecution/{cr-reference-id}/Retrieve.
+	// Retrieve details about a payment execution procedure.
+	RetrievePaymentExecutionProcedure(ctx context.Context, crReferenceID string) (*PaymentExecutionProcedure, error)
+}
@@ -0,0 +1,55 @@
+// Code generated by bian-gen from BIAN v13.0.0 PaymentExecution.yaml. DO NOT EDIT.
+
+// Package paymentexecution contains the types and service interface of the BIAN v13.0.0
+// PaymentExecution service domain.
+package paymentexecution
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Amount is generated from the Amount schema.
+//
+// A monetary amount in a currency
+type Amount struct {
+	AmountCurrency string          `json:"AmountCurrency"`
+	AmountValue    decimal.Decimal `json:"AmountValue"`
+}
+
+// PaymentExecutionProcedure is generated from the PaymentExecutionProcedure schema.
+//
+// The procedure executing a single payment transaction
+type PaymentExecutionProcedure struct {
+	PayeeProductInstanceReference string                 `json:"PayeeProductInstanceReference,omitempty"`
+	PayeeReference                string                 `json:"PayeeReference,omitempty"`
+	PayerProductInstanceReference string                 `json:"PayerProductInstanceReference,omitempty"`
+	PayerReference                string                 `json:"PayerReference,omitempty"`
+	PaymentExecutionStatus        PaymentExecutionStatus `json:"PaymentExecutionStatus,omitempty"`
+	// The payment transaction being executed
+	PaymentTransaction PaymentExecutionProcedurePaymentTransaction `json:"PaymentTransaction"`
+}
+
+// PaymentExecutionProcedurePaymentTransaction is generated from the PaymentExecutionProcedurePaymentTransaction schema.
+//
+// The payment transaction being executed
+type PaymentExecutionProcedurePaymentTransaction struct {
+	PaymentTransactionAmount      Amount     `json:"PaymentTransactionAmount"`
+	PaymentTransactionDate        *time.Time `json:"PaymentTransactionDate,omitempty"`
+	PaymentTransactionDescription string     `json:"PaymentTransactionDescription,omitempty"`
+	PaymentTransactionReference   string     `json:"PaymentTransactionReference,omitempty"`
+	PaymentTransactionType        string     `json:"PaymentTransactionType,omitempty"`
+	PaymentTransactionValueDate   *time.Time `json:"PaymentTransactionValueDate,omitempty"`
+}
+
+// PaymentExecutionStatus is generated from the PaymentExecutionStatus schema.
+type PaymentExecutionStatus string
+
+// PaymentExecutionStatus values
+const (
+	PaymentExecutionStatusInitiated PaymentExecutionStatus = "Initiated"
+	PaymentExecutionStatusExecuted  PaymentExecutionStatus = "Executed"
+	PaymentExecutionStatusRejected  PaymentExecutionStatus = "Rejected"
+	PaymentExecutionStatusReturned  PaymentExecutionStatus = "Returned"
+)
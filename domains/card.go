@@ -0,0 +1,39 @@
+package domains
+
+import (
+	"context"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// CardService defines operations for payment cards following BIAN Issued Device Administration and Credit Card service domains.
+// This interface implements a subset of BIAN v13.0.0 operations focused on read-only card and credit facility retrieval.
+//
+// BIAN Alignment:
+// - RetrieveCard maps to BIAN "Retrieve Issued Device Administration" operation
+// - RetrieveAccountCard maps to BIAN "Retrieve Credit Card Facility" operation (card and credit terms of an account)
+type CardService interface {
+	// RetrieveCard retrieves a card by its unique identifier.
+	//
+	// BIAN Operation: Retrieve Issued Device Administration
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - cardID: Unique identifier for the card
+	//
+	// Returns:
+	//   - Card with masked PAN, expiry, status and credit facility if any
+	//   - Error if card not found, access denied, or internal error
+	RetrieveCard(ctx context.Context, cardID string) (*models.Card, error)
+
+	// RetrieveAccountCard retrieves the card issued on an account.
+	//
+	// BIAN Operation: Retrieve Credit Card Facility
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - accountID: Unique identifier for the account
+	//
+	// Returns:
+	//   - Card issued on the account
+	//   - Error if the account does not exist or has no card (ErrNotFound), access denied, or internal error
+	RetrieveAccountCard(ctx context.Context, accountID string) (*models.Card, error)
+}
@@ -38,4 +38,25 @@ type ConsentService interface {
 	//   - Consent status if found
 	//   - Error if consent not found, access denied, or internal error
 	RetrieveConsentStatus(ctx context.Context, consentID string) (models.ConsentStatus, error)
-}
\ No newline at end of file
+}
+
+// AccountConsentRetriever is implemented by consent services that can list the
+// consents covering an account. It is optional: Account.consents is empty in
+// GraphQL when the configured ConsentService does not implement it.
+//
+// BIAN Alignment:
+// - RetrieveAccountConsents maps to BIAN "Retrieve Consent" operation (filtered by account)
+type AccountConsentRetriever interface {
+	// RetrieveAccountConsents retrieves all consents that cover an account.
+	// Returns an empty list if the account exists but has no consents.
+	//
+	// BIAN Operation: Retrieve Consent (filtered by account)
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - accountID: Unique identifier for the account
+	//
+	// Returns:
+	//   - List of consents covering the account (may be empty)
+	//   - Error if account not found, access denied, or internal error
+	RetrieveAccountConsents(ctx context.Context, accountID string) ([]*models.Consent, error)
+}
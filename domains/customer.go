@@ -0,0 +1,26 @@
+package domains
+
+import (
+	"context"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// CustomerService defines operations for customer account associations following BIAN Customer Position service domain.
+// This interface implements a subset of BIAN v13.0.0 operations focused on read-only position retrieval.
+//
+// BIAN Alignment:
+// - RetrieveCustomerAccounts maps to BIAN "Retrieve Customer Position" operation (account holdings)
+type CustomerService interface {
+	// RetrieveCustomerAccounts retrieves all accounts held by a customer.
+	//
+	// BIAN Operation: Retrieve Customer Position
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - customerID: Unique identifier for the customer
+	//
+	// Returns:
+	//   - List of accounts held by the customer (may be empty)
+	//   - Error if customer not found, access denied, or internal error
+	RetrieveCustomerAccounts(ctx context.Context, customerID string) ([]*models.Account, error)
+}
@@ -0,0 +1,39 @@
+package domains
+
+import (
+	"context"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// DirectDebitService defines operations for direct debits following BIAN Direct Debit Mandate service domain.
+// This interface implements a subset of BIAN v13.0.0 operations focused on read-only mandate retrieval.
+//
+// BIAN Alignment:
+// - RetrieveDirectDebit maps to BIAN "Retrieve Direct Debit Mandate" operation
+// - RetrieveAccountDirectDebits maps to BIAN "Retrieve Direct Debit Mandate" operation (all mandates on an account)
+type DirectDebitService interface {
+	// RetrieveDirectDebit retrieves a direct debit by its unique identifier.
+	//
+	// BIAN Operation: Retrieve Direct Debit Mandate
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - directDebitID: Unique identifier for the direct debit
+	//
+	// Returns:
+	//   - Scheduled payment of type DIRECT_DEBIT with its mandate reference and next expected collection
+	//   - Error if direct debit not found, access denied, or internal error
+	RetrieveDirectDebit(ctx context.Context, directDebitID string) (*models.ScheduledPayment, error)
+
+	// RetrieveAccountDirectDebits retrieves the direct debits collected from an account.
+	//
+	// BIAN Operation: Retrieve Direct Debit Mandate
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - accountID: Unique identifier for the account
+	//
+	// Returns:
+	//   - List of direct debits ordered by next execution date (may be empty)
+	//   - Error if account not found, access denied, or internal error
+	RetrieveAccountDirectDebits(ctx context.Context, accountID string) ([]*models.ScheduledPayment, error)
+}
@@ -0,0 +1,102 @@
+package domains
+
+import (
+	"context"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// Enricher derives details a provider does not supply, such as a
+// transaction's category, merchant category code and clean merchant name.
+// Implementations range from local rules to external categorisation APIs.
+type Enricher interface {
+	// Enrich fills in the enrichment fields of tx. It is given a copy of the
+	// provider's transaction, so it may modify tx freely. Fields the enricher
+	// cannot derive are left empty rather than reported as errors; errors are
+	// reserved for failures such as an unreachable categorisation service.
+	Enrich(ctx context.Context, tx *models.Transaction) error
+}
+
+// EnrichingTransactionService decorates a TransactionService, running every
+// transaction it returns through an Enricher
+type EnrichingTransactionService struct {
+	next     TransactionService
+	enricher Enricher
+}
+
+// Ensure the enriching decorator implements the interface it wraps
+var _ TransactionService = (*EnrichingTransactionService)(nil)
+
+// NewEnrichingTransactionService wraps a TransactionService with enrichment
+func NewEnrichingTransactionService(next TransactionService, enricher Enricher) *EnrichingTransactionService {
+	return &EnrichingTransactionService{next: next, enricher: enricher}
+}
+
+// RetrievePaymentTransaction retrieves and enriches a transaction
+func (s *EnrichingTransactionService) RetrievePaymentTransaction(ctx context.Context, transactionID string) (*models.Transaction, error) {
+	transaction, err := s.next.RetrievePaymentTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return s.enrich(ctx, transaction)
+}
+
+// RetrievePaymentTransactionHistory retrieves and enriches each transaction
+func (s *EnrichingTransactionService) RetrievePaymentTransactionHistory(ctx context.Context, accountID string, opts HistoryOptions) ([]*models.Transaction, error) {
+	transactions, err := s.next.RetrievePaymentTransactionHistory(ctx, accountID, opts)
+	if err != nil {
+		return nil, err
+	}
+	enriched := make([]*models.Transaction, len(transactions))
+	for i, transaction := range transactions {
+		if enriched[i], err = s.enrich(ctx, transaction); err != nil {
+			return nil, err
+		}
+	}
+	return enriched, nil
+}
+
+// enrich enriches a copy so the provider's transaction is never modified
+func (s *EnrichingTransactionService) enrich(ctx context.Context, transaction *models.Transaction) (*models.Transaction, error) {
+	enriched := *transaction
+	if err := s.enricher.Enrich(ctx, &enriched); err != nil {
+		return nil, err
+	}
+	return &enriched, nil
+}
+
+// EnrichingEventSource decorates an EventSource, enriching the transaction of
+// every TRANSACTION_POSTED event so subscribers see the same transaction as
+// EnrichingTransactionService serves. Events whose enrichment fails are
+// dropped, as an EventSource has no way to report the error.
+type EnrichingEventSource struct {
+	next     EventSource
+	enricher Enricher
+}
+
+// Ensure the enriching event decorator implements the interface it wraps
+var _ EventSource = (*EnrichingEventSource)(nil)
+
+// NewEnrichingEventSource wraps an EventSource with transaction enrichment
+func NewEnrichingEventSource(next EventSource, enricher Enricher) *EnrichingEventSource {
+	return &EnrichingEventSource{next: next, enricher: enricher}
+}
+
+// Subscribe subscribes to the wrapped source and enriches posted transactions
+func (s *EnrichingEventSource) Subscribe(ctx context.Context, filter EventFilter) (<-chan models.Event, error) {
+	events, err := s.next.Subscribe(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return relayEvents(ctx, events, func(event models.Event) (models.Event, bool) {
+		if event.Transaction == nil {
+			return event, true
+		}
+		enriched := *event.Transaction
+		if err := s.enricher.Enrich(ctx, &enriched); err != nil {
+			return event, false
+		}
+		event.Transaction = &enriched
+		return event, true
+	}), nil
+}
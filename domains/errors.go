@@ -0,0 +1,26 @@
+package domains
+
+import "errors"
+
+// Sentinel errors providers wrap so APIs can classify failures without
+// parsing messages, e.g. fmt.Errorf("account %w: %s", ErrNotFound, id)
+// produces "account not found: <id>".
+var (
+	// ErrNotFound indicates the requested resource does not exist
+	ErrNotFound = errors.New("not found")
+
+	// ErrForbidden indicates the caller may not access the resource
+	ErrForbidden = errors.New("access denied")
+
+	// ErrRateLimited indicates the caller or upstream bank API is throttled
+	ErrRateLimited = errors.New("rate limit exceeded")
+
+	// ErrRateUnavailable indicates no exchange rate is quoted, directly or
+	// through a cross rate, for a currency pair
+	ErrRateUnavailable = errors.New("exchange rate unavailable")
+)
+
+// IsNotFound reports whether err means a resource does not exist
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
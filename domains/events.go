@@ -0,0 +1,149 @@
+package domains
+
+import (
+	"context"
+	"sync"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// EventFilter selects which events a subscriber receives. Empty fields match everything.
+type EventFilter struct {
+	// Event types to receive (all types when empty)
+	Types []models.EventType
+
+	// Only transaction and balance events for this account
+	AccountID string
+
+	// Only consent events for this consent
+	ConsentID string
+}
+
+// Matches reports whether event passes the filter
+func (f EventFilter) Matches(event models.Event) bool {
+	if len(f.Types) > 0 {
+		matched := false
+		for _, t := range f.Types {
+			if t == event.Type {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if f.AccountID != "" && event.AccountID != f.AccountID {
+		return false
+	}
+	if f.ConsentID != "" && (event.Consent == nil || event.Consent.ID != f.ConsentID) {
+		return false
+	}
+	return true
+}
+
+// EventSource streams domain change notifications so APIs can push updates
+// instead of clients polling.
+//
+// BIAN Alignment:
+//   - Subscribe corresponds to BIAN "Notify" behaviour qualifiers on Current Account,
+//     Payment Execution and Customer Consent Management service domains
+type EventSource interface {
+	// Subscribe streams events matching filter until ctx is cancelled, at which
+	// point the returned channel is closed.
+	//
+	// Parameters:
+	//   - ctx: Context whose cancellation ends the subscription
+	//   - filter: Event types and resources to receive
+	//
+	// Returns:
+	//   - Channel of matching events
+	//   - Error if the subscription cannot be established
+	Subscribe(ctx context.Context, filter EventFilter) (<-chan models.Event, error)
+}
+
+// eventBufferSize is how many undelivered events a subscriber may hold
+const eventBufferSize = 64
+
+// EventBus is an in-memory EventSource that providers publish to. Delivery is
+// best effort: events are dropped for subscribers whose buffer is full rather
+// than blocking the publisher.
+type EventBus struct {
+	mu          sync.RWMutex
+	subscribers map[*eventSubscriber]struct{}
+}
+
+type eventSubscriber struct {
+	filter EventFilter
+	events chan models.Event
+}
+
+// NewEventBus creates an empty event bus
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// Subscribe registers a subscriber that is removed when ctx is cancelled
+func (b *EventBus) Subscribe(ctx context.Context, filter EventFilter) (<-chan models.Event, error) {
+	sub := &eventSubscriber{
+		filter: filter,
+		events: make(chan models.Event, eventBufferSize),
+	}
+
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, sub)
+		close(sub.events)
+		b.mu.Unlock()
+	}()
+
+	return sub.events, nil
+}
+
+// Publish delivers event to every matching subscriber without blocking
+func (b *EventBus) Publish(event models.Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+		}
+	}
+}
+
+// relayEvents forwards events from in, passed through transform, until in
+// closes or ctx is cancelled. Events for which transform reports false are
+// dropped. Decorators use it to process a subscription's events.
+func relayEvents(ctx context.Context, in <-chan models.Event, transform func(models.Event) (models.Event, bool)) <-chan models.Event {
+	out := make(chan models.Event, eventBufferSize)
+	go func() {
+		defer close(out)
+		for event := range in {
+			event, ok := transform(event)
+			if !ok {
+				continue
+			}
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Ensure EventBus implements EventSource
+var _ EventSource = (*EventBus)(nil)
@@ -0,0 +1,216 @@
+package domains_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// receive returns the next event on events, failing the test if none arrives
+func receive(t *testing.T, events <-chan models.Event) models.Event {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("subscription closed unexpectedly")
+		}
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return models.Event{}
+	}
+}
+
+// expectNone fails the test if an event is waiting on events
+func expectNone(t *testing.T, events <-chan models.Event) {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		if ok {
+			t.Errorf("unexpected event %s for %s", event.Type, event.AccountID)
+		}
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+// expectClosed fails the test unless events closes
+func expectClosed(t *testing.T, events <-chan models.Event) {
+	t.Helper()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("received an event after unsubscribing")
+		}
+	case <-time.After(time.Second):
+		t.Error("subscription was not closed")
+	}
+}
+
+func posted(accountID, amount string) models.Event {
+	now := time.Now()
+	return models.Event{
+		Type:      models.EventTypeTransactionPosted,
+		AccountID: accountID,
+		Transaction: &models.Transaction{
+			ID:              "tx-" + accountID,
+			AccountID:       accountID,
+			TransactionType: models.TransactionTypeDebit,
+			Amount:          money(amount, "AUD"),
+			Description:     "WOOLWORTHS 1234 SYDNEY",
+			PostingDate:     now,
+			ValueDate:       now,
+		},
+		Timestamp: now,
+	}
+}
+
+func TestEventBus_FanOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := domains.NewEventBus()
+
+	all, _ := bus.Subscribe(ctx, domains.EventFilter{})
+	acc1, _ := bus.Subscribe(ctx, domains.EventFilter{AccountID: "acc-001"})
+	balances, _ := bus.Subscribe(ctx, domains.EventFilter{Types: []models.EventType{models.EventTypeBalanceChanged}})
+	consent, _ := bus.Subscribe(ctx, domains.EventFilter{ConsentID: "consent-001"})
+
+	bus.Publish(posted("acc-001", "-1.00"))
+	bus.Publish(posted("acc-002", "-2.00"))
+
+	// Every matching subscriber receives its own copy, in publish order
+	if event := receive(t, all); event.AccountID != "acc-001" {
+		t.Errorf("first event for %s, want acc-001", event.AccountID)
+	}
+	if event := receive(t, all); event.AccountID != "acc-002" {
+		t.Errorf("second event for %s, want acc-002", event.AccountID)
+	}
+	if event := receive(t, acc1); event.AccountID != "acc-001" {
+		t.Errorf("account filter delivered %s", event.AccountID)
+	}
+	expectNone(t, acc1)
+	expectNone(t, balances)
+	expectNone(t, consent)
+
+	bus.Publish(models.Event{Type: models.EventTypeConsentStatusChanged, Consent: &models.Consent{ID: "consent-001"}})
+	if event := receive(t, consent); event.Consent.ID != "consent-001" {
+		t.Errorf("consent filter delivered %+v", event.Consent)
+	}
+}
+
+func TestEventBus_Unsubscribe(t *testing.T) {
+	bus := domains.NewEventBus()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _ := bus.Subscribe(ctx, domains.EventFilter{})
+	remaining, _ := bus.Subscribe(context.Background(), domains.EventFilter{})
+
+	cancel()
+	expectClosed(t, events)
+
+	// Publishing after a subscriber left neither blocks nor panics, and
+	// other subscribers are unaffected
+	bus.Publish(posted("acc-001", "-1.00"))
+	receive(t, remaining)
+}
+
+func TestEventBus_FullBufferDoesNotBlock(t *testing.T) {
+	bus := domains.NewEventBus()
+	events, _ := bus.Subscribe(context.Background(), domains.EventFilter{})
+
+	// A subscriber that never reads loses events instead of stalling publishers
+	done := make(chan struct{})
+	go func() {
+		for range 1000 {
+			bus.Publish(posted("acc-001", "-1.00"))
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Publish blocked on a full subscriber")
+	}
+	receive(t, events)
+}
+
+// stubEnricher categorises every transaction, or fails when err is set
+type stubEnricher struct {
+	err error
+}
+
+func (e stubEnricher) Enrich(ctx context.Context, tx *models.Transaction) error {
+	if e.err != nil {
+		return e.err
+	}
+	tx.Category = models.TransactionCategoryGroceries
+	return nil
+}
+
+func TestEnrichingEventSource(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := domains.NewEventBus()
+	events, err := domains.NewEnrichingEventSource(bus, stubEnricher{}).Subscribe(ctx, domains.EventFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	original := posted("acc-001", "-1.00")
+	bus.Publish(original)
+	if event := receive(t, events); event.Transaction.Category != models.TransactionCategoryGroceries {
+		t.Errorf("category = %q, want the enriched category", event.Transaction.Category)
+	}
+	if original.Transaction.Category != "" {
+		t.Error("enrichment modified the published transaction")
+	}
+
+	// Events without a transaction pass through unchanged
+	bus.Publish(models.Event{Type: models.EventTypeConsentStatusChanged, Consent: &models.Consent{ID: "consent-001"}})
+	if event := receive(t, events); event.Consent == nil {
+		t.Error("consent event was not relayed")
+	}
+
+	cancel()
+	expectClosed(t, events)
+}
+
+func TestEnrichingEventSource_DropsFailedEnrichment(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := domains.NewEventBus()
+	events, _ := domains.NewEnrichingEventSource(bus, stubEnricher{err: errors.New("categoriser unavailable")}).Subscribe(ctx, domains.EventFilter{})
+
+	bus.Publish(posted("acc-001", "-1.00"))
+	expectNone(t, events)
+}
+
+func TestValidatingEventSource(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := domains.NewEventBus()
+	events, err := domains.NewValidatingEventSource(bus).Subscribe(ctx, domains.EventFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := posted("acc-001", "-1.00")
+	invalid.Transaction.PostingDate = time.Time{}
+	bus.Publish(invalid)
+	bus.Publish(models.Event{
+		Type:      models.EventTypeBalanceChanged,
+		AccountID: "acc-001",
+		Balance:   &models.Balance{BalanceType: "RESERVED", Amount: money("1.00", "AUD"), Timestamp: time.Now()},
+	})
+	bus.Publish(posted("acc-002", "-2.00"))
+
+	// Only the valid event is delivered
+	if event := receive(t, events); event.AccountID != "acc-002" {
+		t.Errorf("delivered the invalid event for %s", event.AccountID)
+	}
+	expectNone(t, events)
+
+	cancel()
+	expectClosed(t, events)
+}
@@ -0,0 +1,61 @@
+package domains
+
+import (
+	"context"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// FXService defines operations for foreign exchange following BIAN Currency Exchange service domain.
+// This interface implements a subset of BIAN v13.0.0 operations focused on rate retrieval and conversion.
+//
+// BIAN Alignment:
+// - RetrieveExchangeRate maps to BIAN "Retrieve Currency Exchange Rate" operation
+// - RetrieveExchangeRates maps to BIAN "Retrieve Currency Exchange Rate" operation (all pairs for a base)
+// - ConvertAmount maps to BIAN "Execute Currency Exchange" operation (quote only, no settlement)
+type FXService interface {
+	// RetrieveExchangeRate retrieves the latest rate for a currency pair.
+	// Returns the rate with its observation timestamp or an error if no rate is available.
+	//
+	// BIAN Operation: Retrieve Currency Exchange Rate
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - baseCurrency: ISO 4217 code of the currency being priced
+	//   - quoteCurrency: ISO 4217 code the price is expressed in
+	//
+	// Returns:
+	//   - Exchange rate (units of quote currency per unit of base currency)
+	//   - ErrRateUnavailable (wrapped) if no rate is available for the pair,
+	//     or an error for an invalid currency or internal failure
+	RetrieveExchangeRate(ctx context.Context, baseCurrency, quoteCurrency string) (*models.ExchangeRate, error)
+
+	// RetrieveExchangeRates retrieves all available rates for a base currency.
+	//
+	// BIAN Operation: Retrieve Currency Exchange Rate
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - baseCurrency: ISO 4217 code of the currency being priced
+	//
+	// Returns:
+	//   - List of exchange rates (may be empty)
+	//   - Error if invalid currency or internal error
+	RetrieveExchangeRates(ctx context.Context, baseCurrency string) ([]*models.ExchangeRate, error)
+
+	// ConvertAmount converts an amount into the quote currency at the latest rate.
+	//
+	// BIAN Operation: Execute Currency Exchange
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - amount: Amount to convert
+	//   - quoteCurrency: ISO 4217 code to convert into
+	//
+	// Returns:
+	//   - Converted amount rounded to the quote currency's minor unit
+	//   - Exchange rate used for the conversion
+	//   - ErrRateUnavailable (wrapped) if no rate is available for the pair,
+	//     or an error for an invalid currency or internal failure
+	ConvertAmount(ctx context.Context, amount models.Money, quoteCurrency string) (models.Money, *models.ExchangeRate, error)
+}
+
+// Ensure FXService can be used wherever models need a rate source
+var _ models.RateSource = (FXService)(nil)
@@ -0,0 +1,65 @@
+package domains
+
+import (
+	"context"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// PayeeService defines operations for a customer's saved payees following BIAN Party Reference Data Directory service domain.
+// This interface implements a subset of BIAN v13.0.0 operations for maintaining payment beneficiary references.
+//
+// BIAN Alignment:
+// - RetrievePayees maps to BIAN "Retrieve Party Reference Data Directory Entry" operation (all payees of a customer)
+// - RetrievePayee maps to BIAN "Retrieve Party Reference Data Directory Entry" operation
+// - CreatePayee maps to BIAN "Register Party Reference Data Directory Entry" operation
+// - DeletePayee maps to BIAN "Terminate Party Reference Data Directory Entry" operation
+type PayeeService interface {
+	// RetrievePayees retrieves all payees saved by a customer.
+	//
+	// BIAN Operation: Retrieve Party Reference Data Directory Entry
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - customerID: Unique identifier for the customer
+	//
+	// Returns:
+	//   - List of payees ordered by nickname (may be empty)
+	//   - Error if customer not found, access denied, or internal error
+	RetrievePayees(ctx context.Context, customerID string) ([]*models.Payee, error)
+
+	// RetrievePayee retrieves a payee by its unique identifier.
+	//
+	// BIAN Operation: Retrieve Party Reference Data Directory Entry
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - payeeID: Unique identifier for the payee
+	//
+	// Returns:
+	//   - Payee with its domestic, international or biller details
+	//   - Error if payee not found, access denied, or internal error
+	RetrievePayee(ctx context.Context, payeeID string) (*models.Payee, error)
+
+	// CreatePayee saves a new payee for payee.CustomerID. The ID and creation
+	// date are assigned by the provider; any given are ignored.
+	//
+	// BIAN Operation: Register Party Reference Data Directory Entry
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - payee: Payee details, valid according to Payee.ValidateInput
+	//
+	// Returns:
+	//   - The created payee
+	//   - Error if customer not found, payee details invalid (models.ValidationError), access denied, or internal error
+	CreatePayee(ctx context.Context, payee *models.Payee) (*models.Payee, error)
+
+	// DeletePayee removes a payee.
+	//
+	// BIAN Operation: Terminate Party Reference Data Directory Entry
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - payeeID: Unique identifier for the payee
+	//
+	// Returns:
+	//   - Error if payee not found, access denied, or internal error
+	DeletePayee(ctx context.Context, payeeID string) error
+}
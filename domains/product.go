@@ -0,0 +1,87 @@
+package domains
+
+import (
+	"context"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// ProductEffective selects products by the period they are offered in
+type ProductEffective string
+
+const (
+	// ProductEffectiveCurrent selects products offered now (the default)
+	ProductEffectiveCurrent ProductEffective = "CURRENT"
+
+	// ProductEffectiveFuture selects products that will be offered later
+	ProductEffectiveFuture ProductEffective = "FUTURE"
+
+	// ProductEffectiveAll selects current and future products
+	ProductEffectiveAll ProductEffective = "ALL"
+)
+
+// IsValid checks if the effective filter is valid
+func (e ProductEffective) IsValid() bool {
+	switch e {
+	case ProductEffectiveCurrent, ProductEffectiveFuture, ProductEffectiveAll:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProductFilter narrows the products returned by RetrieveProducts, following
+// the CDR Get Products query parameters
+type ProductFilter struct {
+	// Only products in this category (all categories when empty)
+	Category models.ProductCategory `json:"category,omitempty"`
+
+	// Only products offered in this period (CURRENT when empty)
+	Effective ProductEffective `json:"effective,omitempty"`
+}
+
+// Validate checks the category and effective period
+func (f ProductFilter) Validate() error {
+	var v models.FieldErrors
+	if f.Category != "" && !f.Category.IsValid() {
+		v.Add("category", "unknown product category %q", f.Category)
+	}
+	if f.Effective != "" && !f.Effective.IsValid() {
+		v.Add("effective", "must be CURRENT, FUTURE or ALL")
+	}
+	return v.Err()
+}
+
+// ProductService defines operations for the product catalogue following the BIAN Product Directory service domain.
+// This interface implements a subset of BIAN v13.0.0 operations focused on read-only product retrieval, with products
+// modelled on CDR BankingProductV4 so rates, fees, constraints and eligibility can be compared across providers.
+//
+// BIAN Alignment:
+// - RetrieveProducts maps to BIAN "Retrieve Product Directory Entry" operation over the catalogue
+// - RetrieveProduct maps to BIAN "Retrieve Product Directory Entry" operation
+type ProductService interface {
+	// RetrieveProducts lists the products matching the filter, ordered by name.
+	//
+	// BIAN Operation: Retrieve Product Directory Entry
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - filter: Category and effective period to select
+	//
+	// Returns:
+	//   - Matching products (empty when none match)
+	//   - Error if access denied or internal error
+	RetrieveProducts(ctx context.Context, filter ProductFilter) ([]*models.Product, error)
+
+	// RetrieveProduct retrieves a product by its unique identifier, whether
+	// or not it is currently offered.
+	//
+	// BIAN Operation: Retrieve Product Directory Entry
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - productID: Unique identifier for the product
+	//
+	// Returns:
+	//   - Product with its features, constraints, eligibility, fees and rates
+	//   - Error if product not found, access denied, or internal error
+	RetrieveProduct(ctx context.Context, productID string) (*models.Product, error)
+}
@@ -0,0 +1,39 @@
+package domains
+
+import (
+	"context"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// StandingOrderService defines operations for standing orders following BIAN Standing Order service domain.
+// This interface implements a subset of BIAN v13.0.0 operations focused on read-only arrangement retrieval.
+//
+// BIAN Alignment:
+// - RetrieveStandingOrder maps to BIAN "Retrieve Standing Order" operation
+// - RetrieveAccountStandingOrders maps to BIAN "Retrieve Standing Order" operation (all orders paid from an account)
+type StandingOrderService interface {
+	// RetrieveStandingOrder retrieves a standing order by its unique identifier.
+	//
+	// BIAN Operation: Retrieve Standing Order
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - standingOrderID: Unique identifier for the standing order
+	//
+	// Returns:
+	//   - Scheduled payment of type STANDING_ORDER with its next execution date
+	//   - Error if standing order not found, access denied, or internal error
+	RetrieveStandingOrder(ctx context.Context, standingOrderID string) (*models.ScheduledPayment, error)
+
+	// RetrieveAccountStandingOrders retrieves the standing orders paid from an account.
+	//
+	// BIAN Operation: Retrieve Standing Order
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout
+	//   - accountID: Unique identifier for the account
+	//
+	// Returns:
+	//   - List of standing orders ordered by next execution date (may be empty)
+	//   - Error if account not found, access denied, or internal error
+	RetrieveAccountStandingOrders(ctx context.Context, accountID string) ([]*models.ScheduledPayment, error)
+}
@@ -18,6 +18,24 @@ type HistoryOptions struct {
 	Offset int `json:"offset,omitempty"` // Number of transactions to skip (for pagination)
 }
 
+// MaxHistoryLimit is the largest page size accepted by RetrievePaymentTransactionHistory
+const MaxHistoryLimit = 500
+
+// Validate checks pagination bounds and the date range
+func (o HistoryOptions) Validate() error {
+	var v models.FieldErrors
+	if o.Limit < 0 || o.Limit > MaxHistoryLimit {
+		v.Add("limit", "must be between 1 and %d", MaxHistoryLimit)
+	}
+	if o.Offset < 0 {
+		v.Add("offset", "must be non-negative")
+	}
+	if o.FromDate != nil && o.ToDate != nil && o.ToDate.Before(*o.FromDate) {
+		v.Add("toDate", "must not be before fromDate")
+	}
+	return v.Err()
+}
+
 // TransactionService defines operations for transaction management following BIAN Payment Execution service domain.
 // This interface implements a subset of BIAN v13.0.0 operations focused on read-only transaction retrieval.
 //
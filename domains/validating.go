@@ -0,0 +1,513 @@
+package domains
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// Validating decorators wrap provider implementations and reject any model
+// that fails its Validate contract, so malformed provider output never reaches
+// the API layer. Validation failures are returned as errors wrapping a
+// *models.ValidationError.
+
+// ErrInvalidProviderOutput marks validation failures caused by provider output
+// (a server-side fault) as opposed to invalid caller input
+var ErrInvalidProviderOutput = errors.New("provider returned invalid data")
+
+// invalidOutput wraps a provider validation failure with the offending resource
+func invalidOutput(resource, id string, err error) error {
+	return fmt.Errorf("%w: %s %s: %w", ErrInvalidProviderOutput, resource, id, err)
+}
+
+// ValidatingAccountService validates AccountService output
+type ValidatingAccountService struct {
+	next AccountService
+}
+
+// NewValidatingAccountService wraps an AccountService with output validation
+func NewValidatingAccountService(next AccountService) *ValidatingAccountService {
+	return &ValidatingAccountService{next: next}
+}
+
+// RetrieveCurrentAccount retrieves and validates account details
+func (s *ValidatingAccountService) RetrieveCurrentAccount(ctx context.Context, accountID string) (*models.Account, error) {
+	account, err := s.next.RetrieveCurrentAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := account.Validate(); err != nil {
+		return nil, invalidOutput("account", accountID, err)
+	}
+	return account, nil
+}
+
+// RetrieveCurrentAccountBalance retrieves and validates the current balance
+func (s *ValidatingAccountService) RetrieveCurrentAccountBalance(ctx context.Context, accountID string) (*models.Balance, error) {
+	balance, err := s.next.RetrieveCurrentAccountBalance(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := balance.Validate(); err != nil {
+		return nil, invalidOutput("balance for account", accountID, err)
+	}
+	return balance, nil
+}
+
+// ValidatingTransactionService validates TransactionService input and output
+type ValidatingTransactionService struct {
+	next TransactionService
+}
+
+// NewValidatingTransactionService wraps a TransactionService with validation
+func NewValidatingTransactionService(next TransactionService) *ValidatingTransactionService {
+	return &ValidatingTransactionService{next: next}
+}
+
+// RetrievePaymentTransaction retrieves and validates a transaction
+func (s *ValidatingTransactionService) RetrievePaymentTransaction(ctx context.Context, transactionID string) (*models.Transaction, error) {
+	transaction, err := s.next.RetrievePaymentTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := transaction.Validate(); err != nil {
+		return nil, invalidOutput("transaction", transactionID, err)
+	}
+	return transaction, nil
+}
+
+// RetrievePaymentTransactionHistory validates options, then retrieves and validates each transaction
+func (s *ValidatingTransactionService) RetrievePaymentTransactionHistory(ctx context.Context, accountID string, opts HistoryOptions) ([]*models.Transaction, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	transactions, err := s.next.RetrievePaymentTransactionHistory(ctx, accountID, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, transaction := range transactions {
+		if err := transaction.Validate(); err != nil {
+			return nil, invalidOutput("transaction", transaction.ID, err)
+		}
+	}
+	return transactions, nil
+}
+
+// ValidatingBalanceService validates BalanceService output
+type ValidatingBalanceService struct {
+	next BalanceService
+}
+
+// NewValidatingBalanceService wraps a BalanceService with output validation
+func NewValidatingBalanceService(next BalanceService) *ValidatingBalanceService {
+	return &ValidatingBalanceService{next: next}
+}
+
+// RetrieveAccountBalance retrieves and validates all balance types
+func (s *ValidatingBalanceService) RetrieveAccountBalance(ctx context.Context, accountID string) ([]*models.Balance, error) {
+	balances, err := s.next.RetrieveAccountBalance(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, balance := range balances {
+		if err := balance.Validate(); err != nil {
+			return nil, invalidOutput("balance for account", accountID, err)
+		}
+	}
+	return balances, nil
+}
+
+// ValidatingConsentService validates ConsentService output
+type ValidatingConsentService struct {
+	next ConsentService
+}
+
+// NewValidatingConsentService wraps a ConsentService with output validation
+func NewValidatingConsentService(next ConsentService) *ValidatingConsentService {
+	return &ValidatingConsentService{next: next}
+}
+
+// RetrieveConsent retrieves and validates consent details
+func (s *ValidatingConsentService) RetrieveConsent(ctx context.Context, consentID string) (*models.Consent, error) {
+	consent, err := s.next.RetrieveConsent(ctx, consentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := consent.Validate(); err != nil {
+		return nil, invalidOutput("consent", consentID, err)
+	}
+	return consent, nil
+}
+
+// RetrieveConsentStatus retrieves and validates a consent status
+func (s *ValidatingConsentService) RetrieveConsentStatus(ctx context.Context, consentID string) (models.ConsentStatus, error) {
+	status, err := s.next.RetrieveConsentStatus(ctx, consentID)
+	if err != nil {
+		return "", err
+	}
+	if !status.IsValid() {
+		return "", invalidOutput("consent status for", consentID, &models.ValidationError{
+			Fields: []models.FieldError{{Field: "status", Message: fmt.Sprintf("unknown consent status %q", status)}},
+		})
+	}
+	return status, nil
+}
+
+// ValidatingAccountConsentRetriever validates AccountConsentRetriever output
+type ValidatingAccountConsentRetriever struct {
+	next AccountConsentRetriever
+}
+
+// NewValidatingAccountConsentRetriever wraps an AccountConsentRetriever with output validation
+func NewValidatingAccountConsentRetriever(next AccountConsentRetriever) *ValidatingAccountConsentRetriever {
+	return &ValidatingAccountConsentRetriever{next: next}
+}
+
+// RetrieveAccountConsents retrieves and validates the consents covering an account
+func (s *ValidatingAccountConsentRetriever) RetrieveAccountConsents(ctx context.Context, accountID string) ([]*models.Consent, error) {
+	consents, err := s.next.RetrieveAccountConsents(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, consent := range consents {
+		if err := consent.Validate(); err != nil {
+			return nil, invalidOutput("consent", consent.ID, err)
+		}
+	}
+	return consents, nil
+}
+
+// ValidatingCustomerService validates CustomerService output
+type ValidatingCustomerService struct {
+	next CustomerService
+}
+
+// NewValidatingCustomerService wraps a CustomerService with output validation
+func NewValidatingCustomerService(next CustomerService) *ValidatingCustomerService {
+	return &ValidatingCustomerService{next: next}
+}
+
+// RetrieveCustomerAccounts retrieves and validates the customer's accounts
+func (s *ValidatingCustomerService) RetrieveCustomerAccounts(ctx context.Context, customerID string) ([]*models.Account, error) {
+	accounts, err := s.next.RetrieveCustomerAccounts(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if err := account.Validate(); err != nil {
+			return nil, invalidOutput("account", account.ID, err)
+		}
+	}
+	return accounts, nil
+}
+
+// ValidatingFXService validates FXService output
+type ValidatingFXService struct {
+	next FXService
+}
+
+// NewValidatingFXService wraps an FXService with output validation
+func NewValidatingFXService(next FXService) *ValidatingFXService {
+	return &ValidatingFXService{next: next}
+}
+
+// RetrieveExchangeRate retrieves and validates a rate
+func (s *ValidatingFXService) RetrieveExchangeRate(ctx context.Context, baseCurrency, quoteCurrency string) (*models.ExchangeRate, error) {
+	rate, err := s.next.RetrieveExchangeRate(ctx, baseCurrency, quoteCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if err := rate.Validate(); err != nil {
+		return nil, invalidOutput("exchange rate", baseCurrency+"/"+quoteCurrency, err)
+	}
+	return rate, nil
+}
+
+// RetrieveExchangeRates retrieves and validates all rates for a base currency
+func (s *ValidatingFXService) RetrieveExchangeRates(ctx context.Context, baseCurrency string) ([]*models.ExchangeRate, error) {
+	rates, err := s.next.RetrieveExchangeRates(ctx, baseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	for _, rate := range rates {
+		if err := rate.Validate(); err != nil {
+			return nil, invalidOutput("exchange rate", rate.Pair(), err)
+		}
+	}
+	return rates, nil
+}
+
+// ConvertAmount validates the input amount, then converts and validates the result
+func (s *ValidatingFXService) ConvertAmount(ctx context.Context, amount models.Money, quoteCurrency string) (models.Money, *models.ExchangeRate, error) {
+	if err := amount.Validate(); err != nil {
+		return models.Money{}, nil, err
+	}
+	converted, rate, err := s.next.ConvertAmount(ctx, amount, quoteCurrency)
+	if err != nil {
+		return models.Money{}, nil, err
+	}
+	if err := rate.Validate(); err != nil {
+		return models.Money{}, nil, invalidOutput("exchange rate", rate.Pair(), err)
+	}
+	if err := converted.Validate(); err != nil {
+		return models.Money{}, nil, invalidOutput("converted amount", amount.String(), err)
+	}
+	return converted, rate, nil
+}
+
+// ValidatingCardService validates CardService output
+type ValidatingCardService struct {
+	next CardService
+}
+
+// NewValidatingCardService wraps a CardService with output validation
+func NewValidatingCardService(next CardService) *ValidatingCardService {
+	return &ValidatingCardService{next: next}
+}
+
+// RetrieveCard retrieves and validates a card
+func (s *ValidatingCardService) RetrieveCard(ctx context.Context, cardID string) (*models.Card, error) {
+	card, err := s.next.RetrieveCard(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+	if err := card.Validate(); err != nil {
+		return nil, invalidOutput("card", cardID, err)
+	}
+	return card, nil
+}
+
+// RetrieveAccountCard retrieves and validates the card issued on an account
+func (s *ValidatingCardService) RetrieveAccountCard(ctx context.Context, accountID string) (*models.Card, error) {
+	card, err := s.next.RetrieveAccountCard(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if err := card.Validate(); err != nil {
+		return nil, invalidOutput("card", card.ID, err)
+	}
+	return card, nil
+}
+
+// ValidatingStandingOrderService validates StandingOrderService output
+type ValidatingStandingOrderService struct {
+	next StandingOrderService
+}
+
+// NewValidatingStandingOrderService wraps a StandingOrderService with output validation
+func NewValidatingStandingOrderService(next StandingOrderService) *ValidatingStandingOrderService {
+	return &ValidatingStandingOrderService{next: next}
+}
+
+// RetrieveStandingOrder retrieves and validates a standing order
+func (s *ValidatingStandingOrderService) RetrieveStandingOrder(ctx context.Context, standingOrderID string) (*models.ScheduledPayment, error) {
+	payment, err := s.next.RetrieveStandingOrder(ctx, standingOrderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := payment.Validate(); err != nil {
+		return nil, invalidOutput("standing order", standingOrderID, err)
+	}
+	return payment, nil
+}
+
+// RetrieveAccountStandingOrders retrieves and validates the account's standing orders
+func (s *ValidatingStandingOrderService) RetrieveAccountStandingOrders(ctx context.Context, accountID string) ([]*models.ScheduledPayment, error) {
+	payments, err := s.next.RetrieveAccountStandingOrders(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, payment := range payments {
+		if err := payment.Validate(); err != nil {
+			return nil, invalidOutput("standing order", payment.ID, err)
+		}
+	}
+	return payments, nil
+}
+
+// ValidatingDirectDebitService validates DirectDebitService output
+type ValidatingDirectDebitService struct {
+	next DirectDebitService
+}
+
+// NewValidatingDirectDebitService wraps a DirectDebitService with output validation
+func NewValidatingDirectDebitService(next DirectDebitService) *ValidatingDirectDebitService {
+	return &ValidatingDirectDebitService{next: next}
+}
+
+// RetrieveDirectDebit retrieves and validates a direct debit
+func (s *ValidatingDirectDebitService) RetrieveDirectDebit(ctx context.Context, directDebitID string) (*models.ScheduledPayment, error) {
+	payment, err := s.next.RetrieveDirectDebit(ctx, directDebitID)
+	if err != nil {
+		return nil, err
+	}
+	if err := payment.Validate(); err != nil {
+		return nil, invalidOutput("direct debit", directDebitID, err)
+	}
+	return payment, nil
+}
+
+// RetrieveAccountDirectDebits retrieves and validates the account's direct debits
+func (s *ValidatingDirectDebitService) RetrieveAccountDirectDebits(ctx context.Context, accountID string) ([]*models.ScheduledPayment, error) {
+	payments, err := s.next.RetrieveAccountDirectDebits(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, payment := range payments {
+		if err := payment.Validate(); err != nil {
+			return nil, invalidOutput("direct debit", payment.ID, err)
+		}
+	}
+	return payments, nil
+}
+
+// ValidatingPayeeService validates PayeeService input and output
+type ValidatingPayeeService struct {
+	next PayeeService
+}
+
+// NewValidatingPayeeService wraps a PayeeService with input and output validation
+func NewValidatingPayeeService(next PayeeService) *ValidatingPayeeService {
+	return &ValidatingPayeeService{next: next}
+}
+
+// RetrievePayees retrieves and validates the customer's payees
+func (s *ValidatingPayeeService) RetrievePayees(ctx context.Context, customerID string) ([]*models.Payee, error) {
+	payees, err := s.next.RetrievePayees(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	for _, payee := range payees {
+		if err := payee.Validate(); err != nil {
+			return nil, invalidOutput("payee", payee.ID, err)
+		}
+	}
+	return payees, nil
+}
+
+// RetrievePayee retrieves and validates a payee
+func (s *ValidatingPayeeService) RetrievePayee(ctx context.Context, payeeID string) (*models.Payee, error) {
+	payee, err := s.next.RetrievePayee(ctx, payeeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := payee.Validate(); err != nil {
+		return nil, invalidOutput("payee", payeeID, err)
+	}
+	return payee, nil
+}
+
+// CreatePayee validates the new payee, then creates and validates the result
+func (s *ValidatingPayeeService) CreatePayee(ctx context.Context, payee *models.Payee) (*models.Payee, error) {
+	if err := payee.ValidateInput(); err != nil {
+		return nil, err
+	}
+	created, err := s.next.CreatePayee(ctx, payee)
+	if err != nil {
+		return nil, err
+	}
+	if err := created.Validate(); err != nil {
+		return nil, invalidOutput("payee", created.ID, err)
+	}
+	return created, nil
+}
+
+// DeletePayee deletes a payee; there is no output to validate
+func (s *ValidatingPayeeService) DeletePayee(ctx context.Context, payeeID string) error {
+	return s.next.DeletePayee(ctx, payeeID)
+}
+
+// ValidatingProductService validates ProductService input and output
+type ValidatingProductService struct {
+	next ProductService
+}
+
+// NewValidatingProductService wraps a ProductService with input and output validation
+func NewValidatingProductService(next ProductService) *ValidatingProductService {
+	return &ValidatingProductService{next: next}
+}
+
+// RetrieveProducts validates the filter, then retrieves and validates each product
+func (s *ValidatingProductService) RetrieveProducts(ctx context.Context, filter ProductFilter) ([]*models.Product, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	products, err := s.next.RetrieveProducts(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, product := range products {
+		if err := product.Validate(); err != nil {
+			return nil, invalidOutput("product", product.ID, err)
+		}
+	}
+	return products, nil
+}
+
+// RetrieveProduct retrieves and validates a product
+func (s *ValidatingProductService) RetrieveProduct(ctx context.Context, productID string) (*models.Product, error) {
+	product, err := s.next.RetrieveProduct(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if err := product.Validate(); err != nil {
+		return nil, invalidOutput("product", productID, err)
+	}
+	return product, nil
+}
+
+// ValidatingEventSource validates the payloads of EventSource events. Events
+// with an invalid transaction, balance or consent are dropped rather than
+// pushed to subscribers, as a subscription has no way to report the error.
+type ValidatingEventSource struct {
+	next EventSource
+}
+
+// NewValidatingEventSource wraps an EventSource with payload validation
+func NewValidatingEventSource(next EventSource) *ValidatingEventSource {
+	return &ValidatingEventSource{next: next}
+}
+
+// Subscribe subscribes to the wrapped source and drops invalid events
+func (s *ValidatingEventSource) Subscribe(ctx context.Context, filter EventFilter) (<-chan models.Event, error) {
+	events, err := s.next.Subscribe(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return relayEvents(ctx, events, func(event models.Event) (models.Event, bool) {
+		return event, validEvent(event)
+	}), nil
+}
+
+// validEvent reports whether every payload of event passes validation
+func validEvent(event models.Event) bool {
+	if event.Transaction != nil && event.Transaction.Validate() != nil {
+		return false
+	}
+	if event.Balance != nil && event.Balance.Validate() != nil {
+		return false
+	}
+	if event.Consent != nil && event.Consent.Validate() != nil {
+		return false
+	}
+	return true
+}
+
+// Ensure decorators implement their domain interfaces
+var (
+	_ AccountService          = (*ValidatingAccountService)(nil)
+	_ TransactionService      = (*ValidatingTransactionService)(nil)
+	_ BalanceService          = (*ValidatingBalanceService)(nil)
+	_ ConsentService          = (*ValidatingConsentService)(nil)
+	_ AccountConsentRetriever = (*ValidatingAccountConsentRetriever)(nil)
+	_ CustomerService         = (*ValidatingCustomerService)(nil)
+	_ FXService               = (*ValidatingFXService)(nil)
+	_ CardService             = (*ValidatingCardService)(nil)
+	_ StandingOrderService    = (*ValidatingStandingOrderService)(nil)
+	_ DirectDebitService      = (*ValidatingDirectDebitService)(nil)
+	_ PayeeService            = (*ValidatingPayeeService)(nil)
+	_ ProductService          = (*ValidatingProductService)(nil)
+	_ EventSource             = (*ValidatingEventSource)(nil)
+)
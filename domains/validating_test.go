@@ -0,0 +1,116 @@
+package domains_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+// brokenProvider serves well-formed data from the mock provider, except for
+// the resources listed in its fields, which it corrupts
+type brokenProvider struct {
+	*mock.Provider
+	account     string
+	transaction string
+	consent     string
+}
+
+func (p brokenProvider) RetrieveCurrentAccount(ctx context.Context, accountID string) (*models.Account, error) {
+	account, err := p.Provider.RetrieveCurrentAccount(ctx, accountID)
+	if err != nil || accountID != p.account {
+		return account, err
+	}
+	broken := *account
+	broken.Currency = "AU"
+	return &broken, nil
+}
+
+func (p brokenProvider) RetrievePaymentTransactionHistory(ctx context.Context, accountID string, opts domains.HistoryOptions) ([]*models.Transaction, error) {
+	transactions, err := p.Provider.RetrievePaymentTransactionHistory(ctx, accountID, opts)
+	if err != nil {
+		return nil, err
+	}
+	for i, tx := range transactions {
+		if tx.ID == p.transaction {
+			broken := *tx
+			broken.PostingDate = time.Time{}
+			transactions[i] = &broken
+		}
+	}
+	return transactions, nil
+}
+
+func (p brokenProvider) RetrieveConsentStatus(ctx context.Context, consentID string) (models.ConsentStatus, error) {
+	if consentID == p.consent {
+		return "UNKNOWN", nil
+	}
+	return p.Provider.RetrieveConsentStatus(ctx, consentID)
+}
+
+// checkRejected asserts err reports invalid provider output on field
+func checkRejected(t *testing.T, name string, err error, field string) {
+	t.Helper()
+	if !errors.Is(err, domains.ErrInvalidProviderOutput) {
+		t.Errorf("%s: error = %v, want ErrInvalidProviderOutput", name, err)
+		return
+	}
+	ve, ok := models.AsValidationError(err)
+	if !ok || len(ve.Fields) != 1 || ve.Fields[0].Field != field {
+		t.Errorf("%s: validation error = %v, want one error on %s", name, ve, field)
+	}
+}
+
+func TestValidatingServices_RejectInvalidOutput(t *testing.T) {
+	ctx := context.Background()
+	provider := brokenProvider{Provider: mock.NewProvider(), account: "acc-002", transaction: "tx-003", consent: "consent-001"}
+
+	accounts := domains.NewValidatingAccountService(provider)
+	if account, err := accounts.RetrieveCurrentAccount(ctx, "acc-001"); err != nil || account.ID != "acc-001" {
+		t.Errorf("valid account = %v, %v", account, err)
+	}
+	account, err := accounts.RetrieveCurrentAccount(ctx, "acc-002")
+	if account != nil {
+		t.Error("an invalid account must not be returned")
+	}
+	checkRejected(t, "account", err, "currency")
+
+	transactions := domains.NewValidatingTransactionService(provider)
+	if history, err := transactions.RetrievePaymentTransactionHistory(ctx, "acc-002", domains.HistoryOptions{}); err != nil || len(history) == 0 {
+		t.Errorf("valid history = %d transactions, %v", len(history), err)
+	}
+	// One invalid transaction rejects the whole page rather than serving a partial one
+	history, err := transactions.RetrievePaymentTransactionHistory(ctx, "acc-001", domains.HistoryOptions{})
+	if history != nil {
+		t.Errorf("a page with an invalid transaction must not be returned, got %d", len(history))
+	}
+	checkRejected(t, "transaction history", err, "postingDate")
+
+	consents := domains.NewValidatingConsentService(provider)
+	status, err := consents.RetrieveConsentStatus(ctx, "consent-001")
+	if status != "" {
+		t.Errorf("an invalid consent status must not be returned, got %s", status)
+	}
+	checkRejected(t, "consent status", err, "status")
+}
+
+func TestValidatingServices_PassThroughErrors(t *testing.T) {
+	ctx := context.Background()
+	transactions := domains.NewValidatingTransactionService(mock.NewProvider())
+
+	// Provider errors are returned unchanged
+	_, err := transactions.RetrievePaymentTransaction(ctx, "tx-999")
+	if !errors.Is(err, domains.ErrNotFound) || errors.Is(err, domains.ErrInvalidProviderOutput) {
+		t.Errorf("missing transaction error = %v, want ErrNotFound only", err)
+	}
+
+	// Invalid options are caller input, not provider output, and never reach the provider
+	_, err = transactions.RetrievePaymentTransactionHistory(ctx, "acc-001", domains.HistoryOptions{Limit: domains.MaxHistoryLimit + 1})
+	if _, ok := models.AsValidationError(err); !ok || errors.Is(err, domains.ErrInvalidProviderOutput) {
+		t.Errorf("invalid options error = %v, want a caller ValidationError", err)
+	}
+}
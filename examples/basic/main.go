@@ -1,15 +1,42 @@
 package main
 
 import (
+	"context"
 	"log"
+	"os"
+	"strconv"
+	"time"
 
+	"github.com/serverlesscloud/bian-go/providers/enrich"
 	"github.com/serverlesscloud/bian-go/providers/mock"
 	"github.com/serverlesscloud/bian-go/server"
 )
 
 func main() {
-	// Initialize mock provider with sample data
-	provider := mock.NewProvider()
+	// Initialize mock provider with sample data, optionally backfilling the
+	// interest and fees of the sample accounts' products, e.g. MOCK_INTEREST_DAYS=90
+	var mockOpts []mock.Option
+	if days, err := strconv.Atoi(os.Getenv("MOCK_INTEREST_DAYS")); err == nil && days > 0 {
+		mockOpts = append(mockOpts, mock.WithInterestSimulation(days))
+	}
+	provider := mock.NewProvider(mockOpts...)
+	
+	// Optionally post random transactions to drive GraphQL subscriptions,
+	// e.g. MOCK_ACTIVITY_INTERVAL=5s
+	if interval, err := time.ParseDuration(os.Getenv("MOCK_ACTIVITY_INTERVAL")); err == nil && interval > 0 {
+		go provider.Simulate(context.Background(), interval)
+	}
+	
+	// Categorise transactions with the bundled rules, or a rules file from
+	// ENRICHMENT_RULES_FILE
+	enricher := enrich.NewDefaultRulesEnricher()
+	if path := os.Getenv("ENRICHMENT_RULES_FILE"); path != "" {
+		rules, err := enrich.LoadRulesEnricherFile(path)
+		if err != nil {
+			log.Fatalf("Failed to load enrichment rules: %v", err)
+		}
+		enricher = rules
+	}
 	
 	// Create server configuration
 	config := server.DefaultConfig()
@@ -21,6 +48,15 @@ func main() {
 		provider, // BalanceService
 		provider, // ConsentService
 		config,
+		server.WithCustomerService(provider),
+		server.WithFXService(provider),
+		server.WithCardService(provider),
+		server.WithStandingOrderService(provider),
+		server.WithDirectDebitService(provider),
+		server.WithPayeeService(provider),
+		server.WithProductService(provider),
+		server.WithEventSource(provider),
+		server.WithEnricher(enricher),
 	)
 	
 	// Start server (blocks until shutdown)
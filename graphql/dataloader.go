@@ -0,0 +1,209 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+const (
+	// loaderWait is how long a loader collects keys before dispatching a batch
+	loaderWait = 2 * time.Millisecond
+
+	// loaderMaxBatch dispatches a batch early once it holds this many keys
+	loaderMaxBatch = 100
+)
+
+// batchFunc fetches values for a batch of unique keys. It must return one value
+// and one error per key, in key order.
+type batchFunc[K comparable, V any] func(ctx context.Context, keys []K) ([]V, []error)
+
+// loaderResult holds the outcome of a single key once its batch completes
+type loaderResult[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// loaderBatch collects keys waiting to be dispatched together
+type loaderBatch[K comparable, V any] struct {
+	keys    []K
+	results []*loaderResult[V]
+}
+
+// loader memoises loads of the same kind within one response. Every key is
+// fetched at most once; later loads share the cached result. Keys requested
+// within loaderWait of each other are collected into a batch so a batchFunc
+// backed by a real batch lookup can fetch them in one call.
+type loader[K comparable, V any] struct {
+	ctx   context.Context
+	fetch batchFunc[K, V]
+
+	mu    sync.Mutex
+	cache map[K]*loaderResult[V]
+	batch *loaderBatch[K, V]
+}
+
+// newLoader creates a loader whose batches run under ctx
+func newLoader[K comparable, V any](ctx context.Context, fetch batchFunc[K, V]) *loader[K, V] {
+	return &loader[K, V]{
+		ctx:   ctx,
+		fetch: fetch,
+		cache: make(map[K]*loaderResult[V]),
+	}
+}
+
+// Load returns the value for key, joining the pending batch if there is one
+func (l *loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	result, cached := l.cache[key]
+	if !cached {
+		result = &loaderResult[V]{done: make(chan struct{})}
+		l.cache[key] = result
+
+		if l.batch == nil {
+			l.batch = &loaderBatch[K, V]{}
+			go l.dispatchAfter(l.batch, loaderWait)
+		}
+		l.batch.keys = append(l.batch.keys, key)
+		l.batch.results = append(l.batch.results, result)
+
+		if len(l.batch.keys) >= loaderMaxBatch {
+			full := l.batch
+			l.batch = nil
+			go l.dispatch(full)
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-result.done:
+		return result.value, result.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Prime caches a value fetched elsewhere so later loads of key reuse it
+func (l *loader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, cached := l.cache[key]; cached {
+		return
+	}
+	result := &loaderResult[V]{done: make(chan struct{}), value: value}
+	close(result.done)
+	l.cache[key] = result
+}
+
+// dispatchAfter dispatches b after wait unless it was already dispatched for being full
+func (l *loader[K, V]) dispatchAfter(b *loaderBatch[K, V], wait time.Duration) {
+	time.Sleep(wait)
+
+	l.mu.Lock()
+	if l.batch != b {
+		l.mu.Unlock()
+		return
+	}
+	l.batch = nil
+	l.mu.Unlock()
+
+	l.dispatch(b)
+}
+
+// dispatch fetches a batch and releases everyone waiting on its keys
+func (l *loader[K, V]) dispatch(b *loaderBatch[K, V]) {
+	values, errs := l.fetch(l.ctx, b.keys)
+	for i, result := range b.results {
+		result.value = values[i]
+		result.err = errs[i]
+		close(result.done)
+	}
+}
+
+// fanOut adapts a single-key service call into a batchFunc that fetches the
+// batch's keys concurrently. The domain services have no batch lookups, so
+// this saves duplicate calls rather than round trips.
+func fanOut[K comparable, V any](fetch func(ctx context.Context, key K) (V, error)) batchFunc[K, V] {
+	return func(ctx context.Context, keys []K) ([]V, []error) {
+		values := make([]V, len(keys))
+		errs := make([]error, len(keys))
+
+		var wg sync.WaitGroup
+		for i, key := range keys {
+			wg.Add(1)
+			go func(i int, key K) {
+				defer wg.Done()
+				values[i], errs[i] = fetch(ctx, key)
+			}(i, key)
+		}
+		wg.Wait()
+
+		return values, errs
+	}
+}
+
+// Loaders holds the dataloaders used by field resolvers for one response
+type Loaders struct {
+	accounts        *loader[string, *models.Account]
+	currentBalances *loader[string, *models.Balance]
+	balances        *loader[string, []*models.Balance]
+}
+
+// newLoaders creates a fresh set of loaders bound to a response context
+func newLoaders(
+	ctx context.Context,
+	accountService domains.AccountService,
+	balanceService domains.BalanceService,
+) *Loaders {
+	return &Loaders{
+		accounts:        newLoader(ctx, fanOut(accountService.RetrieveCurrentAccount)),
+		currentBalances: newLoader(ctx, fanOut(accountService.RetrieveCurrentAccountBalance)),
+		balances:        newLoader(ctx, fanOut(balanceService.RetrieveAccountBalance)),
+	}
+}
+
+type loadersKey struct{}
+
+// loaderScope attaches a fresh set of loaders to every response. A query or
+// mutation has one response; a subscription has one per event, so each event
+// sees current balances and nothing accumulates over a long-lived stream.
+type loaderScope struct {
+	resolver *Resolver
+}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = loaderScope{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (l loaderScope) ExtensionName() string {
+	return "Dataloaders"
+}
+
+// Validate implements graphql.HandlerExtension
+func (l loaderScope) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse implements graphql.ResponseInterceptor
+func (l loaderScope) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	loaders := newLoaders(ctx, l.resolver.accountService, l.resolver.balanceService)
+	return next(context.WithValue(ctx, loadersKey{}, loaders))
+}
+
+// loaders returns the response's loaders, or an uncached set when the resolver
+// is used without loaderScope
+func (r *Resolver) loaders(ctx context.Context) *Loaders {
+	if loaders, ok := ctx.Value(loadersKey{}).(*Loaders); ok {
+		return loaders
+	}
+	return newLoaders(ctx, r.accountService, r.balanceService)
+}
@@ -0,0 +1,175 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// countingFetch records every batch it is asked for and fails for keys
+// listed in failing
+type countingFetch struct {
+	mu      sync.Mutex
+	batches [][]string
+	calls   atomic.Int32
+	failing map[string]bool
+}
+
+func (c *countingFetch) fetch(ctx context.Context, keys []string) ([]string, []error) {
+	c.mu.Lock()
+	c.batches = append(c.batches, append([]string(nil), keys...))
+	c.mu.Unlock()
+
+	values := make([]string, len(keys))
+	errs := make([]error, len(keys))
+	for i, key := range keys {
+		c.calls.Add(1)
+		if c.failing[key] {
+			errs[i] = fmt.Errorf("no value for %s", key)
+			continue
+		}
+		values[i] = "value-" + key
+	}
+	return values, errs
+}
+
+// loadAll loads keys concurrently and returns the results in key order
+func loadAll(l *loader[string, string], keys ...string) ([]string, []error) {
+	values := make([]string, len(keys))
+	errs := make([]error, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values[i], errs[i] = l.Load(context.Background(), key)
+		}()
+	}
+	wg.Wait()
+	return values, errs
+}
+
+func TestLoader_Deduplicates(t *testing.T) {
+	fetch := &countingFetch{}
+	l := newLoader(context.Background(), fetch.fetch)
+
+	keys := []string{"a", "b", "a", "a", "b"}
+	values, errs := loadAll(l, keys...)
+	for i, value := range values {
+		if errs[i] != nil || value != "value-"+keys[i] {
+			t.Errorf("load %d = %q, %v", i, value, errs[i])
+		}
+	}
+	if calls := fetch.calls.Load(); calls != 2 {
+		t.Errorf("fetched %d keys, want each distinct key once", calls)
+	}
+}
+
+func TestLoader_Caches(t *testing.T) {
+	fetch := &countingFetch{}
+	l := newLoader(context.Background(), fetch.fetch)
+
+	if _, err := l.Load(context.Background(), "a"); err != nil {
+		t.Fatal(err)
+	}
+	// A later load, after the first batch completed, is served from the cache
+	if value, err := l.Load(context.Background(), "a"); err != nil || value != "value-a" {
+		t.Errorf("cached load = %q, %v", value, err)
+	}
+	if len(fetch.batches) != 1 {
+		t.Errorf("batches = %v, want one", fetch.batches)
+	}
+
+	// Primed values are never fetched
+	l.Prime("p", "primed")
+	if value, _ := l.Load(context.Background(), "p"); value != "primed" {
+		t.Errorf("primed load = %q", value)
+	}
+	// Priming does not overwrite a loaded value
+	l.Prime("a", "stale")
+	if value, _ := l.Load(context.Background(), "a"); value != "value-a" {
+		t.Errorf("load after prime = %q, want value-a", value)
+	}
+	if calls := fetch.calls.Load(); calls != 1 {
+		t.Errorf("fetched %d keys, want 1", calls)
+	}
+}
+
+func TestLoader_Batches(t *testing.T) {
+	fetch := &countingFetch{}
+	l := newLoader(context.Background(), fetch.fetch)
+
+	keys := make([]string, loaderMaxBatch+1)
+	for i := range keys {
+		keys[i] = fmt.Sprint(i)
+	}
+	loadAll(l, keys...)
+
+	// Batches never exceed loaderMaxBatch and every key is fetched exactly once
+	total := 0
+	for _, batch := range fetch.batches {
+		if len(batch) > loaderMaxBatch {
+			t.Errorf("batch of %d keys exceeds %d", len(batch), loaderMaxBatch)
+		}
+		total += len(batch)
+	}
+	if total != len(keys) {
+		t.Errorf("batched %d keys, want %d", total, len(keys))
+	}
+}
+
+func TestLoader_Errors(t *testing.T) {
+	fetch := &countingFetch{failing: map[string]bool{"bad": true}}
+	l := newLoader(context.Background(), fetch.fetch)
+
+	// An error is returned to every load of its key and to no other key
+	values, errs := loadAll(l, "good", "bad", "bad")
+	if errs[0] != nil || values[0] != "value-good" {
+		t.Errorf("good = %q, %v", values[0], errs[0])
+	}
+	for _, err := range errs[1:] {
+		if err == nil || err.Error() != "no value for bad" {
+			t.Errorf("bad error = %v", err)
+		}
+	}
+
+	// Errors are cached like values for the rest of the response
+	if _, err := l.Load(context.Background(), "bad"); err == nil {
+		t.Error("cached load of a failed key should fail")
+	}
+	if calls := fetch.calls.Load(); calls != 2 {
+		t.Errorf("fetched %d keys, want 2", calls)
+	}
+}
+
+func TestLoader_CancelledLoad(t *testing.T) {
+	l := newLoader(context.Background(), func(ctx context.Context, keys []string) ([]string, []error) {
+		return make([]string, len(keys)), make([]error, len(keys))
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := l.Load(ctx, "a"); !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled load error = %v, want context.Canceled", err)
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	fetch := fanOut(func(ctx context.Context, key int) (int, error) {
+		if key < 0 {
+			return 0, errors.New("negative")
+		}
+		return key * 2, nil
+	})
+
+	values, errs := fetch(context.Background(), []int{1, -1, 3})
+	if values[0] != 2 || values[2] != 6 || errs[0] != nil || errs[2] != nil {
+		t.Errorf("values = %v, errors = %v", values, errs)
+	}
+	if errs[1] == nil {
+		t.Error("the failing key should carry its error")
+	}
+}
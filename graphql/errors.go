@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/serverlesscloud/bian-go/apierr"
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/graphql/scalars"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errPayeesDisabled is returned by the payee mutations when no payee service
+// is configured. It reads as NOT_FOUND, like the REST payee routes, which are
+// not registered at all without a payee service.
+var errPayeesDisabled = fmt.Errorf("payee mutations are not enabled: %w", domains.ErrNotFound)
+
+// notFound returns a not found error for a resource, preserving domains.ErrNotFound
+func notFound(resource, id string) error {
+	return fmt.Errorf("%s %w: %s", resource, domains.ErrNotFound, id)
+}
+
+// WithHideInternalErrors replaces the message of INTERNAL_ERROR errors with a
+// generic one so provider details never reach clients (use in production)
+func WithHideInternalErrors(hide bool) Option {
+	return func(o *options) {
+		o.hideInternalErrors = hide
+	}
+}
+
+// errorCode classifies a resolver error using the codes shared with REST
+func errorCode(err error) apierr.Code {
+	switch {
+	case domains.IsNotFound(err):
+		return apierr.NotFound
+	case errors.Is(err, domains.ErrForbidden):
+		return apierr.Forbidden
+	case errors.Is(err, domains.ErrRateLimited):
+		return apierr.RateLimited
+	case errors.Is(err, scalars.ErrInvalidValue):
+		return apierr.InvalidInput
+	}
+	if _, ok := models.AsValidationError(err); ok && !errors.Is(err, domains.ErrInvalidProviderOutput) {
+		return apierr.InvalidInput
+	}
+	return apierr.InternalError
+}
+
+// errorPresenter attaches extensions.code and extensions.requestId to every
+// resolver error, mirroring the REST ErrorResponse: validation failures also
+// carry extensions.fields, and internal error text is optionally hidden
+func errorPresenter(hideInternalErrors bool) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]interface{}{}
+		}
+		requestID := apierr.RequestIDFromContext(ctx)
+
+		if _, coded := gqlErr.Extensions["code"]; !coded {
+			code := errorCode(err)
+			gqlErr.Extensions["code"] = code
+
+			if ve, ok := models.AsValidationError(err); ok && code == apierr.InvalidInput {
+				gqlErr.Extensions["fields"] = ve.Fields
+			}
+			if code == apierr.InternalError && hideInternalErrors {
+				log.Printf("[%s] GraphQL internal error at %s: %v", requestID, gqlErr.Path, err)
+				gqlErr.Message = "Internal server error"
+			}
+		}
+
+		if requestID != "" {
+			gqlErr.Extensions["requestId"] = requestID
+		}
+		return gqlErr
+	}
+}
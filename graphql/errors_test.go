@@ -0,0 +1,126 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/apierr"
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/graphql/scalars"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+func TestErrorCode(t *testing.T) {
+	var invalid models.FieldErrors
+	invalid.Add("limit", "must be positive")
+
+	tests := []struct {
+		name string
+		err  error
+		want apierr.Code
+	}{
+		{"not found", notFound("account", "acc-999"), apierr.NotFound},
+		{"forbidden", fmt.Errorf("account %w: acc-001", domains.ErrForbidden), apierr.Forbidden},
+		{"rate limited", fmt.Errorf("upstream: %w", domains.ErrRateLimited), apierr.RateLimited},
+		{"invalid scalar", fmt.Errorf("fromDate: %w", scalars.ErrInvalidValue), apierr.InvalidInput},
+		{"caller validation", invalid.Err(), apierr.InvalidInput},
+		{"provider validation", fmt.Errorf("%w: %w", domains.ErrInvalidProviderOutput, invalid.Err()), apierr.InternalError},
+		{"payees disabled", errPayeesDisabled, apierr.NotFound},
+		{"unclassified", errors.New("connection refused"), apierr.InternalError},
+		{"unwrapped not found text", errors.New("account not found"), apierr.InternalError},
+	}
+	for _, tt := range tests {
+		if got := errorCode(tt.err); got != tt.want {
+			t.Errorf("%s: errorCode() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+// failingProvider fails account lookups with an error exposing internal details
+type failingProvider struct {
+	*mock.Provider
+}
+
+func (p failingProvider) RetrieveCurrentAccount(ctx context.Context, accountID string) (*models.Account, error) {
+	if accountID == "acc-001" {
+		return nil, errors.New("dial tcp 10.0.0.5:5432: password authentication failed")
+	}
+	return p.Provider.RetrieveCurrentAccount(ctx, accountID)
+}
+
+func TestErrorPresenter(t *testing.T) {
+	provider := failingProvider{mock.NewProvider()}
+
+	tests := []struct {
+		name        string
+		hide        bool
+		query       string
+		wantCode    apierr.Code
+		wantMessage string
+	}{
+		{"not found", true, `{ account(id: "acc-999") { id } }`, apierr.NotFound, "account not found: acc-999"},
+		{"internal error shown", false, `{ account(id: "acc-001") { id } }`, apierr.InternalError, "password authentication failed"},
+		{"internal error hidden", true, `{ account(id: "acc-001") { id } }`, apierr.InternalError, "Internal server error"},
+		{"invalid input", true, `{ transactions(accountId: "acc-002", input: {limit: 0}) { id } }`, apierr.InvalidInput, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewServer(provider, provider, provider, provider, WithHideInternalErrors(tt.hide)).Handler()
+			response := postGraphQL(t, handler, map[string]any{"query": tt.query})
+			if len(response.Errors) != 1 {
+				t.Fatalf("errors = %+v, want one", response.Errors)
+			}
+			gqlErr := response.Errors[0]
+			if code := response.errorCode(); code != string(tt.wantCode) {
+				t.Errorf("code = %s, want %s", code, tt.wantCode)
+			}
+			if !strings.Contains(gqlErr.Message, tt.wantMessage) {
+				t.Errorf("message = %q, want it to contain %q", gqlErr.Message, tt.wantMessage)
+			}
+			// Masking hides provider details entirely
+			if tt.hide && strings.Contains(gqlErr.Message, "10.0.0.5") {
+				t.Errorf("hidden internal error leaked %q", gqlErr.Message)
+			}
+			if requestID, _ := gqlErr.Extensions["requestId"].(string); !apierr.ValidRequestID(requestID) {
+				t.Errorf("requestId = %v", gqlErr.Extensions["requestId"])
+			}
+			_, hasFields := gqlErr.Extensions["fields"]
+			if hasFields != (tt.wantCode == apierr.InvalidInput) {
+				t.Errorf("fields = %v, want them only for INVALID_INPUT", gqlErr.Extensions["fields"])
+			}
+		})
+	}
+}
+
+func TestErrorPresenter_RequestID(t *testing.T) {
+	handler := newTestHandler()
+
+	req := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query": "{ account(id: \"acc-999\") { id } }"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(apierr.RequestIDHeader, "client-42")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	// The caller's ID is echoed in the header and in every error
+	if got := rec.Header().Get(apierr.RequestIDHeader); got != "client-42" {
+		t.Errorf("X-Request-ID = %q, want client-42", got)
+	}
+	if !strings.Contains(rec.Body.String(), `"requestId":"client-42"`) {
+		t.Errorf("body %s does not carry the request ID", rec.Body.String())
+	}
+}
+
+func TestPayeeMutations_Disabled(t *testing.T) {
+	handler := newTestHandler()
+
+	response := postGraphQL(t, handler, map[string]any{"query": `mutation { deletePayee(id: "payee-001") }`})
+	if code := response.errorCode(); code != string(apierr.NotFound) {
+		t.Errorf("code = %q, want NOT_FOUND (errors %+v)", code, response.Errors)
+	}
+}
@@ -0,0 +1,31 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+import (
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+type InsightsInput struct {
+	FromDate *time.Time            `json:"fromDate,omitempty"`
+	ToDate   *time.Time            `json:"toDate,omitempty"`
+	Period   *models.InsightPeriod `json:"period,omitempty"`
+}
+
+type Mutation struct {
+}
+
+type Query struct {
+}
+
+type Subscription struct {
+}
+
+type TransactionHistoryInput struct {
+	FromDate *time.Time `json:"fromDate,omitempty"`
+	ToDate   *time.Time `json:"toDate,omitempty"`
+	Limit    *int       `json:"limit,omitempty"`
+	Offset   *int       `json:"offset,omitempty"`
+}
@@ -0,0 +1,264 @@
+package graphql
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/graphql/generated"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Error codes returned in extensions.code when an operation exceeds a limit.
+// COMPLEXITY_LIMIT_EXCEEDED is set by gqlgen's complexity extension.
+const (
+	errComplexityLimitExceeded = "COMPLEXITY_LIMIT_EXCEEDED"
+	errDepthLimitExceeded      = "DEPTH_LIMIT_EXCEEDED"
+)
+
+// Limit violations are rejected before execution, like parse and validation errors
+func init() {
+	errcode.RegisterErrorType(errComplexityLimitExceeded, errcode.KindProtocol)
+	errcode.RegisterErrorType(errDepthLimitExceeded, errcode.KindProtocol)
+}
+
+// Costs sets the complexity weight of list fields. A list field costs its
+// assumed size multiplied by the cost of the selection beneath it.
+type Costs struct {
+	// Assumed page size when transactions is queried without input.limit
+	DefaultTransactionLimit int
+
+	// Assumed number of balance types per account
+	BalancesPerAccount int
+
+	// Assumed number of consents covering an account
+	ConsentsPerAccount int
+
+	// Assumed number of standing orders or direct debits on an account
+	ScheduledPaymentsPerAccount int
+
+	// Assumed number of saved payees per customer
+	PayeesPerCustomer int
+
+	// Assumed number of products in the catalogue
+	ProductsPerCatalogue int
+
+	// Cost of insights, which read the whole transaction history in their
+	// date range, added to the cost of the selection beneath them
+	Insights int
+}
+
+// Limits bounds the work a single GraphQL operation may request
+type Limits struct {
+	// Maximum total complexity of an operation (0 disables the check)
+	MaxComplexity int
+
+	// Maximum selection depth of an operation (0 disables the check)
+	MaxDepth int
+
+	// Per-field complexity weights
+	Costs Costs
+}
+
+// DefaultLimits returns limits suited to the bundled schema
+func DefaultLimits() Limits {
+	return Limits{
+		MaxComplexity: 2000,
+		MaxDepth:      10,
+		Costs: Costs{
+			DefaultTransactionLimit:     100,
+			BalancesPerAccount:          3,
+			ConsentsPerAccount:          5,
+			ScheduledPaymentsPerAccount: 10,
+			PayeesPerCustomer:           20,
+			ProductsPerCatalogue:        50,
+			Insights:                    100,
+		},
+	}
+}
+
+// WithLimits overrides the default complexity and depth limits
+func WithLimits(limits Limits) Option {
+	return func(o *options) {
+		o.limits = limits
+	}
+}
+
+// complexity weights list fields by their expected size; transaction lists are
+// weighted by the requested limit
+func (c Costs) complexity() generated.ComplexityRoot {
+	var root generated.ComplexityRoot
+
+	transactions := func(childComplexity int, input *generated.TransactionHistoryInput) int {
+		size := c.DefaultTransactionLimit
+		if input != nil && input.Limit != nil && *input.Limit > 0 {
+			size = min(*input.Limit, domains.MaxHistoryLimit)
+		}
+		return listCost(childComplexity, size)
+	}
+	balances := func(childComplexity int) int {
+		return listCost(childComplexity, c.BalancesPerAccount)
+	}
+
+	root.Query.Transactions = func(childComplexity int, accountID string, input *generated.TransactionHistoryInput) int {
+		return transactions(childComplexity, input)
+	}
+	root.Query.Balances = func(childComplexity int, accountID string) int {
+		return balances(childComplexity)
+	}
+	root.Account.Transactions = transactions
+	root.Account.Balances = balances
+	root.Account.Consents = func(childComplexity int) int {
+		return listCost(childComplexity, c.ConsentsPerAccount)
+	}
+	scheduledPayments := func(childComplexity int) int {
+		return listCost(childComplexity, c.ScheduledPaymentsPerAccount)
+	}
+	root.Account.StandingOrders = scheduledPayments
+	root.Account.DirectDebits = scheduledPayments
+	root.Query.Payees = func(childComplexity int, customerID string) int {
+		return listCost(childComplexity, c.PayeesPerCustomer)
+	}
+	root.Query.Products = func(childComplexity int, category *models.ProductCategory, effective *domains.ProductEffective) int {
+		return listCost(childComplexity, c.ProductsPerCatalogue)
+	}
+	insights := func(childComplexity int, input *generated.InsightsInput) int {
+		return c.Insights + childComplexity
+	}
+	root.Query.Insights = func(childComplexity int, accountID string, input *generated.InsightsInput) int {
+		return insights(childComplexity, input)
+	}
+	root.Account.Insights = insights
+
+	return root
+}
+
+// listCost is the cost of a list of size items, saturating instead of
+// overflowing so nested lists cannot wrap around the limit
+func listCost(childComplexity, size int) int {
+	if size <= 0 {
+		size = 1
+	}
+	if childComplexity > (math.MaxInt-1)/size {
+		return math.MaxInt
+	}
+	return 1 + childComplexity*size
+}
+
+// complexityLimit returns gqlgen's complexity extension for the limits, or nil when disabled
+func (l Limits) complexityLimit() *extension.ComplexityLimit {
+	if l.MaxComplexity <= 0 {
+		return nil
+	}
+	return extension.FixedComplexityLimit(l.MaxComplexity)
+}
+
+// operationLimit rejects operations that nest deeper than MaxDepth or select
+// more than MaxComplexity fields. Every field costs at least 1, so such an
+// operation would also fail the complexity check. It measures each fragment
+// once, in time linear in the query, and runs before gqlgen's complexity walk,
+// which expands every fragment spread and takes exponential time on fragments
+// that spread each other repeatedly. Introspection fields are not counted.
+type operationLimit struct {
+	maxDepth  int
+	maxFields int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = operationLimit{}
+
+// operationLimit returns the extension enforcing the limits, or nil when both are disabled
+func (l Limits) operationLimit() *operationLimit {
+	if l.MaxDepth <= 0 && l.MaxComplexity <= 0 {
+		return nil
+	}
+	return &operationLimit{maxDepth: l.MaxDepth, maxFields: l.MaxComplexity}
+}
+
+// ExtensionName implements graphql.HandlerExtension
+func (l operationLimit) ExtensionName() string {
+	return "OperationLimit"
+}
+
+// Validate implements graphql.HandlerExtension
+func (l operationLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator
+func (l operationLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil {
+		return nil
+	}
+	size := measureSelections(opCtx.Operation.SelectionSet, make(map[string]selectionSize))
+	if l.maxDepth > 0 && size.depth > l.maxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", size.depth, l.maxDepth)
+		errcode.Set(err, errDepthLimitExceeded)
+		return err
+	}
+	if l.maxFields > 0 && size.fields > l.maxFields {
+		err := gqlerror.Errorf("operation selects %d fields, which exceeds the complexity limit of %d", size.fields, l.maxFields)
+		errcode.Set(err, errComplexityLimitExceeded)
+		return err
+	}
+	return nil
+}
+
+// selectionSize is the deepest field nesting and the total number of fields
+// of a selection set with its fragments expanded
+type selectionSize struct {
+	depth  int
+	fields int
+}
+
+// measureSelections returns the size of a selection set, measuring each named
+// fragment once and caching the result in fragments
+func measureSelections(selections ast.SelectionSet, fragments map[string]selectionSize) selectionSize {
+	var total selectionSize
+	for _, selection := range selections {
+		var size selectionSize
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			size = measureSelections(s.SelectionSet, fragments)
+			size.depth++
+			size.fields = addSaturating(size.fields, 1)
+		case *ast.InlineFragment:
+			size = measureSelections(s.SelectionSet, fragments)
+		case *ast.FragmentSpread:
+			if s.Definition == nil {
+				continue
+			}
+			cached, ok := fragments[s.Name]
+			if !ok {
+				// Validation rejects fragment cycles; the placeholder keeps
+				// one from recursing forever regardless
+				fragments[s.Name] = selectionSize{}
+				cached = measureSelections(s.Definition.SelectionSet, fragments)
+				fragments[s.Name] = cached
+			}
+			size = cached
+		}
+		total.depth = max(total.depth, size.depth)
+		total.fields = addSaturating(total.fields, size.fields)
+	}
+	return total
+}
+
+// addSaturating adds two non-negative counts, saturating instead of overflowing
+func addSaturating(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
@@ -0,0 +1,152 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+// graphQLResponse is the decoded body of a GraphQL HTTP response
+type graphQLResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+// errorCode returns the extensions.code of the first error, or "" when there is none
+func (r graphQLResponse) errorCode() string {
+	if len(r.Errors) == 0 {
+		return ""
+	}
+	code, _ := r.Errors[0].Extensions["code"].(string)
+	return code
+}
+
+// postGraphQL POSTs a GraphQL request body to handler and decodes the response
+func postGraphQL(t *testing.T, handler http.Handler, body map[string]any) graphQLResponse {
+	t.Helper()
+	payload, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest("POST", "/graphql", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var response graphQLResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode response: %v (body %s)", err, rec.Body.String())
+	}
+	return response
+}
+
+// newTestHandler serves the mock provider over GraphQL
+func newTestHandler(opts ...Option) http.Handler {
+	provider := mock.NewProvider()
+	return NewServer(provider, provider, provider, provider, opts...).Handler()
+}
+
+func TestLimits(t *testing.T) {
+	handler := newTestHandler()
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode string
+	}{
+		{
+			name:  "within limits",
+			query: `{ account(id: "acc-001") { id transactions(input: {limit: 10}) { id } } }`,
+		},
+		{
+			// 500 transactions, each with 500 nested transactions
+			name:     "over complexity",
+			query:    `{ account(id: "acc-001") { transactions(input: {limit: 500}) { account { transactions(input: {limit: 500}) { id } } } } }`,
+			wantCode: errComplexityLimitExceeded,
+		},
+		{
+			// Depth 12 against the default limit of 10
+			name:     "over depth",
+			query:    `{ account(id: "acc-001") { ` + strings.Repeat(`transactions(input: {limit: 1}) { account { `, 5) + `id` + strings.Repeat(` } }`, 5) + ` } }`,
+			wantCode: errDepthLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := postGraphQL(t, handler, map[string]any{"query": tt.query})
+			if code := response.errorCode(); code != tt.wantCode {
+				t.Errorf("error code = %q, want %q (errors %+v)", code, tt.wantCode, response.Errors)
+			}
+			// Rejected operations never execute
+			if tt.wantCode != "" && response.Data != nil {
+				t.Errorf("rejected operation returned data %v", response.Data)
+			}
+		})
+	}
+}
+
+func TestLimits_Disabled(t *testing.T) {
+	handler := newTestHandler(WithLimits(Limits{Costs: DefaultLimits().Costs}))
+
+	query := `{ account(id: "acc-001") { transactions(input: {limit: 500}) { account { transactions(input: {limit: 500}) { id } } } } }`
+	if response := postGraphQL(t, handler, map[string]any{"query": query}); len(response.Errors) != 0 {
+		t.Errorf("errors = %+v, want none with limits disabled", response.Errors)
+	}
+}
+
+func TestSelectionDepth(t *testing.T) {
+	handler := newTestHandler(WithLimits(Limits{MaxDepth: 2, Costs: DefaultLimits().Costs}))
+
+	// Fragments count towards depth; introspection does not
+	fragment := `query { account(id: "acc-001") { ...balances } } fragment balances on Account { currentBalance { amount { amount } } }`
+	if response := postGraphQL(t, handler, map[string]any{"query": fragment}); response.errorCode() != errDepthLimitExceeded {
+		t.Errorf("fragment error code = %q, want %s", response.errorCode(), errDepthLimitExceeded)
+	}
+	introspection := `{ __schema { types { fields { type { name } } } } }`
+	if response := postGraphQL(t, handler, map[string]any{"query": introspection}); len(response.Errors) != 0 {
+		t.Errorf("introspection errors = %+v, want none", response.Errors)
+	}
+}
+
+// fragmentChain returns a query of levels fragments, each spreading the next
+// twice, which expands to 2^levels copies of the last one
+func fragmentChain(levels int) string {
+	var query strings.Builder
+	query.WriteString(`query { account(id: "acc-001") { ...F0 } }`)
+	for i := range levels {
+		fmt.Fprintf(&query, " fragment F%d on Account { id ...F%d ...F%d }", i, i+1, i+1)
+	}
+	fmt.Fprintf(&query, " fragment F%d on Account { id }", levels)
+	return query.String()
+}
+
+func TestLimits_FragmentExpansion(t *testing.T) {
+	handler := newTestHandler()
+
+	// A small chain is within the limits
+	if response := postGraphQL(t, handler, map[string]any{"query": fragmentChain(4)}); len(response.Errors) != 0 {
+		t.Fatalf("errors = %+v, want none", response.Errors)
+	}
+
+	// Each level doubles the expanded size, so walking 60 levels would never
+	// finish; the query must be rejected without expanding it
+	start := time.Now()
+	response := postGraphQL(t, handler, map[string]any{"query": fragmentChain(60)})
+	if code := response.errorCode(); code != errComplexityLimitExceeded {
+		t.Errorf("error code = %q, want %s (errors %+v)", code, errComplexityLimitExceeded, response.Errors)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("rejecting the query took %s", elapsed)
+	}
+}
@@ -0,0 +1,132 @@
+package graphql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errPersistedQueryNotAllowed is returned in allowlist mode for operations that
+// were not registered ahead of time
+const errPersistedQueryNotAllowed = "PERSISTED_QUERY_NOT_ALLOWED"
+
+func init() {
+	errcode.RegisterErrorType(errPersistedQueryNotAllowed, errcode.KindProtocol)
+}
+
+// PersistedQueries is an allowlist of operations keyed by the hex SHA-256 hash
+// of their text, the same hash Automatic Persisted Query clients send
+type PersistedQueries map[string]string
+
+// NewPersistedQueries builds an allowlist from operation texts
+func NewPersistedQueries(queries ...string) PersistedQueries {
+	pq := make(PersistedQueries, len(queries))
+	for _, query := range queries {
+		pq[queryHash(query)] = query
+	}
+	return pq
+}
+
+// LoadPersistedQueries reads a JSON manifest of {"<sha256>": "<query>"} and
+// verifies every hash against its query
+func LoadPersistedQueries(r io.Reader) (PersistedQueries, error) {
+	var manifest map[string]string
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode persisted queries: %w", err)
+	}
+
+	pq := make(PersistedQueries, len(manifest))
+	for hash, query := range manifest {
+		if queryHash(query) != hash {
+			return nil, fmt.Errorf("persisted query hash %s does not match its query", hash)
+		}
+		pq[hash] = query
+	}
+	return pq, nil
+}
+
+// LoadPersistedQueriesFile reads a persisted query manifest from disk
+func LoadPersistedQueriesFile(path string) (PersistedQueries, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open persisted queries: %w", err)
+	}
+	defer f.Close()
+	return LoadPersistedQueries(f)
+}
+
+// WithPersistedQueryAllowlist restricts the server to the given operations.
+// Clients may send either the registered hash (APQ style) or the full query
+// text; anything else is rejected with PERSISTED_QUERY_NOT_ALLOWED.
+func WithPersistedQueryAllowlist(queries PersistedQueries) Option {
+	return func(o *options) {
+		o.persistedQueries = queries
+	}
+}
+
+// persistedQueryAllowlist is a handler extension that only admits registered operations
+type persistedQueryAllowlist struct {
+	queries PersistedQueries
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = persistedQueryAllowlist{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (a persistedQueryAllowlist) ExtensionName() string {
+	return "PersistedQueryAllowlist"
+}
+
+// Validate implements graphql.HandlerExtension
+func (a persistedQueryAllowlist) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters resolves hashes to registered queries and rejects unknown operations
+func (a persistedQueryAllowlist) MutateOperationParameters(ctx context.Context, params *graphql.RawParams) *gqlerror.Error {
+	hash := persistedQueryHash(params.Extensions)
+	if hash == "" {
+		hash = queryHash(params.Query)
+	}
+
+	query, ok := a.queries[hash]
+	if !ok {
+		err := gqlerror.Errorf("operation is not in the persisted query allowlist")
+		errcode.Set(err, errPersistedQueryNotAllowed)
+		return err
+	}
+	if params.Query != "" && params.Query != query {
+		err := gqlerror.Errorf("provided persisted query hash does not match query")
+		errcode.Set(err, errPersistedQueryNotAllowed)
+		return err
+	}
+
+	params.Query = query
+	return nil
+}
+
+// persistedQueryHash extracts extensions.persistedQuery.sha256Hash from a request
+func persistedQueryHash(extensions map[string]any) string {
+	pq, ok := extensions["persistedQuery"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	hash, _ := pq["sha256Hash"].(string)
+	return hash
+}
+
+// queryHash returns the hex SHA-256 hash of a query
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
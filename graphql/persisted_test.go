@@ -0,0 +1,95 @@
+package graphql
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const persistedAccountQuery = `{ account(id: "acc-001") { id } }`
+
+// persistedExtension is the APQ extensions object for query's hash
+func persistedExtension(query string) map[string]any {
+	return map[string]any{
+		"persistedQuery": map[string]any{"version": 1, "sha256Hash": queryHash(query)},
+	}
+}
+
+func TestPersistedQueryAllowlist(t *testing.T) {
+	handler := newTestHandler(WithPersistedQueryAllowlist(NewPersistedQueries(persistedAccountQuery)))
+	other := `{ account(id: "acc-002") { id } }`
+
+	tests := []struct {
+		name     string
+		body     map[string]any
+		wantCode string
+	}{
+		{"registered hash", map[string]any{"extensions": persistedExtension(persistedAccountQuery)}, ""},
+		{"registered text", map[string]any{"query": persistedAccountQuery}, ""},
+		{"unknown hash", map[string]any{"extensions": persistedExtension(other)}, errPersistedQueryNotAllowed},
+		{"unknown text", map[string]any{"query": other}, errPersistedQueryNotAllowed},
+		{"hash of another query", map[string]any{"query": other, "extensions": persistedExtension(persistedAccountQuery)}, errPersistedQueryNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := postGraphQL(t, handler, tt.body)
+			if code := response.errorCode(); code != tt.wantCode {
+				t.Fatalf("error code = %q, want %q (errors %+v)", code, tt.wantCode, response.Errors)
+			}
+			if tt.wantCode == "" && response.Data["account"] == nil {
+				t.Errorf("data = %v, want the account", response.Data)
+			}
+		})
+	}
+}
+
+func TestAutomaticPersistedQueries(t *testing.T) {
+	handler := newTestHandler()
+	hashOnly := map[string]any{"extensions": persistedExtension(persistedAccountQuery)}
+
+	// An unregistered hash asks the client to send the full query
+	if code := postGraphQL(t, handler, hashOnly).errorCode(); code != "PERSISTED_QUERY_NOT_FOUND" {
+		t.Fatalf("miss error code = %q, want PERSISTED_QUERY_NOT_FOUND", code)
+	}
+
+	// Sending the query with its hash registers it
+	register := map[string]any{"query": persistedAccountQuery, "extensions": persistedExtension(persistedAccountQuery)}
+	if response := postGraphQL(t, handler, register); len(response.Errors) != 0 {
+		t.Fatalf("register errors = %+v", response.Errors)
+	}
+
+	// Later requests need only the hash
+	response := postGraphQL(t, handler, hashOnly)
+	if len(response.Errors) != 0 || response.Data["account"] == nil {
+		t.Errorf("hit = %v, %+v; want the account", response.Data, response.Errors)
+	}
+}
+
+func TestLoadPersistedQueries(t *testing.T) {
+	manifest := `{"` + queryHash(persistedAccountQuery) + `": "{ account(id: \"acc-001\") { id } }"}`
+	queries, err := LoadPersistedQueries(strings.NewReader(manifest))
+	if err != nil || queries[queryHash(persistedAccountQuery)] != persistedAccountQuery {
+		t.Fatalf("LoadPersistedQueries() = %v, %v", queries, err)
+	}
+
+	// A hash that does not match its query is rejected
+	if _, err := LoadPersistedQueries(strings.NewReader(`{"abc123": "{ account(id: \"acc-001\") { id } }"}`)); err == nil {
+		t.Error("LoadPersistedQueries() should reject a mismatched hash")
+	}
+	if _, err := LoadPersistedQueries(strings.NewReader(`[`)); err == nil {
+		t.Error("LoadPersistedQueries() should reject malformed JSON")
+	}
+
+	path := filepath.Join(t.TempDir(), "persisted.json")
+	if err := os.WriteFile(path, []byte(manifest), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if queries, err := LoadPersistedQueriesFile(path); err != nil || len(queries) != 1 {
+		t.Errorf("LoadPersistedQueriesFile() = %v, %v", queries, err)
+	}
+	if _, err := LoadPersistedQueriesFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("LoadPersistedQueriesFile() should fail for a missing file")
+	}
+}
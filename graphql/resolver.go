@@ -2,8 +2,6 @@ package graphql
 
 import (
 	"context"
-	"fmt"
-	"strings"
 	"time"
 
 	"github.com/serverlesscloud/bian-go/domains"
@@ -13,24 +11,49 @@ import (
 
 // Resolver contains the domain services
 type Resolver struct {
-	accountService     domains.AccountService
-	transactionService domains.TransactionService
-	balanceService     domains.BalanceService
-	consentService     domains.ConsentService
+	accountService       domains.AccountService
+	transactionService   domains.TransactionService
+	balanceService       domains.BalanceService
+	consentService       domains.ConsentService
+	eventSource          domains.EventSource
+	accountConsents      domains.AccountConsentRetriever
+	cardService          domains.CardService
+	standingOrderService domains.StandingOrderService
+	directDebitService   domains.DirectDebitService
+	payeeService         domains.PayeeService
+	productService       domains.ProductService
+	analyticsService     domains.AnalyticsService
 }
 
+
 // NewResolver creates a new GraphQL resolver
 func NewResolver(
 	accountService domains.AccountService,
 	transactionService domains.TransactionService,
 	balanceService domains.BalanceService,
 	consentService domains.ConsentService,
+	opts ...Option,
 ) *Resolver {
+	o := newOptions(opts)
+	if o.analyticsService == nil {
+		o.analyticsService = domains.NewTransactionAnalytics(transactionService)
+	}
+	if o.accountConsents == nil {
+		o.accountConsents, _ = consentService.(domains.AccountConsentRetriever)
+	}
 	return &Resolver{
-		accountService:     accountService,
-		transactionService: transactionService,
-		balanceService:     balanceService,
-		consentService:     consentService,
+		accountService:       accountService,
+		transactionService:   transactionService,
+		balanceService:       balanceService,
+		consentService:       consentService,
+		eventSource:          o.eventSource,
+		accountConsents:      o.accountConsents,
+		cardService:          o.cardService,
+		standingOrderService: o.standingOrderService,
+		directDebitService:   o.directDebitService,
+		payeeService:         o.payeeService,
+		productService:       o.productService,
+		analyticsService:     o.analyticsService,
 	}
 }
 
@@ -39,280 +62,643 @@ func (r *Resolver) Query() generated.QueryResolver {
 	return &queryResolver{r}
 }
 
+// Product resolver implementations
+func (r *Resolver) Product() generated.ProductResolver {
+	return &productResolver{r}
+}
+
+func (r *Resolver) ProductFeature() generated.ProductFeatureResolver {
+	return &productFeatureResolver{r}
+}
+
+func (r *Resolver) ProductConstraint() generated.ProductConstraintResolver {
+	return &productConstraintResolver{r}
+}
+
+func (r *Resolver) ProductEligibility() generated.ProductEligibilityResolver {
+	return &productEligibilityResolver{r}
+}
+
+func (r *Resolver) ProductFee() generated.ProductFeeResolver {
+	return &productFeeResolver{r}
+}
+
+func (r *Resolver) DepositRate() generated.DepositRateResolver {
+	return &depositRateResolver{r}
+}
+
+func (r *Resolver) LendingRate() generated.LendingRateResolver {
+	return &lendingRateResolver{r}
+}
+
+func (r *Resolver) RateTier() generated.RateTierResolver {
+	return &rateTierResolver{r}
+}
+
+// Mutation resolver implementation
+func (r *Resolver) Mutation() generated.MutationResolver {
+	return &mutationResolver{r}
+}
+
+// Account resolver implementation
+func (r *Resolver) Account() generated.AccountResolver {
+	return &accountResolver{r}
+}
+
+// Transaction resolver implementation
+func (r *Resolver) Transaction() generated.TransactionResolver {
+	return &transactionResolver{r}
+}
+
+// ScheduledPayment resolver implementation
+func (r *Resolver) ScheduledPayment() generated.ScheduledPaymentResolver {
+	return &scheduledPaymentResolver{r}
+}
+
+// AccountIdentifier resolver implementation
+func (r *Resolver) AccountIdentifier() generated.AccountIdentifierResolver {
+	return &accountIdentifierResolver{r}
+}
+
+// InternationalPayee resolver implementation
+func (r *Resolver) InternationalPayee() generated.InternationalPayeeResolver {
+	return &internationalPayeeResolver{r}
+}
+
 type queryResolver struct{ *Resolver }
+type accountResolver struct{ *Resolver }
+type transactionResolver struct{ *Resolver }
+type scheduledPaymentResolver struct{ *Resolver }
+type accountIdentifierResolver struct{ *Resolver }
+type internationalPayeeResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
+type productResolver struct{ *Resolver }
+type productFeatureResolver struct{ *Resolver }
+type productConstraintResolver struct{ *Resolver }
+type productEligibilityResolver struct{ *Resolver }
+type productFeeResolver struct{ *Resolver }
+type depositRateResolver struct{ *Resolver }
+type lendingRateResolver struct{ *Resolver }
+type rateTierResolver struct{ *Resolver }
 
 // Account resolves the account query
-func (r *queryResolver) Account(ctx context.Context, id string) (*generated.Account, error) {
+func (r *queryResolver) Account(ctx context.Context, id string) (*models.Account, error) {
 	account, err := r.accountService.RetrieveCurrentAccount(ctx, id)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, fmt.Errorf("account not found: %s", id)
+		if domains.IsNotFound(err) {
+			return nil, notFound("account", id)
 		}
 		return nil, err
 	}
 	
-	return &generated.Account{
-		ID:            account.ID,
-		AccountNumber: account.AccountNumber,
-		AccountType:   mapAccountType(account.AccountType),
-		ProductName:   account.ProductName,
-		Nickname:      &account.Nickname,
-		Status:        mapAccountStatus(account.Status),
-		OpenDate:      account.OpenDate.Format(time.RFC3339),
-		CloseDate:     formatTimePtr(account.CloseDate),
-		Currency:      account.Currency,
-	}, nil
+	r.loaders(ctx).accounts.Prime(id, account)
+	return account, nil
 }
 
 // Balance resolves the balance query (current balance only)
-func (r *queryResolver) Balance(ctx context.Context, accountID string) (*generated.Balance, error) {
+func (r *queryResolver) Balance(ctx context.Context, accountID string) (*models.Balance, error) {
 	balance, err := r.accountService.RetrieveCurrentAccountBalance(ctx, accountID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, fmt.Errorf("account not found: %s", accountID)
+		if domains.IsNotFound(err) {
+			return nil, notFound("account", accountID)
 		}
 		return nil, err
 	}
 	
-	return &generated.Balance{
-		BalanceType: mapBalanceType(balance.BalanceType),
-		Amount: &generated.Money{
-			Amount:   balance.Amount.Amount.String(),
-			Currency: balance.Amount.Currency,
-		},
-		Timestamp: balance.Timestamp.Format(time.RFC3339),
-	}, nil
+	return balance, nil
 }
 
 // Balances resolves the balances query (all balance types)
-func (r *queryResolver) Balances(ctx context.Context, accountID string) ([]*generated.Balance, error) {
+func (r *queryResolver) Balances(ctx context.Context, accountID string) ([]*models.Balance, error) {
 	balances, err := r.balanceService.RetrieveAccountBalance(ctx, accountID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, fmt.Errorf("account not found: %s", accountID)
+		if domains.IsNotFound(err) {
+			return nil, notFound("account", accountID)
 		}
 		return nil, err
 	}
 	
-	var result []*generated.Balance
-	for _, balance := range balances {
-		result = append(result, &generated.Balance{
-			BalanceType: mapBalanceType(balance.BalanceType),
-			Amount: &generated.Money{
-				Amount:   balance.Amount.Amount.String(),
-				Currency: balance.Amount.Currency,
-			},
-			Timestamp: balance.Timestamp.Format(time.RFC3339),
-		})
-	}
-	
-	return result, nil
+	return balances, nil
 }
 
 // Transaction resolves the transaction query
-func (r *queryResolver) Transaction(ctx context.Context, id string) (*generated.Transaction, error) {
+func (r *queryResolver) Transaction(ctx context.Context, id string) (*models.Transaction, error) {
 	transaction, err := r.transactionService.RetrievePaymentTransaction(ctx, id)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, fmt.Errorf("transaction not found: %s", id)
+		if domains.IsNotFound(err) {
+			return nil, notFound("transaction", id)
 		}
 		return nil, err
 	}
 	
-	return mapTransaction(transaction), nil
+	return transaction, nil
 }
 
 // Transactions resolves the transactions query
-func (r *queryResolver) Transactions(ctx context.Context, accountID string, input *generated.TransactionHistoryInput) ([]*generated.Transaction, error) {
+func (r *queryResolver) Transactions(ctx context.Context, accountID string, input *generated.TransactionHistoryInput) ([]*models.Transaction, error) {
+	return r.transactionHistory(ctx, accountID, input)
+}
+
+// Insights resolves the insights query
+func (r *queryResolver) Insights(ctx context.Context, accountID string, input *generated.InsightsInput) (*models.AccountInsights, error) {
+	return r.accountInsights(ctx, accountID, input)
+}
+
+// Consent resolves the consent query
+func (r *queryResolver) Consent(ctx context.Context, id string) (*models.Consent, error) {
+	consent, err := r.consentService.RetrieveConsent(ctx, id)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("consent", id)
+		}
+		return nil, err
+	}
+	
+	return consent, nil
+}
+
+// ConsentStatus resolves the consentStatus query
+func (r *queryResolver) ConsentStatus(ctx context.Context, id string) (*models.ConsentStatus, error) {
+	status, err := r.consentService.RetrieveConsentStatus(ctx, id)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("consent", id)
+		}
+		return nil, err
+	}
+	
+	return &status, nil
+}
+
+// Card resolves the card query
+func (r *queryResolver) Card(ctx context.Context, id string) (*models.Card, error) {
+	if r.cardService == nil {
+		return nil, notFound("card", id)
+	}
+	
+	card, err := r.cardService.RetrieveCard(ctx, id)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("card", id)
+		}
+		return nil, err
+	}
+	
+	return card, nil
+}
+
+// StandingOrder resolves the standingOrder query
+func (r *queryResolver) StandingOrder(ctx context.Context, id string) (*models.ScheduledPayment, error) {
+	if r.standingOrderService == nil {
+		return nil, notFound("standing order", id)
+	}
+	
+	standingOrder, err := r.standingOrderService.RetrieveStandingOrder(ctx, id)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("standing order", id)
+		}
+		return nil, err
+	}
+	
+	return standingOrder, nil
+}
+
+// DirectDebit resolves the directDebit query
+func (r *queryResolver) DirectDebit(ctx context.Context, id string) (*models.ScheduledPayment, error) {
+	if r.directDebitService == nil {
+		return nil, notFound("direct debit", id)
+	}
+	
+	directDebit, err := r.directDebitService.RetrieveDirectDebit(ctx, id)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("direct debit", id)
+		}
+		return nil, err
+	}
+	
+	return directDebit, nil
+}
+
+// Payees resolves the payees query, returning an empty list when payees are not enabled
+func (r *queryResolver) Payees(ctx context.Context, customerID string) ([]*models.Payee, error) {
+	if r.payeeService == nil {
+		return []*models.Payee{}, nil
+	}
+	
+	payees, err := r.payeeService.RetrievePayees(ctx, customerID)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("customer", customerID)
+		}
+		return nil, err
+	}
+	
+	return payees, nil
+}
+
+// Payee resolves the payee query
+func (r *queryResolver) Payee(ctx context.Context, id string) (*models.Payee, error) {
+	if r.payeeService == nil {
+		return nil, notFound("payee", id)
+	}
+	
+	payee, err := r.payeeService.RetrievePayee(ctx, id)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("payee", id)
+		}
+		return nil, err
+	}
+	
+	return payee, nil
+}
+
+// Products resolves the products query, returning an empty list when the catalogue is not enabled
+func (r *queryResolver) Products(ctx context.Context, category *models.ProductCategory, effective *domains.ProductEffective) ([]*models.Product, error) {
+	if r.productService == nil {
+		return []*models.Product{}, nil
+	}
+	
+	filter := domains.ProductFilter{}
+	if category != nil {
+		filter.Category = *category
+	}
+	if effective != nil {
+		filter.Effective = *effective
+	}
+	return r.productService.RetrieveProducts(ctx, filter)
+}
+
+// Product resolves the product query
+func (r *queryResolver) Product(ctx context.Context, id string) (*models.Product, error) {
+	if r.productService == nil {
+		return nil, notFound("product", id)
+	}
+	
+	product, err := r.productService.RetrieveProduct(ctx, id)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("product", id)
+		}
+		return nil, err
+	}
+	
+	return product, nil
+}
+
+// CreatePayee validates the input and saves it as a new payee for the customer
+func (r *mutationResolver) CreatePayee(ctx context.Context, customerID string, input models.Payee) (*models.Payee, error) {
+	if r.payeeService == nil {
+		return nil, errPayeesDisabled
+	}
+	
+	// The customer comes from the argument and the ID is assigned by the service
+	input.ID = ""
+	input.CustomerID = customerID
+	
+	var fields models.FieldErrors
+	fields.Nested("input", input.ValidateInput())
+	if err := fields.Err(); err != nil {
+		return nil, err
+	}
+	
+	payee, err := r.payeeService.CreatePayee(ctx, &input)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("customer", customerID)
+		}
+		return nil, err
+	}
+	
+	return payee, nil
+}
+
+// DeletePayee resolves the deletePayee mutation
+func (r *mutationResolver) DeletePayee(ctx context.Context, id string) (bool, error) {
+	if r.payeeService == nil {
+		return false, errPayeesDisabled
+	}
+	
+	if err := r.payeeService.DeletePayee(ctx, id); err != nil {
+		if domains.IsNotFound(err) {
+			return false, notFound("payee", id)
+		}
+		return false, err
+	}
+	
+	return true, nil
+}
+
+// transactionHistory validates the history input and retrieves the account's transactions
+func (r *Resolver) transactionHistory(ctx context.Context, accountID string, input *generated.TransactionHistoryInput) ([]*models.Transaction, error) {
 	opts := domains.HistoryOptions{}
+	var fields models.FieldErrors
 	
 	if input != nil {
-		if input.FromDate != nil {
-			fromDate, err := time.Parse("2006-01-02", *input.FromDate)
-			if err != nil {
-				return nil, fmt.Errorf("invalid fromDate format: %w", err)
-			}
-			opts.FromDate = &fromDate
-		}
-		
+		// Dates are already parsed and validated by the Date scalar
+		opts.FromDate = input.FromDate
 		if input.ToDate != nil {
-			toDate, err := time.Parse("2006-01-02", *input.ToDate)
-			if err != nil {
-				return nil, fmt.Errorf("invalid toDate format: %w", err)
-			}
-			opts.ToDate = &toDate
+			endOfDay := input.ToDate.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			opts.ToDate = &endOfDay
 		}
 		
 		if input.Limit != nil {
-			if *input.Limit <= 0 || *input.Limit > 500 {
-				return nil, fmt.Errorf("limit must be between 1 and 500")
+			if *input.Limit <= 0 {
+				fields.Add("input.limit", "must be a positive integer")
+			} else {
+				opts.Limit = *input.Limit
 			}
-			opts.Limit = *input.Limit
 		}
 		
 		if input.Offset != nil {
-			if *input.Offset < 0 {
-				return nil, fmt.Errorf("offset must be non-negative")
-			}
 			opts.Offset = *input.Offset
 		}
 	}
 	
+	fields.Nested("input", opts.Validate())
+	if err := fields.Err(); err != nil {
+		return nil, err
+	}
+	
 	transactions, err := r.transactionService.RetrievePaymentTransactionHistory(ctx, accountID, opts)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, fmt.Errorf("account not found: %s", accountID)
+		if domains.IsNotFound(err) {
+			return nil, notFound("account", accountID)
 		}
 		return nil, err
 	}
 	
-	var result []*generated.Transaction
-	for _, tx := range transactions {
-		result = append(result, mapTransaction(tx))
-	}
-	
-	return result, nil
+	return transactions, nil
 }
 
-// Consent resolves the consent query
-func (r *queryResolver) Consent(ctx context.Context, id string) (*generated.Consent, error) {
-	consent, err := r.consentService.RetrieveConsent(ctx, id)
+// accountInsights is shared by the insights query and Account.insights
+func (r *Resolver) accountInsights(ctx context.Context, accountID string, input *generated.InsightsInput) (*models.AccountInsights, error) {
+	opts := domains.InsightsOptions{}
+	if input != nil {
+		// Dates are already parsed and validated by the Date scalar
+		opts.FromDate = input.FromDate
+		opts.ToDate = input.ToDate
+		if input.Period != nil {
+			opts.Period = *input.Period
+		}
+	}
+	
+	var fields models.FieldErrors
+	fields.Nested("input", opts.Validate())
+	if err := fields.Err(); err != nil {
+		return nil, err
+	}
+	
+	insights, err := r.analyticsService.RetrieveAccountInsights(ctx, accountID, opts)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, fmt.Errorf("consent not found: %s", id)
+		if domains.IsNotFound(err) {
+			return nil, notFound("account", accountID)
 		}
 		return nil, err
 	}
 	
-	return &generated.Consent{
-		ID:             consent.ID,
-		Status:         mapConsentStatus(consent.Status),
-		Scopes:         consent.Scopes,
-		GrantDate:      consent.GrantDate.Format(time.RFC3339),
-		ExpiryDate:     consent.ExpiryDate.Format(time.RFC3339),
-		RevocationDate: formatTimePtr(consent.RevocationDate),
-	}, nil
+	return insights, nil
 }
 
-// ConsentStatus resolves the consentStatus query
-func (r *queryResolver) ConsentStatus(ctx context.Context, id string) (*generated.ConsentStatus, error) {
-	status, err := r.consentService.RetrieveConsentStatus(ctx, id)
+// Field resolvers where the domain model shape differs from the schema
+
+// Nickname returns null rather than an empty string when no nickname is set
+func (r *accountResolver) Nickname(ctx context.Context, obj *models.Account) (*string, error) {
+	return optionalString(obj.Nickname), nil
+}
+
+// CurrentBalance resolves the account's current balance through the request dataloader
+func (r *accountResolver) CurrentBalance(ctx context.Context, obj *models.Account) (*models.Balance, error) {
+	return r.loaders(ctx).currentBalances.Load(ctx, obj.ID)
+}
+
+// Balances resolves all balance types through the request dataloader
+func (r *accountResolver) Balances(ctx context.Context, obj *models.Account) ([]*models.Balance, error) {
+	return r.loaders(ctx).balances.Load(ctx, obj.ID)
+}
+
+// Transactions resolves the account's transaction history
+func (r *accountResolver) Transactions(ctx context.Context, obj *models.Account, input *generated.TransactionHistoryInput) ([]*models.Transaction, error) {
+	return r.transactionHistory(ctx, obj.ID, input)
+}
+
+// Insights resolves cash-flow insights from the account's transaction history
+func (r *accountResolver) Insights(ctx context.Context, obj *models.Account, input *generated.InsightsInput) (*models.AccountInsights, error) {
+	return r.accountInsights(ctx, obj.ID, input)
+}
+
+// Consents resolves the consents covering the account, empty when the consent
+// service cannot list them
+func (r *accountResolver) Consents(ctx context.Context, obj *models.Account) ([]*models.Consent, error) {
+	if r.accountConsents == nil {
+		return []*models.Consent{}, nil
+	}
+	return r.accountConsents.RetrieveAccountConsents(ctx, obj.ID)
+}
+
+// Card resolves the card issued on the account, or null when it has none
+func (r *accountResolver) Card(ctx context.Context, obj *models.Account) (*models.Card, error) {
+	if r.cardService == nil {
+		return nil, nil
+	}
+	
+	card, err := r.cardService.RetrieveAccountCard(ctx, obj.ID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			return nil, fmt.Errorf("consent not found: %s", id)
+		if domains.IsNotFound(err) {
+			return nil, nil
 		}
 		return nil, err
 	}
 	
-	mappedStatus := mapConsentStatus(status)
-	return &mappedStatus, nil
-}
-
-// Helper functions for mapping between domain models and GraphQL types
-
-func mapAccountType(at models.AccountType) generated.AccountType {
-	switch at {
-	case models.AccountTypeChecking:
-		return generated.AccountTypeChecking
-	case models.AccountTypeSavings:
-		return generated.AccountTypeSavings
-	case models.AccountTypeCreditCard:
-		return generated.AccountTypeCreditCard
-	case models.AccountTypeInvestment:
-		return generated.AccountTypeInvestment
-	default:
-		return generated.AccountTypeChecking
-	}
-}
-
-func mapAccountStatus(as models.AccountStatus) generated.AccountStatus {
-	switch as {
-	case models.AccountStatusOpen:
-		return generated.AccountStatusOpen
-	case models.AccountStatusClosed:
-		return generated.AccountStatusClosed
-	case models.AccountStatusSuspended:
-		return generated.AccountStatusSuspended
-	default:
-		return generated.AccountStatusOpen
-	}
-}
-
-func mapBalanceType(bt models.BalanceType) generated.BalanceType {
-	switch bt {
-	case models.BalanceTypeCurrent:
-		return generated.BalanceTypeCurrent
-	case models.BalanceTypeAvailable:
-		return generated.BalanceTypeAvailable
-	case models.BalanceTypePending:
-		return generated.BalanceTypePending
-	default:
-		return generated.BalanceTypeCurrent
-	}
-}
-
-func mapTransactionType(tt models.TransactionType) generated.TransactionType {
-	switch tt {
-	case models.TransactionTypeDebit:
-		return generated.TransactionTypeDebit
-	case models.TransactionTypeCredit:
-		return generated.TransactionTypeCredit
-	case models.TransactionTypeTransfer:
-		return generated.TransactionTypeTransfer
-	case models.TransactionTypePayment:
-		return generated.TransactionTypePayment
-	case models.TransactionTypeFee:
-		return generated.TransactionTypeFee
-	default:
-		return generated.TransactionTypeDebit
-	}
-}
-
-func mapConsentStatus(cs models.ConsentStatus) generated.ConsentStatus {
-	switch cs {
-	case models.ConsentStatusActive:
-		return generated.ConsentStatusActive
-	case models.ConsentStatusExpired:
-		return generated.ConsentStatusExpired
-	case models.ConsentStatusRevoked:
-		return generated.ConsentStatusRevoked
-	case models.ConsentStatusPending:
-		return generated.ConsentStatusPending
-	default:
-		return generated.ConsentStatusActive
-	}
-}
-
-func mapTransaction(tx *models.Transaction) *generated.Transaction {
-	result := &generated.Transaction{
-		ID:              tx.ID,
-		Reference:       &tx.Reference,
-		TransactionType: mapTransactionType(tx.TransactionType),
-		Amount: &generated.Money{
-			Amount:   tx.Amount.Amount.String(),
-			Currency: tx.Amount.Currency,
-		},
-		Description:  tx.Description,
-		MerchantName: &tx.MerchantName,
-		PostingDate:  tx.PostingDate.Format(time.RFC3339),
-		ValueDate:    tx.ValueDate.Format(time.RFC3339),
-		AccountID:    tx.AccountID,
-	}
-	
-	if tx.RunningBalance != nil {
-		result.RunningBalance = &generated.Money{
-			Amount:   tx.RunningBalance.Amount.String(),
-			Currency: tx.RunningBalance.Currency,
+	return card, nil
+}
+
+// StandingOrders resolves the standing orders paid from the account, empty
+// when no StandingOrderService is configured
+func (r *accountResolver) StandingOrders(ctx context.Context, obj *models.Account) ([]*models.ScheduledPayment, error) {
+	if r.standingOrderService == nil {
+		return []*models.ScheduledPayment{}, nil
+	}
+	return r.standingOrderService.RetrieveAccountStandingOrders(ctx, obj.ID)
+}
+
+// DirectDebits resolves the direct debits collected from the account, empty
+// when no DirectDebitService is configured
+func (r *accountResolver) DirectDebits(ctx context.Context, obj *models.Account) ([]*models.ScheduledPayment, error) {
+	if r.directDebitService == nil {
+		return []*models.ScheduledPayment{}, nil
+	}
+	return r.directDebitService.RetrieveAccountDirectDebits(ctx, obj.ID)
+}
+
+// ProductID returns null when the account's product is unknown
+func (r *accountResolver) ProductID(ctx context.Context, obj *models.Account) (*string, error) {
+	return optionalString(obj.ProductID), nil
+}
+
+// Product resolves the account's product, or null when it is unknown or the catalogue is not enabled
+func (r *accountResolver) Product(ctx context.Context, obj *models.Account) (*models.Product, error) {
+	if r.productService == nil || obj.ProductID == "" {
+		return nil, nil
+	}
+	
+	product, err := r.productService.RetrieveProduct(ctx, obj.ProductID)
+	if err != nil {
+		if domains.IsNotFound(err) {
+			return nil, nil
 		}
+		return nil, err
 	}
 	
-	return result
+	return product, nil
+}
+
+// Account resolves the owning account through the request dataloader
+func (r *transactionResolver) Account(ctx context.Context, obj *models.Transaction) (*models.Account, error) {
+	return r.loaders(ctx).accounts.Load(ctx, obj.AccountID)
+}
+
+// Reference returns null rather than an empty string when no reference is set
+func (r *transactionResolver) Reference(ctx context.Context, obj *models.Transaction) (*string, error) {
+	return optionalString(obj.Reference), nil
+}
+
+// MerchantName returns null rather than an empty string when no merchant is set
+func (r *transactionResolver) MerchantName(ctx context.Context, obj *models.Transaction) (*string, error) {
+	return optionalString(obj.MerchantName), nil
+}
+
+// Category returns null for transactions that have not been enriched or categorised
+func (r *transactionResolver) Category(ctx context.Context, obj *models.Transaction) (*models.TransactionCategory, error) {
+	if obj.Category == "" {
+		return nil, nil
+	}
+	return &obj.Category, nil
+}
+
+func (r *transactionResolver) MerchantCategoryCode(ctx context.Context, obj *models.Transaction) (*string, error) {
+	return optionalString(obj.MerchantCategoryCode), nil
+}
+
+func (r *transactionResolver) CleanMerchantName(ctx context.Context, obj *models.Transaction) (*string, error) {
+	return optionalString(obj.CleanMerchantName), nil
+}
+
+// Reference returns null rather than an empty string when no reference is set
+func (r *scheduledPaymentResolver) Reference(ctx context.Context, obj *models.ScheduledPayment) (*string, error) {
+	return optionalString(obj.Reference), nil
+}
+
+// MandateReference returns null for standing orders, which have no mandate
+func (r *scheduledPaymentResolver) MandateReference(ctx context.Context, obj *models.ScheduledPayment) (*string, error) {
+	return optionalString(obj.MandateReference), nil
+}
+
+// BankCode returns null for IBAN identifiers
+func (r *accountIdentifierResolver) BankCode(ctx context.Context, obj *models.AccountIdentifier) (*string, error) {
+	return optionalString(obj.BankCode), nil
+}
+
+// AccountNumber returns null for IBAN identifiers
+func (r *accountIdentifierResolver) AccountNumber(ctx context.Context, obj *models.AccountIdentifier) (*string, error) {
+	return optionalString(obj.AccountNumber), nil
+}
+
+// Iban returns null for domestic identifiers
+func (r *accountIdentifierResolver) Iban(ctx context.Context, obj *models.AccountIdentifier) (*string, error) {
+	return optionalString(obj.IBAN), nil
+}
+
+// Masked returns the masked IBAN, or the masked account number for domestic identifiers
+func (r *accountIdentifierResolver) Masked(ctx context.Context, obj *models.AccountIdentifier) (string, error) {
+	masked := obj.Masked()
+	if masked.IBAN != "" {
+		return masked.IBAN, nil
+	}
+	return masked.AccountNumber, nil
+}
+
+// Iban returns null for countries that do not use IBANs
+func (r *internationalPayeeResolver) Iban(ctx context.Context, obj *models.InternationalPayee) (*string, error) {
+	return optionalString(obj.IBAN), nil
+}
+
+// AccountNumber returns null when the payee is identified by IBAN
+func (r *internationalPayeeResolver) AccountNumber(ctx context.Context, obj *models.InternationalPayee) (*string, error) {
+	return optionalString(obj.AccountNumber), nil
+}
+
+// Optional product details return null rather than an empty string
+
+func (r *productResolver) ApplicationURI(ctx context.Context, obj *models.Product) (*string, error) {
+	return optionalString(obj.ApplicationURI), nil
+}
+
+func (r *productFeatureResolver) AdditionalValue(ctx context.Context, obj *models.ProductFeature) (*string, error) {
+	return optionalString(obj.AdditionalValue), nil
+}
+
+func (r *productFeatureResolver) AdditionalInfo(ctx context.Context, obj *models.ProductFeature) (*string, error) {
+	return optionalString(obj.AdditionalInfo), nil
+}
+
+func (r *productConstraintResolver) AdditionalInfo(ctx context.Context, obj *models.ProductConstraint) (*string, error) {
+	return optionalString(obj.AdditionalInfo), nil
+}
+
+func (r *productEligibilityResolver) AdditionalValue(ctx context.Context, obj *models.ProductEligibility) (*string, error) {
+	return optionalString(obj.AdditionalValue), nil
+}
+
+func (r *productEligibilityResolver) AdditionalInfo(ctx context.Context, obj *models.ProductEligibility) (*string, error) {
+	return optionalString(obj.AdditionalInfo), nil
+}
+
+func (r *productFeeResolver) AccrualFrequency(ctx context.Context, obj *models.ProductFee) (*string, error) {
+	return optionalString(obj.AccrualFrequency), nil
+}
+
+func (r *productFeeResolver) AdditionalInfo(ctx context.Context, obj *models.ProductFee) (*string, error) {
+	return optionalString(obj.AdditionalInfo), nil
 }
 
-func formatTimePtr(t *time.Time) *string {
-	if t == nil {
+func (r *depositRateResolver) CalculationFrequency(ctx context.Context, obj *models.DepositRate) (*string, error) {
+	return optionalString(obj.CalculationFrequency), nil
+}
+
+func (r *depositRateResolver) ApplicationFrequency(ctx context.Context, obj *models.DepositRate) (*string, error) {
+	return optionalString(obj.ApplicationFrequency), nil
+}
+
+func (r *depositRateResolver) AdditionalInfo(ctx context.Context, obj *models.DepositRate) (*string, error) {
+	return optionalString(obj.AdditionalInfo), nil
+}
+
+func (r *lendingRateResolver) CalculationFrequency(ctx context.Context, obj *models.LendingRate) (*string, error) {
+	return optionalString(obj.CalculationFrequency), nil
+}
+
+func (r *lendingRateResolver) ApplicationFrequency(ctx context.Context, obj *models.LendingRate) (*string, error) {
+	return optionalString(obj.ApplicationFrequency), nil
+}
+
+func (r *lendingRateResolver) AdditionalInfo(ctx context.Context, obj *models.LendingRate) (*string, error) {
+	return optionalString(obj.AdditionalInfo), nil
+}
+
+// RateApplicationMethod returns null when the tier does not specify one
+func (r *rateTierResolver) RateApplicationMethod(ctx context.Context, obj *models.RateTier) (*models.RateApplicationMethod, error) {
+	if obj.RateApplicationMethod == "" {
+		return nil, nil
+	}
+	return &obj.RateApplicationMethod, nil
+}
+
+func optionalString(s string) *string {
+	if s == "" {
 		return nil
 	}
-	formatted := t.Format(time.RFC3339)
-	return &formatted
-}
\ No newline at end of file
+	return &s
+}
@@ -0,0 +1,63 @@
+package scalars
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DateLayout is the calendar date format accepted and produced by the Date scalar
+const DateLayout = "2006-01-02"
+
+// ParseDateTime parses an RFC 3339 timestamp. An explicit offset ("Z" or
+// "+10:00") is required so instants are never ambiguous; the result is in UTC.
+func ParseDateTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, invalidValue("invalid DateTime %q: must be RFC 3339 with a time zone offset, e.g. 2024-01-15T09:30:00Z", value)
+	}
+	return t.UTC(), nil
+}
+
+// ParseDate parses a calendar date in YYYY-MM-DD format as midnight UTC
+func ParseDate(value string) (time.Time, error) {
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return time.Time{}, invalidValue("invalid Date %q: must be YYYY-MM-DD", value)
+	}
+	return t, nil
+}
+
+// MarshalDateTime writes a timestamp as an RFC 3339 string in UTC
+func MarshalDateTime(t time.Time) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(t.UTC().Format(time.RFC3339)))
+	})
+}
+
+// UnmarshalDateTime accepts an RFC 3339 string with a time zone offset
+func UnmarshalDateTime(v interface{}) (time.Time, error) {
+	value, ok := v.(string)
+	if !ok {
+		return time.Time{}, invalidValue("DateTime must be an RFC 3339 string, got %T", v)
+	}
+	return ParseDateTime(value)
+}
+
+// MarshalDate writes the calendar date of t (in its own location) as YYYY-MM-DD
+func MarshalDate(t time.Time) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(t.Format(DateLayout)))
+	})
+}
+
+// UnmarshalDate accepts a YYYY-MM-DD string
+func UnmarshalDate(v interface{}) (time.Time, error) {
+	value, ok := v.(string)
+	if !ok {
+		return time.Time{}, invalidValue("Date must be a YYYY-MM-DD string, got %T", v)
+	}
+	return ParseDate(value)
+}
@@ -0,0 +1,97 @@
+package scalars
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// marshalled returns the JSON a marshaler writes
+func marshalled(m graphql.Marshaler) string {
+	var buf bytes.Buffer
+	m.MarshalGQL(&buf)
+	return buf.String()
+}
+
+func TestDateTime(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Time
+		wire  string
+	}{
+		{"2024-01-15T09:30:00Z", time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC), "2024-01-15T09:30:00Z"},
+		{"2024-01-15T09:30:00+10:00", time.Date(2024, 1, 14, 23, 30, 0, 0, time.UTC), "2024-01-14T23:30:00Z"},
+		{"2024-01-15T09:30:00-05:30", time.Date(2024, 1, 15, 15, 0, 0, 0, time.UTC), "2024-01-15T15:00:00Z"},
+		// Fractional seconds are accepted; output has whole seconds
+		{"2024-01-15T09:30:00.123456789Z", time.Date(2024, 1, 15, 9, 30, 0, 123456789, time.UTC), "2024-01-15T09:30:00Z"},
+	}
+	for _, tt := range tests {
+		got, err := UnmarshalDateTime(tt.input)
+		if err != nil {
+			t.Errorf("UnmarshalDateTime(%q) error = %v", tt.input, err)
+			continue
+		}
+		if !got.Equal(tt.want) || got.Location() != time.UTC {
+			t.Errorf("UnmarshalDateTime(%q) = %v, want %v in UTC", tt.input, got, tt.want)
+		}
+		if wire := marshalled(MarshalDateTime(got)); wire != strconv.Quote(tt.wire) {
+			t.Errorf("MarshalDateTime(%v) = %s, want %q", got, wire, tt.wire)
+		}
+		// What is written can be read back
+		if back, err := UnmarshalDateTime(tt.wire); err != nil || !back.Equal(got.Truncate(time.Second)) {
+			t.Errorf("round trip of %s = %v, %v", tt.wire, back, err)
+		}
+	}
+
+	// Times in other locations are written in UTC
+	sydney := time.FixedZone("AEST", 10*60*60)
+	if wire := marshalled(MarshalDateTime(time.Date(2024, 1, 15, 9, 30, 0, 0, sydney))); wire != `"2024-01-14T23:30:00Z"` {
+		t.Errorf("MarshalDateTime(AEST) = %s", wire)
+	}
+}
+
+func TestDateTime_Invalid(t *testing.T) {
+	invalid := []interface{}{
+		"2024-01-15T09:30:00", // no offset
+		"2024-01-15",
+		"2024-01-15 09:30:00Z",
+		"2024-13-01T00:00:00Z",
+		"2024-02-30T00:00:00Z",
+		"yesterday",
+		"",
+		1705311000,
+		nil,
+	}
+	for _, v := range invalid {
+		if _, err := UnmarshalDateTime(v); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("UnmarshalDateTime(%#v) error = %v, want ErrInvalidValue", v, err)
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	got, err := UnmarshalDate("2024-02-29")
+	if err != nil || !got.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("UnmarshalDate(2024-02-29) = %v, %v", got, err)
+	}
+	if wire := marshalled(MarshalDate(got)); wire != `"2024-02-29"` {
+		t.Errorf("MarshalDate() = %s", wire)
+	}
+
+	// A date is written in its own location, not shifted to UTC
+	late := time.Date(2024, 1, 15, 23, 30, 0, 0, time.FixedZone("AEST", 10*60*60))
+	if wire := marshalled(MarshalDate(late)); wire != `"2024-01-15"` {
+		t.Errorf("MarshalDate(AEST) = %s, want the local date", wire)
+	}
+
+	invalid := []interface{}{"2023-02-29", "2024-1-5", "15/01/2024", "2024-01-15T00:00:00Z", "", 20240115, nil}
+	for _, v := range invalid {
+		if _, err := UnmarshalDate(v); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("UnmarshalDate(%#v) error = %v, want ErrInvalidValue", v, err)
+		}
+	}
+}
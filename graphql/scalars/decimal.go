@@ -0,0 +1,70 @@
+// Package scalars provides gqlgen marshalers for custom GraphQL scalars that
+// bind to third-party types.
+package scalars
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidValue matches every unmarshal error (via errors.Is) so callers can
+// report malformed scalar input as invalid input rather than an internal error
+var ErrInvalidValue = errors.New("invalid scalar value")
+
+// invalidValueError is a scalar parse failure that matches ErrInvalidValue
+type invalidValueError struct {
+	message string
+}
+
+func (e *invalidValueError) Error() string {
+	return e.message
+}
+
+func (e *invalidValueError) Is(target error) bool {
+	return target == ErrInvalidValue
+}
+
+// invalidValue formats a scalar parse failure
+func invalidValue(format string, args ...interface{}) error {
+	return &invalidValueError{message: fmt.Sprintf(format, args...)}
+}
+
+// MarshalDecimal writes a decimal as a JSON string so clients never lose
+// precision to floating point
+func MarshalDecimal(d decimal.Decimal) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(d.String()))
+	})
+}
+
+// UnmarshalDecimal accepts a string, integer or float literal
+func UnmarshalDecimal(v interface{}) (decimal.Decimal, error) {
+	switch value := v.(type) {
+	case string:
+		d, err := decimal.NewFromString(value)
+		if err != nil {
+			return decimal.Zero, invalidValue("invalid decimal %q", value)
+		}
+		return d, nil
+	case json.Number:
+		d, err := decimal.NewFromString(value.String())
+		if err != nil {
+			return decimal.Zero, invalidValue("invalid decimal %q", value)
+		}
+		return d, nil
+	case int:
+		return decimal.NewFromInt(int64(value)), nil
+	case int64:
+		return decimal.NewFromInt(value), nil
+	case float64:
+		return decimal.NewFromFloat(value), nil
+	default:
+		return decimal.Zero, invalidValue("decimal must be a string or number, got %T", v)
+	}
+}
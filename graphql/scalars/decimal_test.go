@@ -0,0 +1,47 @@
+package scalars
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		wire  string
+	}{
+		{"123.45", `"123.45"`},
+		{"-0.0001", `"-0.0001"`},
+		{"12345678901234567890.123456789", `"12345678901234567890.123456789"`},
+		{json.Number("99.90"), `"99.9"`},
+		{42, `"42"`},
+		{int64(-7), `"-7"`},
+		{0.5, `"0.5"`},
+	}
+	for _, tt := range tests {
+		d, err := UnmarshalDecimal(tt.input)
+		if err != nil {
+			t.Errorf("UnmarshalDecimal(%#v) error = %v", tt.input, err)
+			continue
+		}
+		wire := marshalled(MarshalDecimal(d))
+		if wire != tt.wire {
+			t.Errorf("MarshalDecimal(%#v) = %s, want %s", tt.input, wire, tt.wire)
+		}
+		// Decimals are written as strings, which read back unchanged
+		var s string
+		if err := json.Unmarshal([]byte(wire), &s); err != nil {
+			t.Fatal(err)
+		}
+		if back, err := UnmarshalDecimal(s); err != nil || !back.Equal(d) {
+			t.Errorf("round trip of %s = %v, %v", wire, back, err)
+		}
+	}
+
+	for _, v := range []interface{}{"12,50", "abc", "", json.Number("1e"), true, nil, []interface{}{"1"}} {
+		if _, err := UnmarshalDecimal(v); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("UnmarshalDecimal(%#v) error = %v, want ErrInvalidValue", v, err)
+		}
+	}
+}
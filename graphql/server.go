@@ -1,12 +1,22 @@
+//go:generate go run github.com/99designs/gqlgen generate
+
 package graphql
 
 import (
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/serverlesscloud/bian-go/apierr"
+	"github.com/serverlesscloud/bian-go/buildinfo"
 	"github.com/serverlesscloud/bian-go/domains"
 	"github.com/serverlesscloud/bian-go/graphql/generated"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 // Server represents the GraphQL server
@@ -15,23 +25,169 @@ type Server struct {
 	handler  http.Handler
 }
 
+// Option configures optional services and limits on the GraphQL server
+type Option func(*options)
+
+// options collects the optional GraphQL server settings
+type options struct {
+	eventSource          domains.EventSource
+	accountConsents      domains.AccountConsentRetriever
+	cardService          domains.CardService
+	standingOrderService domains.StandingOrderService
+	directDebitService   domains.DirectDebitService
+	payeeService         domains.PayeeService
+	productService       domains.ProductService
+	analyticsService     domains.AnalyticsService
+	limits               Limits
+	persistedQueries     PersistedQueries
+	
+	hideInternalErrors bool
+}
+
+// newOptions applies opts over the defaults
+func newOptions(opts []Option) *options {
+	o := &options{limits: DefaultLimits()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithEventSource enables subscriptions fed by the given event source
+func WithEventSource(eventSource domains.EventSource) Option {
+	return func(o *options) {
+		o.eventSource = eventSource
+	}
+}
+
+// WithAccountConsentRetriever resolves Account.consents. By default the
+// ConsentService is used when it implements domains.AccountConsentRetriever.
+func WithAccountConsentRetriever(accountConsents domains.AccountConsentRetriever) Option {
+	return func(o *options) {
+		o.accountConsents = accountConsents
+	}
+}
+
+// WithCardService enables the card query and Account.card
+func WithCardService(cardService domains.CardService) Option {
+	return func(o *options) {
+		o.cardService = cardService
+	}
+}
+
+// WithStandingOrderService enables the standingOrder query and Account.standingOrders
+func WithStandingOrderService(standingOrderService domains.StandingOrderService) Option {
+	return func(o *options) {
+		o.standingOrderService = standingOrderService
+	}
+}
+
+// WithDirectDebitService enables the directDebit query and Account.directDebits
+func WithDirectDebitService(directDebitService domains.DirectDebitService) Option {
+	return func(o *options) {
+		o.directDebitService = directDebitService
+	}
+}
+
+// WithPayeeService enables the payee queries and the createPayee and
+// deletePayee mutations
+func WithPayeeService(payeeService domains.PayeeService) Option {
+	return func(o *options) {
+		o.payeeService = payeeService
+	}
+}
+
+// WithProductService enables the products and product queries and Account.product
+func WithProductService(productService domains.ProductService) Option {
+	return func(o *options) {
+		o.productService = productService
+	}
+}
+
+// WithAnalyticsService replaces the default insights, which aggregate the
+// transaction service's history
+func WithAnalyticsService(analyticsService domains.AnalyticsService) Option {
+	return func(o *options) {
+		o.analyticsService = analyticsService
+	}
+}
+
+// SchemaDescription describes the schema with the service and BIAN versions
+// the binary was built with
+func SchemaDescription() string {
+	return "bian-go GraphQL API " + buildinfo.String()
+}
+
 // NewServer creates a new GraphQL server
 func NewServer(
 	accountService domains.AccountService,
 	transactionService domains.TransactionService,
 	balanceService domains.BalanceService,
 	consentService domains.ConsentService,
+	opts ...Option,
 ) *Server {
-	resolver := NewResolver(accountService, transactionService, balanceService, consentService)
+	o := newOptions(opts)
+	resolver := NewResolver(accountService, transactionService, balanceService, consentService, opts...)
+	
+	// Build versions are only known at link time, so the schema description
+	// is set on a copy of the parsed schema rather than in schema.graphql
+	described := *generated.NewExecutableSchema(generated.Config{}).Schema()
+	described.Description = SchemaDescription()
 	
-	config := generated.Config{Resolvers: resolver}
+	config := generated.Config{
+		Schema:     &described,
+		Resolvers:  resolver,
+		Complexity: o.limits.Costs.complexity(),
+	}
 	schema := generated.NewExecutableSchema(config)
 	
-	srv := handler.NewDefaultServer(schema)
+	srv := handler.New(schema)
+	
+	// Subscriptions: SSE must precede POST so text/event-stream requests are not
+	// handled as plain queries; WebSocket negotiates graphql-transport-ws
+	srv.AddTransport(transport.SSE{})
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.SetErrorPresenter(errorPresenter(o.hideInternalErrors))
+	
+	// Malformed Decimal, DateTime and Date input fails validation rather than
+	// reaching a resolver, whether written inline or passed as a variable
+	srv.SetValidationRulesFn(validationRules)
+	srv.Use(&scalarVariables{})
+	
+	srv.Use(extension.Introspection{})
+	srv.Use(loaderScope{resolver: resolver})
+	
+	// Allowlist mode only admits registered operations; otherwise clients may
+	// register queries on the fly with Automatic Persisted Queries
+	if o.persistedQueries != nil {
+		srv.Use(persistedQueryAllowlist{queries: o.persistedQueries})
+	} else {
+		srv.Use(extension.AutomaticPersistedQuery{
+			Cache: lru.New[string](100),
+		})
+	}
+	
+	// The operation limit measures each fragment once and runs first, so
+	// oversized operations never reach the complexity walk, which expands
+	// every fragment spread
+	if limit := o.limits.operationLimit(); limit != nil {
+		srv.Use(limit)
+	}
+	if limit := o.limits.complexityLimit(); limit != nil {
+		srv.Use(limit)
+	}
 	
 	return &Server{
 		resolver: resolver,
-		handler:  srv,
+		handler:  apierr.RequestIDMiddleware(streamingMiddleware(srv)),
 	}
 }
 
@@ -43,4 +199,21 @@ func (s *Server) Handler() http.Handler {
 // PlaygroundHandler returns the GraphQL Playground handler for development
 func (s *Server) PlaygroundHandler() http.Handler {
 	return playground.Handler("GraphQL Playground", "/graphql")
-}
\ No newline at end of file
+}
+
+// streamingMiddleware lifts the server write timeout for subscription
+// requests, which stay open for as long as the client is listening
+func streamingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isStreaming(r) {
+			http.NewResponseController(w).SetWriteDeadline(time.Time{})
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isStreaming reports whether r opens a WebSocket or SSE subscription
+func isStreaming(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") ||
+		strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
@@ -0,0 +1,116 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/graphql/generated"
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// Subscription resolver implementation
+func (r *Resolver) Subscription() generated.SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+type subscriptionResolver struct{ *Resolver }
+
+// TransactionPosted streams transactions booked against an account
+func (r *subscriptionResolver) TransactionPosted(ctx context.Context, accountID string) (<-chan *models.Transaction, error) {
+	if err := r.requireAccount(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	events, err := r.subscribe(ctx, domains.EventFilter{
+		Types:     []models.EventType{models.EventTypeTransactionPosted},
+		AccountID: accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return forward(ctx, events, func(event models.Event) *models.Transaction {
+		return event.Transaction
+	}), nil
+}
+
+// BalanceChanged streams balance updates for an account
+func (r *subscriptionResolver) BalanceChanged(ctx context.Context, accountID string) (<-chan *models.Balance, error) {
+	if err := r.requireAccount(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	events, err := r.subscribe(ctx, domains.EventFilter{
+		Types:     []models.EventType{models.EventTypeBalanceChanged},
+		AccountID: accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return forward(ctx, events, func(event models.Event) *models.Balance {
+		return event.Balance
+	}), nil
+}
+
+// ConsentStatusChanged streams status transitions of a consent
+func (r *subscriptionResolver) ConsentStatusChanged(ctx context.Context, id string) (<-chan *models.Consent, error) {
+	if _, err := r.consentService.RetrieveConsent(ctx, id); err != nil {
+		if domains.IsNotFound(err) {
+			return nil, notFound("consent", id)
+		}
+		return nil, err
+	}
+
+	events, err := r.subscribe(ctx, domains.EventFilter{
+		Types:     []models.EventType{models.EventTypeConsentStatusChanged},
+		ConsentID: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return forward(ctx, events, func(event models.Event) *models.Consent {
+		return event.Consent
+	}), nil
+}
+
+// subscribe opens a subscription on the configured event source
+func (r *Resolver) subscribe(ctx context.Context, filter domains.EventFilter) (<-chan models.Event, error) {
+	if r.eventSource == nil {
+		return nil, fmt.Errorf("subscriptions are not enabled")
+	}
+	return r.eventSource.Subscribe(ctx, filter)
+}
+
+// requireAccount rejects subscriptions to accounts that do not exist
+func (r *Resolver) requireAccount(ctx context.Context, accountID string) error {
+	if _, err := r.accountService.RetrieveCurrentAccount(ctx, accountID); err != nil {
+		if domains.IsNotFound(err) {
+			return notFound("account", accountID)
+		}
+		return err
+	}
+	return nil
+}
+
+// forward maps domain events onto a typed channel that closes with the subscription
+func forward[T any](ctx context.Context, events <-chan models.Event, payload func(models.Event) *T) <-chan *T {
+	out := make(chan *T, 1)
+	go func() {
+		defer close(out)
+		for event := range events {
+			value := payload(event)
+			if value == nil {
+				continue
+			}
+			select {
+			case out <- value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
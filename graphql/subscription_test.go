@@ -0,0 +1,213 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/providers/enrich"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+// subscribedSource signals on subscribed once each subscription is registered,
+// so tests publish only after the server is listening
+type subscribedSource struct {
+	domains.EventSource
+	subscribed chan struct{}
+}
+
+func (s subscribedSource) Subscribe(ctx context.Context, filter domains.EventFilter) (<-chan models.Event, error) {
+	events, err := s.EventSource.Subscribe(ctx, filter)
+	s.subscribed <- struct{}{}
+	return events, err
+}
+
+// newSubscriptionClient serves provider over GraphQL with subscriptions fed
+// by source
+func newSubscriptionClient(provider *mock.Provider, source domains.EventSource) (*client.Client, chan struct{}) {
+	subscribed := make(chan struct{}, 1)
+	source = subscribedSource{EventSource: source, subscribed: subscribed}
+	server := NewServer(provider, provider, provider, provider, WithEventSource(source))
+	return client.New(server.Handler()), subscribed
+}
+
+func postDebit(t *testing.T, provider *mock.Provider, amount string) {
+	t.Helper()
+	money, err := models.NewMoneyFromString(amount, "AUD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &models.Transaction{
+		AccountID:       "acc-001",
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          money,
+		Description:     "WOOLWORTHS 1234 SYDNEY",
+	}
+	if err := provider.PostTransaction(tx); err != nil {
+		t.Fatalf("PostTransaction() error = %v", err)
+	}
+}
+
+func TestSubscription_FreshBalancePerEvent(t *testing.T) {
+	provider := mock.NewProvider()
+	c, subscribed := newSubscriptionClient(provider, provider)
+
+	sub := c.Websocket(`subscription {
+		transactionPosted(accountId: "acc-001") {
+			runningBalance { amount }
+			account { currentBalance { amount { amount } } }
+		}
+	}`)
+	defer sub.Close()
+	<-subscribed
+
+	var event struct {
+		TransactionPosted struct {
+			RunningBalance struct{ Amount string }
+			Account        struct {
+				CurrentBalance struct{ Amount struct{ Amount string } }
+			}
+		}
+	}
+
+	var seen []string
+	for _, amount := range []string{"-10.00", "-25.50"} {
+		postDebit(t, provider, amount)
+		if err := sub.Next(&event); err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		posted := event.TransactionPosted
+		// Nested balances are loaded afresh for every event, never from an
+		// earlier event's cache
+		if got := posted.Account.CurrentBalance.Amount.Amount; got != posted.RunningBalance.Amount {
+			t.Errorf("account.currentBalance = %s, want the running balance %s", got, posted.RunningBalance.Amount)
+		}
+		seen = append(seen, posted.Account.CurrentBalance.Amount.Amount)
+	}
+	if seen[0] == seen[1] {
+		t.Errorf("both events reported balance %s", seen[0])
+	}
+}
+
+func TestSubscription_BalanceChanged(t *testing.T) {
+	provider := mock.NewProvider()
+	c, subscribed := newSubscriptionClient(provider, provider)
+
+	sub := c.Websocket(`subscription { balanceChanged(accountId: "acc-001") { balanceType amount { amount } } }`)
+	defer sub.Close()
+	<-subscribed
+
+	var event struct {
+		BalanceChanged struct {
+			BalanceType string
+			Amount      struct{ Amount string }
+		}
+	}
+
+	// Each posting changes the current and available balances
+	var current []string
+	for _, amount := range []string{"-10.00", "-25.50"} {
+		postDebit(t, provider, amount)
+		for range 2 {
+			if err := sub.Next(&event); err != nil {
+				t.Fatalf("Next() error = %v", err)
+			}
+			if event.BalanceChanged.BalanceType == string(models.BalanceTypeCurrent) {
+				current = append(current, event.BalanceChanged.Amount.Amount)
+			}
+		}
+	}
+
+	balance, err := provider.RetrieveCurrentAccountBalance(context.Background(), "acc-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(current) != 2 || current[0] == current[1] || current[1] != balance.Amount.Amount.String() {
+		t.Errorf("current balances = %v, want two updates ending at %s", current, balance.Amount.Amount)
+	}
+}
+
+func TestSubscription_ConsentStatusChanged(t *testing.T) {
+	provider := mock.NewProvider()
+	c, subscribed := newSubscriptionClient(provider, provider)
+
+	sub := c.Websocket(`subscription { consentStatusChanged(id: "consent-001") { id status revocationDate } }`)
+	defer sub.Close()
+	<-subscribed
+
+	if err := provider.UpdateConsentStatus("consent-001", models.ConsentStatusRevoked); err != nil {
+		t.Fatal(err)
+	}
+	var event struct {
+		ConsentStatusChanged struct {
+			ID             string
+			Status         string
+			RevocationDate *string
+		}
+	}
+	if err := sub.Next(&event); err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	changed := event.ConsentStatusChanged
+	if changed.ID != "consent-001" || changed.Status != "REVOKED" || changed.RevocationDate == nil {
+		t.Errorf("event = %+v, want consent-001 REVOKED with a revocation date", changed)
+	}
+}
+
+func TestSubscription_EnrichedSource(t *testing.T) {
+	provider := mock.NewProvider()
+	source := domains.NewValidatingEventSource(domains.NewEnrichingEventSource(provider, enrich.NewDefaultRulesEnricher()))
+	c, subscribed := newSubscriptionClient(provider, source)
+
+	sub := c.Websocket(`subscription { transactionPosted(accountId: "acc-001") { category cleanMerchantName } }`)
+	defer sub.Close()
+	<-subscribed
+
+	postDebit(t, provider, "-10.00")
+	var event struct {
+		TransactionPosted struct {
+			Category          string
+			CleanMerchantName string
+		}
+	}
+	if err := sub.Next(&event); err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	// Pushed transactions match what the enriched transaction service serves
+	if posted := event.TransactionPosted; posted.Category != "GROCERIES" || posted.CleanMerchantName != "Woolworths" {
+		t.Errorf("posted transaction = %+v, want it enriched", posted)
+	}
+}
+
+func TestSubscription_Errors(t *testing.T) {
+	provider := mock.NewProvider()
+	c, _ := newSubscriptionClient(provider, provider)
+	disabled := client.New(NewServer(provider, provider, provider, provider).Handler())
+
+	tests := []struct {
+		name     string
+		client   *client.Client
+		query    string
+		wantCode string
+	}{
+		{"unknown account", c, `subscription { balanceChanged(accountId: "acc-999") { balanceType } }`, "NOT_FOUND"},
+		{"unknown consent", c, `subscription { consentStatusChanged(id: "consent-999") { status } }`, "NOT_FOUND"},
+		{"subscriptions disabled", disabled, `subscription { balanceChanged(accountId: "acc-001") { balanceType } }`, "INTERNAL_ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := tt.client.Websocket(tt.query)
+			defer sub.Close()
+
+			var event map[string]any
+			err := sub.Next(&event)
+			if err == nil || !strings.Contains(err.Error(), `"code":"`+tt.wantCode+`"`) {
+				t.Errorf("error = %v, want code %s", err, tt.wantCode)
+			}
+		})
+	}
+}
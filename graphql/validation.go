@@ -0,0 +1,140 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/serverlesscloud/bian-go/graphql/scalars"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator/core"
+	"github.com/vektah/gqlparser/v2/validator/rules"
+)
+
+// scalarParsers checks the wire format of custom scalars. Malformed values are
+// rejected during validation, before any resolver runs.
+var scalarParsers = map[string]func(v interface{}) error{
+	"Decimal": func(v interface{}) error {
+		_, err := scalars.UnmarshalDecimal(v)
+		return err
+	},
+	"DateTime": func(v interface{}) error {
+		_, err := scalars.UnmarshalDateTime(v)
+		return err
+	},
+	"Date": func(v interface{}) error {
+		_, err := scalars.UnmarshalDate(v)
+		return err
+	},
+}
+
+// validationRules returns the standard rules plus custom scalar format checks
+// for literals written in the query document
+func validationRules() *rules.Rules {
+	r := rules.NewDefaultRules()
+	r.AddRule("ScalarFormat", scalarFormatRule)
+	return r
+}
+
+// scalarFormatRule reports literal custom scalar values that do not parse
+func scalarFormatRule(observers *core.Events, addError core.AddErrFunc) {
+	observers.OnValue(func(walker *core.Walker, value *ast.Value) {
+		if value.Definition == nil || value.Kind == ast.Variable || value.Kind == ast.NullValue {
+			return
+		}
+		parse, ok := scalarParsers[value.Definition.Name]
+		if !ok {
+			return
+		}
+		raw, err := value.Value(nil)
+		if err != nil {
+			return
+		}
+		if err := parse(raw); err != nil {
+			addError(core.Message("%s", err.Error()), core.At(value.Position))
+		}
+	})
+}
+
+// scalarVariables applies the same format checks to variable values, which
+// the standard rules only check for type and shape
+type scalarVariables struct {
+	schema *ast.Schema
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = (*scalarVariables)(nil)
+
+// ExtensionName implements graphql.HandlerExtension
+func (s *scalarVariables) ExtensionName() string {
+	return "ScalarVariables"
+}
+
+// Validate implements graphql.HandlerExtension
+func (s *scalarVariables) Validate(schema graphql.ExecutableSchema) error {
+	s.schema = schema.Schema()
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator
+func (s *scalarVariables) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil {
+		return nil
+	}
+	for _, def := range opCtx.Operation.VariableDefinitions {
+		if err := s.check(def.Type, opCtx.Variables[def.Variable], "$"+def.Variable); err != nil {
+			gqlErr := gqlerror.Errorf("variable %s", err.Error())
+			errcode.Set(gqlErr, errcode.ValidationFailed)
+			return gqlErr
+		}
+	}
+	return nil
+}
+
+// check walks a coerced variable value, descending into lists and input objects
+func (s *scalarVariables) check(typ *ast.Type, value interface{}, path string) error {
+	if value == nil {
+		return nil
+	}
+
+	if typ.Elem != nil {
+		items, ok := value.([]interface{})
+		if !ok {
+			return s.check(typ.Elem, value, path)
+		}
+		for i, item := range items {
+			if err := s.check(typ.Elem, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	def := s.schema.Types[typ.NamedType]
+	if def == nil {
+		return nil
+	}
+	switch def.Kind {
+	case ast.InputObject:
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		for _, field := range def.Fields {
+			if err := s.check(field.Type, fields[field.Name], path+"."+field.Name); err != nil {
+				return err
+			}
+		}
+	case ast.Scalar:
+		if parse, ok := scalarParsers[def.Name]; ok {
+			if err := parse(value); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+	}
+	return nil
+}
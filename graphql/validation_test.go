@@ -0,0 +1,86 @@
+package graphql
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScalarValidation(t *testing.T) {
+	handler := newTestHandler()
+	const history = `query ($input: TransactionHistoryInput) { transactions(accountId: "acc-001", input: $input) { id } }`
+
+	tests := []struct {
+		name        string
+		query       string
+		variables   map[string]any
+		wantMessage string // empty when the operation must succeed
+	}{
+		{
+			name:  "valid literal",
+			query: `{ transactions(accountId: "acc-001", input: {fromDate: "2024-01-01", toDate: "2030-12-31"}) { id } }`,
+		},
+		{
+			name:  "null literal",
+			query: `{ transactions(accountId: "acc-001", input: {fromDate: null}) { id } }`,
+		},
+		{
+			name:        "invalid literal",
+			query:       `{ transactions(accountId: "acc-001", input: {fromDate: "01/02/2024"}) { id } }`,
+			wantMessage: `invalid Date "01/02/2024"`,
+		},
+		{
+			name:        "timestamp for a date",
+			query:       `{ transactions(accountId: "acc-001", input: {toDate: "2024-01-01T00:00:00Z"}) { id } }`,
+			wantMessage: "must be YYYY-MM-DD",
+		},
+		{
+			name:      "valid variable",
+			query:     history,
+			variables: map[string]any{"input": map[string]any{"fromDate": "2024-01-01"}},
+		},
+		{
+			name:      "null variable",
+			query:     history,
+			variables: map[string]any{"input": nil},
+		},
+		{
+			name:        "invalid variable",
+			query:       history,
+			variables:   map[string]any{"input": map[string]any{"toDate": "2024-02-30"}},
+			wantMessage: "$input.toDate",
+		},
+		{
+			name:        "invalid scalar variable",
+			query:       `query ($from: Date) { transactions(accountId: "acc-001", input: {fromDate: $from}) { id } }`,
+			variables:   map[string]any{"from": "tomorrow"},
+			wantMessage: `variable $from: invalid Date "tomorrow"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := map[string]any{"query": tt.query}
+			if tt.variables != nil {
+				body["variables"] = tt.variables
+			}
+			response := postGraphQL(t, handler, body)
+
+			if tt.wantMessage == "" {
+				if len(response.Errors) != 0 {
+					t.Errorf("errors = %+v, want none", response.Errors)
+				}
+				return
+			}
+			if code := response.errorCode(); code != "GRAPHQL_VALIDATION_FAILED" {
+				t.Fatalf("code = %q, want GRAPHQL_VALIDATION_FAILED (errors %+v)", code, response.Errors)
+			}
+			if !strings.Contains(response.Errors[0].Message, tt.wantMessage) {
+				t.Errorf("message = %q, want it to contain %q", response.Errors[0].Message, tt.wantMessage)
+			}
+			// Malformed input is rejected before any resolver runs
+			if response.Data != nil {
+				t.Errorf("rejected operation returned data %v", response.Data)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models/identifiers"
+)
 
 // Account represents a bank account following BIAN Current Account Fulfillment domain
 type Account struct {
@@ -8,11 +12,18 @@ type Account struct {
 	ID            string `json:"id"`
 	AccountNumber string `json:"accountNumber"`
 	
+	// Scheme-specific identifier for payments to the account, when the
+	// provider knows it (AccountNumber alone is free-form)
+	Identifier *AccountIdentifier `json:"identifier,omitempty"`
+	
 	// Account classification
 	AccountType AccountType   `json:"accountType"`
 	ProductName string        `json:"productName"`
 	Nickname    string        `json:"nickname,omitempty"`
 	
+	// Product the account was opened with (see ProductService), when known
+	ProductID string `json:"productId,omitempty"`
+	
 	// Account status and lifecycle
 	Status    AccountStatus `json:"status"`
 	OpenDate  time.Time     `json:"openDate"`
@@ -20,4 +31,31 @@ type Account struct {
 	
 	// Additional metadata
 	Currency string `json:"currency"`
-}
\ No newline at end of file
+}
+
+// AccountIdentifier identifies an account within a payment scheme: an IBAN,
+// or a domestic bank code (BSB, sort code, ABA routing number or Canadian
+// transit number) with an account number
+type AccountIdentifier struct {
+	Scheme identifiers.Scheme `json:"scheme"`
+	
+	// Domestic schemes only
+	BankCode      string `json:"bankCode,omitempty"`
+	AccountNumber string `json:"accountNumber,omitempty"`
+	
+	// IBAN scheme only, in electronic format
+	IBAN string `json:"iban,omitempty"`
+}
+
+// Masked returns a copy of the identifier with the account number or IBAN
+// masked, leaving the bank code readable
+func (i *AccountIdentifier) Masked() *AccountIdentifier {
+	masked := *i
+	if masked.AccountNumber != "" {
+		masked.AccountNumber = identifiers.MaskAccountNumber(masked.AccountNumber)
+	}
+	if masked.IBAN != "" {
+		masked.IBAN = identifiers.MaskIBAN(masked.IBAN)
+	}
+	return &masked
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models/identifiers"
+)
+
+func TestAccount_ValidateIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier *AccountIdentifier
+		wantFields []string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:       "BSB",
+			identifier: &AccountIdentifier{Scheme: identifiers.SchemeBSB, BankCode: "062-000", AccountNumber: "123456789"},
+		},
+		{
+			name:       "IBAN",
+			identifier: &AccountIdentifier{Scheme: identifiers.SchemeIBAN, IBAN: "GB82WEST12345698765432"},
+		},
+		{
+			name:       "IBAN with domestic details",
+			identifier: &AccountIdentifier{Scheme: identifiers.SchemeIBAN, IBAN: "GB82WEST12345698765432", BankCode: "40-47-84"},
+			wantFields: []string{"identifier.bankCode"},
+		},
+		{
+			name:       "bad sort code and account number",
+			identifier: &AccountIdentifier{Scheme: identifiers.SchemeSortCode, BankCode: "40-47", AccountNumber: "1234"},
+			wantFields: []string{"identifier.bankCode", "identifier.accountNumber"},
+		},
+		{
+			name:       "unknown scheme",
+			identifier: &AccountIdentifier{Scheme: "SWIFT"},
+			wantFields: []string{"identifier.scheme"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := &Account{
+				ID:            "acc-001",
+				AccountNumber: "123456789",
+				Identifier:    tt.identifier,
+				AccountType:   AccountTypeChecking,
+				Status:        AccountStatusOpen,
+				OpenDate:      time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Currency:      "AUD",
+			}
+			err := account.Validate()
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			ve, ok := AsValidationError(err)
+			if !ok {
+				t.Fatalf("Validate() = %v, want a ValidationError", err)
+			}
+			if len(ve.Fields) != len(tt.wantFields) {
+				t.Fatalf("got field errors %v, want %v", ve.Fields, tt.wantFields)
+			}
+			for i, field := range tt.wantFields {
+				if ve.Fields[i].Field != field {
+					t.Errorf("field %d = %s, want %s", i, ve.Fields[i].Field, field)
+				}
+			}
+		})
+	}
+}
+
+func TestAccountIdentifier_Masked(t *testing.T) {
+	identifier := &AccountIdentifier{Scheme: identifiers.SchemeBSB, BankCode: "062-000", AccountNumber: "123456789"}
+	masked := identifier.Masked()
+	if masked.BankCode != "062-000" || masked.AccountNumber != "*****6789" {
+		t.Errorf("Masked() = %+v", masked)
+	}
+	if identifier.AccountNumber != "123456789" {
+		t.Errorf("Masked() modified the original: %+v", identifier)
+	}
+}
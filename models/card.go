@@ -0,0 +1,79 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Card represents a payment card issued on an account following BIAN Card
+// Authorization and Issued Device Administration domains
+type Card struct {
+	// Card identification
+	ID        string `json:"id"`
+	AccountID string `json:"accountId"`
+
+	// Primary account number with all but the last four digits masked, as
+	// by identifiers.MaskAccountNumber
+	MaskedPAN string `json:"maskedPan"`
+
+	// Card classification
+	Scheme         CardScheme `json:"scheme"`
+	CardholderName string     `json:"cardholderName"`
+
+	// Card status and expiry (last day of ExpiryMonth)
+	Status      CardStatus `json:"status"`
+	ExpiryMonth int        `json:"expiryMonth"`
+	ExpiryYear  int        `json:"expiryYear"`
+
+	// Credit terms, for credit card accounts only
+	CreditFacility *CreditFacility `json:"creditFacility,omitempty"`
+}
+
+// CreditFacility represents the credit terms of a card account following the
+// BIAN Card Collections and Credit Card domains. Amounts owed are positive.
+type CreditFacility struct {
+	// Credit limit and the part of it not yet drawn
+	CreditLimit     Money `json:"creditLimit"`
+	AvailableCredit Money `json:"availableCredit"`
+
+	// Amount owed at the last statement, and the minimum to pay by the due date
+	StatementBalance Money     `json:"statementBalance"`
+	MinimumPayment   Money     `json:"minimumPayment"`
+	PaymentDueDate   time.Time `json:"paymentDueDate"`
+
+	// Statement cycle
+	StatementCycle StatementCycle `json:"statementCycle"`
+}
+
+// StatementCycle describes when card statements are issued
+type StatementCycle struct {
+	// Day of the month statements are issued (1-28)
+	StatementDay int `json:"statementDay"`
+
+	LastStatementDate time.Time `json:"lastStatementDate"`
+	NextStatementDate time.Time `json:"nextStatementDate"`
+}
+
+// NewStatementCycle returns the cycle for a statement day as of the given time.
+// The day must be between 1 and 28 so that every month has a statement.
+func NewStatementCycle(statementDay int, asOf time.Time) (StatementCycle, error) {
+	if statementDay < 1 || statementDay > 28 {
+		return StatementCycle{}, fmt.Errorf("statement day must be between 1 and 28, got %d", statementDay)
+	}
+	year, month, day := asOf.Date()
+	last := time.Date(year, month, statementDay, 0, 0, 0, 0, asOf.Location())
+	if day < statementDay {
+		last = last.AddDate(0, -1, 0)
+	}
+	return StatementCycle{
+		StatementDay:      statementDay,
+		LastStatementDate: last,
+		NextStatementDate: last.AddDate(0, 1, 0),
+	}, nil
+}
+
+// Expired reports whether the card has passed the end of its expiry month
+func (c *Card) Expired(now time.Time) bool {
+	endOfExpiry := time.Date(c.ExpiryYear, time.Month(c.ExpiryMonth)+1, 1, 0, 0, 0, 0, time.UTC)
+	return !now.Before(endOfExpiry)
+}
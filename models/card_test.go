@@ -0,0 +1,91 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewStatementCycle(t *testing.T) {
+	tests := []struct {
+		name     string
+		day      int
+		asOf     time.Time
+		wantLast time.Time
+		wantNext time.Time
+		wantErr  bool
+	}{
+		{"after statement day", 15, date(2024, time.March, 20), date(2024, time.March, 15), date(2024, time.April, 15), false},
+		{"on statement day", 15, date(2024, time.March, 15), date(2024, time.March, 15), date(2024, time.April, 15), false},
+		{"before statement day", 15, date(2024, time.March, 10), date(2024, time.February, 15), date(2024, time.March, 15), false},
+		{"across year end", 28, date(2024, time.January, 3), date(2023, time.December, 28), date(2024, time.January, 28), false},
+		{"zero day", 0, date(2024, time.March, 10), time.Time{}, time.Time{}, true},
+		{"day past 28", 31, date(2024, time.March, 10), time.Time{}, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycle, err := NewStatementCycle(tt.day, tt.asOf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewStatementCycle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if cycle.StatementDay != tt.day || !cycle.LastStatementDate.Equal(tt.wantLast) || !cycle.NextStatementDate.Equal(tt.wantNext) {
+				t.Errorf("NewStatementCycle() = %+v, want last %s, next %s", cycle, tt.wantLast, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestCard_Expired(t *testing.T) {
+	card := &Card{ExpiryMonth: 12, ExpiryYear: 2024}
+
+	tests := []struct {
+		now  time.Time
+		want bool
+	}{
+		{date(2024, time.December, 1), false},
+		{time.Date(2024, time.December, 31, 23, 59, 59, 0, time.UTC), false},
+		{date(2025, time.January, 1), true},
+	}
+	for _, tt := range tests {
+		if got := card.Expired(tt.now); got != tt.want {
+			t.Errorf("Expired(%s) = %v, want %v", tt.now, got, tt.want)
+		}
+	}
+}
+
+func TestCreditFacility_ValidateOrder(t *testing.T) {
+	usd := func(amount string) Money {
+		m, _ := NewMoneyFromString(amount, "USD")
+		return m
+	}
+	aud, _ := NewMoneyFromString("10.00", "AUD")
+	cycle, _ := NewStatementCycle(15, date(2024, time.March, 20))
+	facility := &CreditFacility{
+		CreditLimit:      usd("5000.00"),
+		AvailableCredit:  aud,
+		StatementBalance: aud,
+		MinimumPayment:   aud,
+		PaymentDueDate:   date(2024, time.April, 9),
+		StatementCycle:   cycle,
+	}
+
+	// Field errors come back in the same order on every call
+	want := []string{"availableCredit.currency", "statementBalance.currency", "minimumPayment.currency"}
+	for range 20 {
+		ve, ok := AsValidationError(facility.Validate())
+		if !ok {
+			t.Fatal("Validate() should fail for mismatched currencies")
+		}
+		var got []string
+		for _, field := range ve.Fields {
+			got = append(got, field.Field)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("fields = %v, want %v", got, want)
+		}
+	}
+}
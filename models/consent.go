@@ -13,6 +13,9 @@ type Consent struct {
 	// Consent scopes (permissions granted)
 	Scopes []string `json:"scopes"`
 	
+	// Accounts the consent covers
+	AccountIDs []string `json:"accountIds,omitempty"`
+	
 	// Consent lifecycle dates
 	GrantDate      time.Time  `json:"grantDate"`
 	ExpiryDate     time.Time  `json:"expiryDate"`
@@ -0,0 +1,328 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CurrencyStatus represents whether an ISO 4217 code is still in circulation
+type CurrencyStatus string
+
+const (
+	CurrencyStatusActive   CurrencyStatus = "ACTIVE"
+	CurrencyStatusHistoric CurrencyStatus = "HISTORIC"
+)
+
+// IsValid checks if the currency status is valid
+func (cs CurrencyStatus) IsValid() bool {
+	switch cs {
+	case CurrencyStatusActive, CurrencyStatusHistoric:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExponentNotApplicable marks ISO 4217 entries without a minor unit, such as
+// precious metals (XAU), special drawing rights (XDR) and the testing codes
+// (XTS, XXX). These codes are registered but cannot be used as Money.
+const ExponentNotApplicable = -1
+
+// Currency describes an ISO 4217 currency entry
+type Currency struct {
+	// Alphabetic code, e.g. "AUD"
+	Code string `json:"code"`
+
+	// Three-digit numeric code, e.g. "036"
+	Numeric string `json:"numeric"`
+
+	// English currency name as published by the ISO 4217 maintenance agency
+	Name string `json:"name"`
+
+	// Number of minor unit digits (2 for AUD, 0 for JPY, 3 for KWD)
+	Exponent int `json:"exponent"`
+
+	// Whether the code is active or has been withdrawn
+	Status CurrencyStatus `json:"status"`
+}
+
+// IsActive reports whether the currency is currently in circulation
+func (c Currency) IsActive() bool {
+	return c.Status == CurrencyStatusActive
+}
+
+// IsMonetary reports whether the currency has a defined minor unit and can
+// therefore be used to denominate Money
+func (c Currency) IsMonetary() bool {
+	return c.Exponent != ExponentNotApplicable
+}
+
+// LookupCurrency returns the registry entry for an alphabetic code (case-insensitive)
+func LookupCurrency(code string) (Currency, bool) {
+	c, ok := currencyRegistry[strings.ToUpper(code)]
+	return c, ok
+}
+
+// LookupCurrencyByNumeric returns the registry entry for a numeric code.
+// When an active and a historic currency share a numeric code, the active one is returned.
+func LookupCurrencyByNumeric(numeric string) (Currency, bool) {
+	if len(numeric) < 3 {
+		numeric = strings.Repeat("0", 3-len(numeric)) + numeric
+	}
+	var found Currency
+	ok := false
+	for _, c := range currencyRegistry {
+		if c.Numeric != numeric {
+			continue
+		}
+		if !ok || (c.IsActive() && !found.IsActive()) {
+			found, ok = c, true
+		}
+	}
+	return found, ok
+}
+
+// Currencies returns all registered currencies sorted by alphabetic code
+func Currencies() []Currency {
+	result := make([]Currency, 0, len(currencyRegistry))
+	for _, c := range currencyRegistry {
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Code < result[j].Code
+	})
+	return result
+}
+
+// CurrencyExponent returns the number of minor unit digits for a currency code
+func CurrencyExponent(code string) (int, error) {
+	c, ok := LookupCurrency(code)
+	if !ok || !c.IsMonetary() {
+		return 0, fmt.Errorf("invalid currency code: %s", code)
+	}
+	return c.Exponent, nil
+}
+
+// currencyRegistry holds the ISO 4217 list (active codes and commonly
+// encountered historic codes) keyed by alphabetic code
+var currencyRegistry = buildCurrencyRegistry([]Currency{
+	// Active currencies
+	{"AED", "784", "UAE Dirham", 2, CurrencyStatusActive},
+	{"AFN", "971", "Afghani", 2, CurrencyStatusActive},
+	{"ALL", "008", "Lek", 2, CurrencyStatusActive},
+	{"AMD", "051", "Armenian Dram", 2, CurrencyStatusActive},
+	{"AOA", "973", "Kwanza", 2, CurrencyStatusActive},
+	{"ARS", "032", "Argentine Peso", 2, CurrencyStatusActive},
+	{"AUD", "036", "Australian Dollar", 2, CurrencyStatusActive},
+	{"AWG", "533", "Aruban Florin", 2, CurrencyStatusActive},
+	{"AZN", "944", "Azerbaijan Manat", 2, CurrencyStatusActive},
+	{"BAM", "977", "Convertible Mark", 2, CurrencyStatusActive},
+	{"BBD", "052", "Barbados Dollar", 2, CurrencyStatusActive},
+	{"BDT", "050", "Taka", 2, CurrencyStatusActive},
+	{"BHD", "048", "Bahraini Dinar", 3, CurrencyStatusActive},
+	{"BIF", "108", "Burundi Franc", 0, CurrencyStatusActive},
+	{"BMD", "060", "Bermudian Dollar", 2, CurrencyStatusActive},
+	{"BND", "096", "Brunei Dollar", 2, CurrencyStatusActive},
+	{"BOB", "068", "Boliviano", 2, CurrencyStatusActive},
+	{"BOV", "984", "Mvdol", 2, CurrencyStatusActive},
+	{"BRL", "986", "Brazilian Real", 2, CurrencyStatusActive},
+	{"BSD", "044", "Bahamian Dollar", 2, CurrencyStatusActive},
+	{"BTN", "064", "Ngultrum", 2, CurrencyStatusActive},
+	{"BWP", "072", "Pula", 2, CurrencyStatusActive},
+	{"BYN", "933", "Belarusian Ruble", 2, CurrencyStatusActive},
+	{"BZD", "084", "Belize Dollar", 2, CurrencyStatusActive},
+	{"CAD", "124", "Canadian Dollar", 2, CurrencyStatusActive},
+	{"CDF", "976", "Congolese Franc", 2, CurrencyStatusActive},
+	{"CHE", "947", "WIR Euro", 2, CurrencyStatusActive},
+	{"CHF", "756", "Swiss Franc", 2, CurrencyStatusActive},
+	{"CHW", "948", "WIR Franc", 2, CurrencyStatusActive},
+	{"CLF", "990", "Unidad de Fomento", 4, CurrencyStatusActive},
+	{"CLP", "152", "Chilean Peso", 0, CurrencyStatusActive},
+	{"CNY", "156", "Yuan Renminbi", 2, CurrencyStatusActive},
+	{"COP", "170", "Colombian Peso", 2, CurrencyStatusActive},
+	{"COU", "970", "Unidad de Valor Real", 2, CurrencyStatusActive},
+	{"CRC", "188", "Costa Rican Colon", 2, CurrencyStatusActive},
+	{"CUP", "192", "Cuban Peso", 2, CurrencyStatusActive},
+	{"CVE", "132", "Cabo Verde Escudo", 2, CurrencyStatusActive},
+	{"CZK", "203", "Czech Koruna", 2, CurrencyStatusActive},
+	{"DJF", "262", "Djibouti Franc", 0, CurrencyStatusActive},
+	{"DKK", "208", "Danish Krone", 2, CurrencyStatusActive},
+	{"DOP", "214", "Dominican Peso", 2, CurrencyStatusActive},
+	{"DZD", "012", "Algerian Dinar", 2, CurrencyStatusActive},
+	{"EGP", "818", "Egyptian Pound", 2, CurrencyStatusActive},
+	{"ERN", "232", "Nakfa", 2, CurrencyStatusActive},
+	{"ETB", "230", "Ethiopian Birr", 2, CurrencyStatusActive},
+	{"EUR", "978", "Euro", 2, CurrencyStatusActive},
+	{"FJD", "242", "Fiji Dollar", 2, CurrencyStatusActive},
+	{"FKP", "238", "Falkland Islands Pound", 2, CurrencyStatusActive},
+	{"GBP", "826", "Pound Sterling", 2, CurrencyStatusActive},
+	{"GEL", "981", "Lari", 2, CurrencyStatusActive},
+	{"GHS", "936", "Ghana Cedi", 2, CurrencyStatusActive},
+	{"GIP", "292", "Gibraltar Pound", 2, CurrencyStatusActive},
+	{"GMD", "270", "Dalasi", 2, CurrencyStatusActive},
+	{"GNF", "324", "Guinean Franc", 0, CurrencyStatusActive},
+	{"GTQ", "320", "Quetzal", 2, CurrencyStatusActive},
+	{"GYD", "328", "Guyana Dollar", 2, CurrencyStatusActive},
+	{"HKD", "344", "Hong Kong Dollar", 2, CurrencyStatusActive},
+	{"HNL", "340", "Lempira", 2, CurrencyStatusActive},
+	{"HTG", "332", "Gourde", 2, CurrencyStatusActive},
+	{"HUF", "348", "Forint", 2, CurrencyStatusActive},
+	{"IDR", "360", "Rupiah", 2, CurrencyStatusActive},
+	{"ILS", "376", "New Israeli Sheqel", 2, CurrencyStatusActive},
+	{"INR", "356", "Indian Rupee", 2, CurrencyStatusActive},
+	{"IQD", "368", "Iraqi Dinar", 3, CurrencyStatusActive},
+	{"IRR", "364", "Iranian Rial", 2, CurrencyStatusActive},
+	{"ISK", "352", "Iceland Krona", 0, CurrencyStatusActive},
+	{"JMD", "388", "Jamaican Dollar", 2, CurrencyStatusActive},
+	{"JOD", "400", "Jordanian Dinar", 3, CurrencyStatusActive},
+	{"JPY", "392", "Yen", 0, CurrencyStatusActive},
+	{"KES", "404", "Kenyan Shilling", 2, CurrencyStatusActive},
+	{"KGS", "417", "Som", 2, CurrencyStatusActive},
+	{"KHR", "116", "Riel", 2, CurrencyStatusActive},
+	{"KMF", "174", "Comorian Franc", 0, CurrencyStatusActive},
+	{"KPW", "408", "North Korean Won", 2, CurrencyStatusActive},
+	{"KRW", "410", "Won", 0, CurrencyStatusActive},
+	{"KWD", "414", "Kuwaiti Dinar", 3, CurrencyStatusActive},
+	{"KYD", "136", "Cayman Islands Dollar", 2, CurrencyStatusActive},
+	{"KZT", "398", "Tenge", 2, CurrencyStatusActive},
+	{"LAK", "418", "Lao Kip", 2, CurrencyStatusActive},
+	{"LBP", "422", "Lebanese Pound", 2, CurrencyStatusActive},
+	{"LKR", "144", "Sri Lanka Rupee", 2, CurrencyStatusActive},
+	{"LRD", "430", "Liberian Dollar", 2, CurrencyStatusActive},
+	{"LSL", "426", "Loti", 2, CurrencyStatusActive},
+	{"LYD", "434", "Libyan Dinar", 3, CurrencyStatusActive},
+	{"MAD", "504", "Moroccan Dirham", 2, CurrencyStatusActive},
+	{"MDL", "498", "Moldovan Leu", 2, CurrencyStatusActive},
+	{"MGA", "969", "Malagasy Ariary", 2, CurrencyStatusActive},
+	{"MKD", "807", "Denar", 2, CurrencyStatusActive},
+	{"MMK", "104", "Kyat", 2, CurrencyStatusActive},
+	{"MNT", "496", "Tugrik", 2, CurrencyStatusActive},
+	{"MOP", "446", "Pataca", 2, CurrencyStatusActive},
+	{"MRU", "929", "Ouguiya", 2, CurrencyStatusActive},
+	{"MUR", "480", "Mauritius Rupee", 2, CurrencyStatusActive},
+	{"MVR", "462", "Rufiyaa", 2, CurrencyStatusActive},
+	{"MWK", "454", "Malawi Kwacha", 2, CurrencyStatusActive},
+	{"MXN", "484", "Mexican Peso", 2, CurrencyStatusActive},
+	{"MXV", "979", "Mexican Unidad de Inversion (UDI)", 2, CurrencyStatusActive},
+	{"MYR", "458", "Malaysian Ringgit", 2, CurrencyStatusActive},
+	{"MZN", "943", "Mozambique Metical", 2, CurrencyStatusActive},
+	{"NAD", "516", "Namibia Dollar", 2, CurrencyStatusActive},
+	{"NGN", "566", "Naira", 2, CurrencyStatusActive},
+	{"NIO", "558", "Cordoba Oro", 2, CurrencyStatusActive},
+	{"NOK", "578", "Norwegian Krone", 2, CurrencyStatusActive},
+	{"NPR", "524", "Nepalese Rupee", 2, CurrencyStatusActive},
+	{"NZD", "554", "New Zealand Dollar", 2, CurrencyStatusActive},
+	{"OMR", "512", "Rial Omani", 3, CurrencyStatusActive},
+	{"PAB", "590", "Balboa", 2, CurrencyStatusActive},
+	{"PEN", "604", "Sol", 2, CurrencyStatusActive},
+	{"PGK", "598", "Kina", 2, CurrencyStatusActive},
+	{"PHP", "608", "Philippine Peso", 2, CurrencyStatusActive},
+	{"PKR", "586", "Pakistan Rupee", 2, CurrencyStatusActive},
+	{"PLN", "985", "Zloty", 2, CurrencyStatusActive},
+	{"PYG", "600", "Guarani", 0, CurrencyStatusActive},
+	{"QAR", "634", "Qatari Rial", 2, CurrencyStatusActive},
+	{"RON", "946", "Romanian Leu", 2, CurrencyStatusActive},
+	{"RSD", "941", "Serbian Dinar", 2, CurrencyStatusActive},
+	{"RUB", "643", "Russian Ruble", 2, CurrencyStatusActive},
+	{"RWF", "646", "Rwanda Franc", 0, CurrencyStatusActive},
+	{"SAR", "682", "Saudi Riyal", 2, CurrencyStatusActive},
+	{"SBD", "090", "Solomon Islands Dollar", 2, CurrencyStatusActive},
+	{"SCR", "690", "Seychelles Rupee", 2, CurrencyStatusActive},
+	{"SDG", "938", "Sudanese Pound", 2, CurrencyStatusActive},
+	{"SEK", "752", "Swedish Krona", 2, CurrencyStatusActive},
+	{"SGD", "702", "Singapore Dollar", 2, CurrencyStatusActive},
+	{"SHP", "654", "Saint Helena Pound", 2, CurrencyStatusActive},
+	{"SLE", "925", "Leone", 2, CurrencyStatusActive},
+	{"SOS", "706", "Somali Shilling", 2, CurrencyStatusActive},
+	{"SRD", "968", "Surinam Dollar", 2, CurrencyStatusActive},
+	{"SSP", "728", "South Sudanese Pound", 2, CurrencyStatusActive},
+	{"STN", "930", "Dobra", 2, CurrencyStatusActive},
+	{"SVC", "222", "El Salvador Colon", 2, CurrencyStatusActive},
+	{"SYP", "760", "Syrian Pound", 2, CurrencyStatusActive},
+	{"SZL", "748", "Lilangeni", 2, CurrencyStatusActive},
+	{"THB", "764", "Baht", 2, CurrencyStatusActive},
+	{"TJS", "972", "Somoni", 2, CurrencyStatusActive},
+	{"TMT", "934", "Turkmenistan New Manat", 2, CurrencyStatusActive},
+	{"TND", "788", "Tunisian Dinar", 3, CurrencyStatusActive},
+	{"TOP", "776", "Pa'anga", 2, CurrencyStatusActive},
+	{"TRY", "949", "Turkish Lira", 2, CurrencyStatusActive},
+	{"TTD", "780", "Trinidad and Tobago Dollar", 2, CurrencyStatusActive},
+	{"TWD", "901", "New Taiwan Dollar", 2, CurrencyStatusActive},
+	{"TZS", "834", "Tanzanian Shilling", 2, CurrencyStatusActive},
+	{"UAH", "980", "Hryvnia", 2, CurrencyStatusActive},
+	{"UGX", "800", "Uganda Shilling", 0, CurrencyStatusActive},
+	{"USD", "840", "US Dollar", 2, CurrencyStatusActive},
+	{"USN", "997", "US Dollar (Next day)", 2, CurrencyStatusActive},
+	{"UYI", "940", "Uruguay Peso en Unidades Indexadas (UI)", 0, CurrencyStatusActive},
+	{"UYU", "858", "Peso Uruguayo", 2, CurrencyStatusActive},
+	{"UYW", "927", "Unidad Previsional", 4, CurrencyStatusActive},
+	{"UZS", "860", "Uzbekistan Sum", 2, CurrencyStatusActive},
+	{"VED", "926", "Bolivar Soberano", 2, CurrencyStatusActive},
+	{"VES", "928", "Bolivar Soberano", 2, CurrencyStatusActive},
+	{"VND", "704", "Dong", 0, CurrencyStatusActive},
+	{"VUV", "548", "Vatu", 0, CurrencyStatusActive},
+	{"WST", "882", "Tala", 2, CurrencyStatusActive},
+	{"XAF", "950", "CFA Franc BEAC", 0, CurrencyStatusActive},
+	{"XAG", "961", "Silver", ExponentNotApplicable, CurrencyStatusActive},
+	{"XAU", "959", "Gold", ExponentNotApplicable, CurrencyStatusActive},
+	{"XBA", "955", "Bond Markets Unit European Composite Unit (EURCO)", ExponentNotApplicable, CurrencyStatusActive},
+	{"XBB", "956", "Bond Markets Unit European Monetary Unit (E.M.U.-6)", ExponentNotApplicable, CurrencyStatusActive},
+	{"XBC", "957", "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)", ExponentNotApplicable, CurrencyStatusActive},
+	{"XBD", "958", "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)", ExponentNotApplicable, CurrencyStatusActive},
+	{"XCD", "951", "East Caribbean Dollar", 2, CurrencyStatusActive},
+	{"XCG", "532", "Caribbean Guilder", 2, CurrencyStatusActive},
+	{"XDR", "960", "SDR (Special Drawing Right)", ExponentNotApplicable, CurrencyStatusActive},
+	{"XOF", "952", "CFA Franc BCEAO", 0, CurrencyStatusActive},
+	{"XPD", "964", "Palladium", ExponentNotApplicable, CurrencyStatusActive},
+	{"XPF", "953", "CFP Franc", 0, CurrencyStatusActive},
+	{"XPT", "962", "Platinum", ExponentNotApplicable, CurrencyStatusActive},
+	{"XSU", "994", "Sucre", ExponentNotApplicable, CurrencyStatusActive},
+	{"XTS", "963", "Codes specifically reserved for testing purposes", ExponentNotApplicable, CurrencyStatusActive},
+	{"XUA", "965", "ADB Unit of Account", ExponentNotApplicable, CurrencyStatusActive},
+	{"XXX", "999", "The codes assigned for transactions where no currency is involved", ExponentNotApplicable, CurrencyStatusActive},
+	{"YER", "886", "Yemeni Rial", 2, CurrencyStatusActive},
+	{"ZAR", "710", "Rand", 2, CurrencyStatusActive},
+	{"ZMW", "967", "Zambian Kwacha", 2, CurrencyStatusActive},
+	{"ZWG", "924", "Zimbabwe Gold", 2, CurrencyStatusActive},
+
+	// Historic currencies still found in transaction history
+	{"ANG", "532", "Netherlands Antillean Guilder", 2, CurrencyStatusHistoric},
+	{"ATS", "040", "Schilling", 2, CurrencyStatusHistoric},
+	{"BEF", "056", "Belgian Franc", 0, CurrencyStatusHistoric},
+	{"BGN", "975", "Bulgarian Lev", 2, CurrencyStatusHistoric},
+	{"BYR", "974", "Belarusian Ruble", 0, CurrencyStatusHistoric},
+	{"CUC", "931", "Peso Convertible", 2, CurrencyStatusHistoric},
+	{"CYP", "196", "Cyprus Pound", 2, CurrencyStatusHistoric},
+	{"DEM", "276", "Deutsche Mark", 2, CurrencyStatusHistoric},
+	{"EEK", "233", "Kroon", 2, CurrencyStatusHistoric},
+	{"ESP", "724", "Spanish Peseta", 0, CurrencyStatusHistoric},
+	{"FIM", "246", "Markka", 2, CurrencyStatusHistoric},
+	{"FRF", "250", "French Franc", 2, CurrencyStatusHistoric},
+	{"GRD", "300", "Drachma", 0, CurrencyStatusHistoric},
+	{"HRK", "191", "Kuna", 2, CurrencyStatusHistoric},
+	{"IEP", "372", "Irish Pound", 2, CurrencyStatusHistoric},
+	{"ITL", "380", "Italian Lira", 0, CurrencyStatusHistoric},
+	{"LTL", "440", "Lithuanian Litas", 2, CurrencyStatusHistoric},
+	{"LUF", "442", "Luxembourg Franc", 0, CurrencyStatusHistoric},
+	{"LVL", "428", "Latvian Lats", 2, CurrencyStatusHistoric},
+	{"MRO", "478", "Ouguiya", 2, CurrencyStatusHistoric},
+	{"MTL", "470", "Maltese Lira", 2, CurrencyStatusHistoric},
+	{"NLG", "528", "Netherlands Guilder", 2, CurrencyStatusHistoric},
+	{"PTE", "620", "Portuguese Escudo", 0, CurrencyStatusHistoric},
+	{"SIT", "705", "Tolar", 2, CurrencyStatusHistoric},
+	{"SKK", "703", "Slovak Koruna", 2, CurrencyStatusHistoric},
+	{"SLL", "694", "Leone", 2, CurrencyStatusHistoric},
+	{"STD", "678", "Dobra", 2, CurrencyStatusHistoric},
+	{"VEF", "937", "Bolivar", 2, CurrencyStatusHistoric},
+	{"ZMK", "894", "Zambian Kwacha", 2, CurrencyStatusHistoric},
+	{"ZWL", "932", "Zimbabwe Dollar", 2, CurrencyStatusHistoric},
+})
+
+func buildCurrencyRegistry(currencies []Currency) map[string]Currency {
+	registry := make(map[string]Currency, len(currencies))
+	for _, c := range currencies {
+		registry[c.Code] = c
+	}
+	return registry
+}
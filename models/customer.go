@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// AccountBalanceSummary reports one account's balance in its own currency and
+// converted into a reporting currency
+type AccountBalanceSummary struct {
+	AccountID string `json:"accountId"`
+
+	// Current balance in the account currency
+	Balance Money `json:"balance"`
+
+	// Current balance in the reporting currency
+	ConvertedBalance Money `json:"convertedBalance"`
+
+	// Rate used for conversion (omitted when no conversion was needed)
+	ExchangeRate *ExchangeRate `json:"exchangeRate,omitempty"`
+}
+
+// CustomerBalanceSummary represents a customer's net position across all
+// accounts in a single reporting currency
+type CustomerBalanceSummary struct {
+	CustomerID        string `json:"customerId"`
+	ReportingCurrency string `json:"reportingCurrency"`
+
+	// Sum of converted current balances
+	Total Money `json:"total"`
+
+	// Per-account breakdown
+	Accounts []AccountBalanceSummary `json:"accounts"`
+
+	// When the summary was computed
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -41,6 +41,43 @@ func (tt TransactionType) IsValid() bool {
 	}
 }
 
+// TransactionCategory represents the spending or income category of a transaction
+type TransactionCategory string
+
+const (
+	TransactionCategorySalary        TransactionCategory = "SALARY"
+	TransactionCategoryIncome        TransactionCategory = "INCOME"
+	TransactionCategoryInterest      TransactionCategory = "INTEREST"
+	TransactionCategoryTransfer      TransactionCategory = "TRANSFER"
+	TransactionCategoryCash          TransactionCategory = "CASH"
+	TransactionCategoryFees          TransactionCategory = "FEES"
+	TransactionCategoryGroceries     TransactionCategory = "GROCERIES"
+	TransactionCategoryDining        TransactionCategory = "DINING"
+	TransactionCategoryTransport     TransactionCategory = "TRANSPORT"
+	TransactionCategoryFuel          TransactionCategory = "FUEL"
+	TransactionCategoryShopping      TransactionCategory = "SHOPPING"
+	TransactionCategoryUtilities     TransactionCategory = "UTILITIES"
+	TransactionCategoryHousing       TransactionCategory = "HOUSING"
+	TransactionCategoryHealth        TransactionCategory = "HEALTH"
+	TransactionCategoryEntertainment TransactionCategory = "ENTERTAINMENT"
+	TransactionCategoryTravel        TransactionCategory = "TRAVEL"
+	TransactionCategoryOther         TransactionCategory = "OTHER"
+)
+
+// IsValid checks if the transaction category is valid
+func (tc TransactionCategory) IsValid() bool {
+	switch tc {
+	case TransactionCategorySalary, TransactionCategoryIncome, TransactionCategoryInterest, TransactionCategoryTransfer,
+		TransactionCategoryCash, TransactionCategoryFees, TransactionCategoryGroceries, TransactionCategoryDining,
+		TransactionCategoryTransport, TransactionCategoryFuel, TransactionCategoryShopping, TransactionCategoryUtilities,
+		TransactionCategoryHousing, TransactionCategoryHealth, TransactionCategoryEntertainment, TransactionCategoryTravel,
+		TransactionCategoryOther:
+		return true
+	default:
+		return false
+	}
+}
+
 // ConsentStatus represents the status of a consent
 type ConsentStatus string
 
@@ -97,4 +134,400 @@ func (as AccountStatus) IsValid() bool {
 	default:
 		return false
 	}
-}
\ No newline at end of file
+}
+
+// CardScheme represents the payment network of a card
+type CardScheme string
+
+const (
+	CardSchemeVisa       CardScheme = "VISA"
+	CardSchemeMastercard CardScheme = "MASTERCARD"
+	CardSchemeAmex       CardScheme = "AMEX"
+)
+
+// IsValid checks if the card scheme is valid
+func (cs CardScheme) IsValid() bool {
+	switch cs {
+	case CardSchemeVisa, CardSchemeMastercard, CardSchemeAmex:
+		return true
+	default:
+		return false
+	}
+}
+
+// CardStatus represents the status of a card
+type CardStatus string
+
+const (
+	CardStatusActive    CardStatus = "ACTIVE"
+	CardStatusBlocked   CardStatus = "BLOCKED"
+	CardStatusExpired   CardStatus = "EXPIRED"
+	CardStatusCancelled CardStatus = "CANCELLED"
+)
+
+// IsValid checks if the card status is valid
+func (cs CardStatus) IsValid() bool {
+	switch cs {
+	case CardStatusActive, CardStatusBlocked, CardStatusExpired, CardStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// ScheduledPaymentType distinguishes standing orders from direct debits
+type ScheduledPaymentType string
+
+const (
+	ScheduledPaymentTypeStandingOrder ScheduledPaymentType = "STANDING_ORDER"
+	ScheduledPaymentTypeDirectDebit   ScheduledPaymentType = "DIRECT_DEBIT"
+)
+
+// IsValid checks if the scheduled payment type is valid
+func (st ScheduledPaymentType) IsValid() bool {
+	switch st {
+	case ScheduledPaymentTypeStandingOrder, ScheduledPaymentTypeDirectDebit:
+		return true
+	default:
+		return false
+	}
+}
+
+// ScheduledPaymentStatus represents the status of a standing order or direct debit
+type ScheduledPaymentStatus string
+
+const (
+	ScheduledPaymentStatusActive    ScheduledPaymentStatus = "ACTIVE"
+	ScheduledPaymentStatusSuspended ScheduledPaymentStatus = "SUSPENDED"
+	ScheduledPaymentStatusCancelled ScheduledPaymentStatus = "CANCELLED"
+	ScheduledPaymentStatusCompleted ScheduledPaymentStatus = "COMPLETED"
+)
+
+// IsValid checks if the scheduled payment status is valid
+func (ss ScheduledPaymentStatus) IsValid() bool {
+	switch ss {
+	case ScheduledPaymentStatusActive, ScheduledPaymentStatusSuspended, ScheduledPaymentStatusCancelled, ScheduledPaymentStatusCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+// Frequency represents how often a scheduled payment executes
+type Frequency string
+
+const (
+	// Every 7 days from the start date
+	FrequencyWeekly Frequency = "WEEKLY"
+	// Every 14 days from the start date
+	FrequencyFortnightly Frequency = "FORTNIGHTLY"
+	// On the start date's day of the month, or the last day of shorter months
+	FrequencyMonthly Frequency = "MONTHLY"
+	// On the last weekday of every month
+	FrequencyLastBusinessDay Frequency = "LAST_BUSINESS_DAY"
+)
+
+// IsValid checks if the frequency is valid
+func (f Frequency) IsValid() bool {
+	switch f {
+	case FrequencyWeekly, FrequencyFortnightly, FrequencyMonthly, FrequencyLastBusinessDay:
+		return true
+	default:
+		return false
+	}
+}
+
+// PayeeType represents the kind of payee
+type PayeeType string
+
+const (
+	PayeeTypeDomestic      PayeeType = "DOMESTIC"
+	PayeeTypeInternational PayeeType = "INTERNATIONAL"
+	PayeeTypeBiller        PayeeType = "BILLER"
+)
+
+// IsValid checks if the payee type is valid
+func (pt PayeeType) IsValid() bool {
+	switch pt {
+	case PayeeTypeDomestic, PayeeTypeInternational, PayeeTypeBiller:
+		return true
+	default:
+		return false
+	}
+}
+
+// DomesticScheme represents the national clearing system a domestic payee's
+// bank code belongs to
+type DomesticScheme string
+
+const (
+	DomesticSchemeBSB      DomesticScheme = "BSB"
+	DomesticSchemeSortCode DomesticScheme = "SORT_CODE"
+	DomesticSchemeABA      DomesticScheme = "ABA"
+)
+
+// IsValid checks if the domestic scheme is valid
+func (ds DomesticScheme) IsValid() bool {
+	switch ds {
+	case DomesticSchemeBSB, DomesticSchemeSortCode, DomesticSchemeABA:
+		return true
+	default:
+		return false
+	}
+}
+
+// EventType represents the kind of domain change an Event describes
+type EventType string
+
+const (
+	EventTypeTransactionPosted    EventType = "TRANSACTION_POSTED"
+	EventTypeBalanceChanged       EventType = "BALANCE_CHANGED"
+	EventTypeConsentStatusChanged EventType = "CONSENT_STATUS_CHANGED"
+)
+
+// IsValid checks if the event type is valid
+func (et EventType) IsValid() bool {
+	switch et {
+	case EventTypeTransactionPosted, EventTypeBalanceChanged, EventTypeConsentStatusChanged:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProductCategory represents the CDR category of a banking product
+type ProductCategory string
+
+const (
+	ProductCategoryTransAndSavingsAccounts ProductCategory = "TRANS_AND_SAVINGS_ACCOUNTS"
+	ProductCategoryTermDeposits            ProductCategory = "TERM_DEPOSITS"
+	ProductCategoryTravelCards             ProductCategory = "TRAVEL_CARDS"
+	ProductCategoryCredAndChrgCards        ProductCategory = "CRED_AND_CHRG_CARDS"
+	ProductCategoryPersLoans               ProductCategory = "PERS_LOANS"
+	ProductCategoryResidentialMortgages    ProductCategory = "RESIDENTIAL_MORTGAGES"
+	ProductCategoryOverdrafts              ProductCategory = "OVERDRAFTS"
+	ProductCategoryBusinessLoans           ProductCategory = "BUSINESS_LOANS"
+)
+
+// IsValid checks if the product category is valid
+func (pc ProductCategory) IsValid() bool {
+	switch pc {
+	case ProductCategoryTransAndSavingsAccounts, ProductCategoryTermDeposits, ProductCategoryTravelCards,
+		ProductCategoryCredAndChrgCards, ProductCategoryPersLoans, ProductCategoryResidentialMortgages,
+		ProductCategoryOverdrafts, ProductCategoryBusinessLoans:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProductFeatureType represents a CDR product feature
+type ProductFeatureType string
+
+const (
+	ProductFeatureCardAccess      ProductFeatureType = "CARD_ACCESS"
+	ProductFeatureDigitalWallet   ProductFeatureType = "DIGITAL_WALLET"
+	ProductFeatureFreeTxns        ProductFeatureType = "FREE_TXNS"
+	ProductFeatureUnlimitedTxns   ProductFeatureType = "UNLIMITED_TXNS"
+	ProductFeatureNPPPayID        ProductFeatureType = "NPP_PAYID"
+	ProductFeatureInterestFree    ProductFeatureType = "INTEREST_FREE"
+	ProductFeatureAdditionalCards ProductFeatureType = "ADDITIONAL_CARDS"
+	ProductFeatureBonusRewards    ProductFeatureType = "BONUS_REWARDS"
+	ProductFeatureOther           ProductFeatureType = "OTHER"
+)
+
+// IsValid checks if the feature type is valid
+func (ft ProductFeatureType) IsValid() bool {
+	switch ft {
+	case ProductFeatureCardAccess, ProductFeatureDigitalWallet, ProductFeatureFreeTxns, ProductFeatureUnlimitedTxns,
+		ProductFeatureNPPPayID, ProductFeatureInterestFree, ProductFeatureAdditionalCards, ProductFeatureBonusRewards,
+		ProductFeatureOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// ProductConstraintType represents a CDR limit on a product's balance or credit limit
+type ProductConstraintType string
+
+const (
+	ProductConstraintMinBalance     ProductConstraintType = "MIN_BALANCE"
+	ProductConstraintMaxBalance     ProductConstraintType = "MAX_BALANCE"
+	ProductConstraintOpeningBalance ProductConstraintType = "OPENING_BALANCE"
+	ProductConstraintMinLimit       ProductConstraintType = "MIN_LIMIT"
+	ProductConstraintMaxLimit       ProductConstraintType = "MAX_LIMIT"
+)
+
+// IsValid checks if the constraint type is valid
+func (ct ProductConstraintType) IsValid() bool {
+	switch ct {
+	case ProductConstraintMinBalance, ProductConstraintMaxBalance, ProductConstraintOpeningBalance,
+		ProductConstraintMinLimit, ProductConstraintMaxLimit:
+		return true
+	default:
+		return false
+	}
+}
+
+// EligibilityType represents a CDR criterion a customer must meet to apply
+type EligibilityType string
+
+const (
+	EligibilityBusiness         EligibilityType = "BUSINESS"
+	EligibilityMinAge           EligibilityType = "MIN_AGE"
+	EligibilityMaxAge           EligibilityType = "MAX_AGE"
+	EligibilityMinIncome        EligibilityType = "MIN_INCOME"
+	EligibilityNaturalPerson    EligibilityType = "NATURAL_PERSON"
+	EligibilityPensionRecipient EligibilityType = "PENSION_RECIPIENT"
+	EligibilityResidencyStatus  EligibilityType = "RESIDENCY_STATUS"
+	EligibilityStaff            EligibilityType = "STAFF"
+	EligibilityStudent          EligibilityType = "STUDENT"
+	EligibilityOther            EligibilityType = "OTHER"
+)
+
+// IsValid checks if the eligibility type is valid
+func (et EligibilityType) IsValid() bool {
+	switch et {
+	case EligibilityBusiness, EligibilityMinAge, EligibilityMaxAge, EligibilityMinIncome, EligibilityNaturalPerson,
+		EligibilityPensionRecipient, EligibilityResidencyStatus, EligibilityStaff, EligibilityStudent, EligibilityOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// FeeType represents the CDR classification of a product fee
+type FeeType string
+
+const (
+	FeeTypePeriodic    FeeType = "PERIODIC"
+	FeeTypeTransaction FeeType = "TRANSACTION"
+	FeeTypeWithdrawal  FeeType = "WITHDRAWAL"
+	FeeTypeDeposit     FeeType = "DEPOSIT"
+	FeeTypePayment     FeeType = "PAYMENT"
+	FeeTypePurchase    FeeType = "PURCHASE"
+	FeeTypeEvent       FeeType = "EVENT"
+	FeeTypeUpfront     FeeType = "UPFRONT"
+	FeeTypeVariable    FeeType = "VARIABLE"
+	FeeTypeExit        FeeType = "EXIT"
+)
+
+// IsValid checks if the fee type is valid
+func (ft FeeType) IsValid() bool {
+	switch ft {
+	case FeeTypePeriodic, FeeTypeTransaction, FeeTypeWithdrawal, FeeTypeDeposit, FeeTypePayment,
+		FeeTypePurchase, FeeTypeEvent, FeeTypeUpfront, FeeTypeVariable, FeeTypeExit:
+		return true
+	default:
+		return false
+	}
+}
+
+// DepositRateType represents the CDR classification of a deposit interest rate
+type DepositRateType string
+
+const (
+	DepositRateFixed        DepositRateType = "FIXED"
+	DepositRateVariable     DepositRateType = "VARIABLE"
+	DepositRateBonus        DepositRateType = "BONUS"
+	DepositRateBundleBonus  DepositRateType = "BUNDLE_BONUS"
+	DepositRateIntroductory DepositRateType = "INTRODUCTORY"
+	DepositRateFloating     DepositRateType = "FLOATING"
+	DepositRateMarketLinked DepositRateType = "MARKET_LINKED"
+)
+
+// IsValid checks if the deposit rate type is valid
+func (rt DepositRateType) IsValid() bool {
+	switch rt {
+	case DepositRateFixed, DepositRateVariable, DepositRateBonus, DepositRateBundleBonus,
+		DepositRateIntroductory, DepositRateFloating, DepositRateMarketLinked:
+		return true
+	default:
+		return false
+	}
+}
+
+// LendingRateType represents the CDR classification of a lending interest rate
+type LendingRateType string
+
+const (
+	LendingRateFixed        LendingRateType = "FIXED"
+	LendingRateVariable     LendingRateType = "VARIABLE"
+	LendingRateIntroductory LendingRateType = "INTRODUCTORY"
+	LendingRateDiscount     LendingRateType = "DISCOUNT"
+	LendingRatePenalty      LendingRateType = "PENALTY"
+	LendingRateCashAdvance  LendingRateType = "CASH_ADVANCE"
+	LendingRatePurchase     LendingRateType = "PURCHASE"
+)
+
+// IsValid checks if the lending rate type is valid
+func (rt LendingRateType) IsValid() bool {
+	switch rt {
+	case LendingRateFixed, LendingRateVariable, LendingRateIntroductory, LendingRateDiscount,
+		LendingRatePenalty, LendingRateCashAdvance, LendingRatePurchase:
+		return true
+	default:
+		return false
+	}
+}
+
+// TierUnit represents the unit a rate tier's bounds are measured in
+type TierUnit string
+
+const (
+	TierUnitDollar  TierUnit = "DOLLAR"
+	TierUnitPercent TierUnit = "PERCENT"
+	TierUnitMonth   TierUnit = "MONTH"
+	TierUnitDay     TierUnit = "DAY"
+)
+
+// IsValid checks if the tier unit is valid
+func (tu TierUnit) IsValid() bool {
+	switch tu {
+	case TierUnitDollar, TierUnitPercent, TierUnitMonth, TierUnitDay:
+		return true
+	default:
+		return false
+	}
+}
+
+// RateApplicationMethod represents how a tiered rate applies to a balance
+type RateApplicationMethod string
+
+const (
+	// The tier's rate applies to the whole balance once it falls in the tier
+	RateApplicationWholeBalance RateApplicationMethod = "WHOLE_BALANCE"
+
+	// The tier's rate applies only to the part of the balance within the tier
+	RateApplicationPerTier RateApplicationMethod = "PER_TIER"
+)
+
+// IsValid checks if the rate application method is valid
+func (m RateApplicationMethod) IsValid() bool {
+	switch m {
+	case RateApplicationWholeBalance, RateApplicationPerTier:
+		return true
+	default:
+		return false
+	}
+}
+
+// InsightPeriod represents the length of the periods account insights are grouped into
+type InsightPeriod string
+
+const (
+	InsightPeriodDay   InsightPeriod = "DAY"
+	InsightPeriodWeek  InsightPeriod = "WEEK"
+	InsightPeriodMonth InsightPeriod = "MONTH"
+)
+
+// IsValid checks if the insight period is valid
+func (ip InsightPeriod) IsValid() bool {
+	switch ip {
+	case InsightPeriodDay, InsightPeriodWeek, InsightPeriodMonth:
+		return true
+	default:
+		return false
+	}
+}
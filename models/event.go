@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Event is a notification that domain data changed. Exactly one of the
+// payload fields is set, according to Type.
+type Event struct {
+	// Event classification
+	Type EventType `json:"type"`
+
+	// Account affected by transaction and balance events
+	AccountID string `json:"accountId,omitempty"`
+
+	// Event payloads
+	Transaction *Transaction `json:"transaction,omitempty"`
+	Balance     *Balance     `json:"balance,omitempty"`
+	Consent     *Consent     `json:"consent,omitempty"`
+
+	// When the change occurred
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRate represents the price of one unit of BaseCurrency in QuoteCurrency
+// following BIAN Currency Exchange service domain
+type ExchangeRate struct {
+	// Currency pair, e.g. AUD/USD
+	BaseCurrency  string `json:"baseCurrency"`
+	QuoteCurrency string `json:"quoteCurrency"`
+
+	// Units of QuoteCurrency per unit of BaseCurrency
+	Rate decimal.Decimal `json:"rate"`
+
+	// When the rate was observed
+	Timestamp time.Time `json:"timestamp"`
+
+	// Where the rate came from (e.g. "fixture", "ECB")
+	Source string `json:"source,omitempty"`
+}
+
+// NewExchangeRate creates a new ExchangeRate with validation
+func NewExchangeRate(baseCurrency, quoteCurrency string, rate decimal.Decimal, timestamp time.Time) (*ExchangeRate, error) {
+	if err := validateCurrency(baseCurrency); err != nil {
+		return nil, err
+	}
+	if err := validateCurrency(quoteCurrency); err != nil {
+		return nil, err
+	}
+	if !rate.IsPositive() {
+		return nil, fmt.Errorf("exchange rate must be positive: %s", rate.String())
+	}
+	return &ExchangeRate{
+		BaseCurrency:  strings.ToUpper(baseCurrency),
+		QuoteCurrency: strings.ToUpper(quoteCurrency),
+		Rate:          rate,
+		Timestamp:     timestamp,
+	}, nil
+}
+
+// Pair returns the currency pair in BASE/QUOTE notation
+func (r *ExchangeRate) Pair() string {
+	return r.BaseCurrency + "/" + r.QuoteCurrency
+}
+
+// Inverse returns the rate for the opposite direction (QUOTE/BASE)
+func (r *ExchangeRate) Inverse() *ExchangeRate {
+	return &ExchangeRate{
+		BaseCurrency:  r.QuoteCurrency,
+		QuoteCurrency: r.BaseCurrency,
+		Rate:          decimal.NewFromInt(1).DivRound(r.Rate, 16),
+		Timestamp:     r.Timestamp,
+		Source:        r.Source,
+	}
+}
+
+// Convert converts an amount in BaseCurrency to QuoteCurrency, rounded to the
+// quote currency's minor unit
+func (r *ExchangeRate) Convert(m Money) (Money, error) {
+	if m.Currency != r.BaseCurrency {
+		return Money{}, fmt.Errorf("currency mismatch: %s != %s", m.Currency, r.BaseCurrency)
+	}
+	converted := Money{
+		Amount:   m.Amount.Mul(r.Rate),
+		Currency: r.QuoteCurrency,
+	}
+	return converted.Round(), nil
+}
+
+// RateSource looks up exchange rates for currency conversion.
+// domains.FXService implementations satisfy this interface.
+type RateSource interface {
+	RetrieveExchangeRate(ctx context.Context, baseCurrency, quoteCurrency string) (*ExchangeRate, error)
+}
+
+// ConvertTo returns the amount converted into currency using rates from source
+func (m Money) ConvertTo(ctx context.Context, source RateSource, currency string) (Money, error) {
+	currency = strings.ToUpper(currency)
+	if m.Currency == currency {
+		return m, nil
+	}
+	rate, err := source.RetrieveExchangeRate(ctx, m.Currency, currency)
+	if err != nil {
+		return Money{}, err
+	}
+	return rate.Convert(m)
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type staticRates map[string]*ExchangeRate
+
+func (s staticRates) RetrieveExchangeRate(ctx context.Context, baseCurrency, quoteCurrency string) (*ExchangeRate, error) {
+	if rate, ok := s[baseCurrency+"/"+quoteCurrency]; ok {
+		return rate, nil
+	}
+	return nil, fmt.Errorf("exchange rate not found: %s/%s", baseCurrency, quoteCurrency)
+}
+
+func TestMoney_ConvertTo(t *testing.T) {
+	rate, err := NewExchangeRate("USD", "JPY", decimal.RequireFromString("149.85"), time.Now())
+	if err != nil {
+		t.Fatalf("NewExchangeRate() error = %v", err)
+	}
+	rates := staticRates{rate.Pair(): rate}
+
+	usd, _ := NewMoneyFromString("10.01", "USD")
+	jpy, err := usd.ConvertTo(context.Background(), rates, "jpy")
+	if err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	if jpy.Currency != "JPY" || !jpy.Amount.Equal(decimal.NewFromInt(1500)) {
+		t.Errorf("ConvertTo() = %v, want 1500 JPY", jpy)
+	}
+
+	same, err := usd.ConvertTo(context.Background(), rates, "USD")
+	if err != nil || !same.Equal(usd) {
+		t.Errorf("ConvertTo(same currency) = %v, %v", same, err)
+	}
+
+	if _, err := usd.ConvertTo(context.Background(), rates, "EUR"); err == nil {
+		t.Error("ConvertTo() should return error for unknown pair")
+	}
+
+	back, err := rate.Inverse().Convert(jpy)
+	if err != nil || !back.Amount.Equal(decimal.RequireFromString("10.01")) {
+		t.Errorf("Inverse().Convert() = %v, %v", back, err)
+	}
+
+	if _, err := NewExchangeRate("USD", "EUR", decimal.Zero, time.Now()); err == nil {
+		t.Error("NewExchangeRate() should reject non-positive rate")
+	}
+}
@@ -0,0 +1,175 @@
+package models
+
+import (
+	"strings"
+)
+
+// Locale describes how monetary amounts are written for a region
+type Locale struct {
+	// BCP 47 language tag, e.g. "en-AU"
+	Tag string
+
+	// Separator between the integer and fractional part
+	DecimalSeparator string
+
+	// Separator between groups of three integer digits
+	GroupSeparator string
+
+	// Whether the currency symbol follows the amount ("1.234,56 €")
+	SymbolAfter bool
+
+	// Whether a no-break space separates the amount and the currency symbol
+	SymbolSpace bool
+
+	// ISO 4217 code of the locale's home currency, written with the bare
+	// symbol ("$"); other currencies sharing that symbol are qualified ("US$")
+	Currency string
+}
+
+// Invisible separators, written as escapes so they are visible in source
+const (
+	noBreakSpace       = "\u00a0"
+	narrowNoBreakSpace = "\u202f"
+)
+
+// Predefined locales used by statements and notifications
+var (
+	LocaleEnAU = Locale{Tag: "en-AU", DecimalSeparator: ".", GroupSeparator: ",", Currency: "AUD"}
+	LocaleEnUS = Locale{Tag: "en-US", DecimalSeparator: ".", GroupSeparator: ",", Currency: "USD"}
+	LocaleEnGB = Locale{Tag: "en-GB", DecimalSeparator: ".", GroupSeparator: ",", Currency: "GBP"}
+	LocaleEnCA = Locale{Tag: "en-CA", DecimalSeparator: ".", GroupSeparator: ",", Currency: "CAD"}
+	LocaleEnNZ = Locale{Tag: "en-NZ", DecimalSeparator: ".", GroupSeparator: ",", Currency: "NZD"}
+	LocaleFrCA = Locale{Tag: "fr-CA", DecimalSeparator: ",", GroupSeparator: noBreakSpace, SymbolAfter: true, SymbolSpace: true, Currency: "CAD"}
+	LocaleDeDE = Locale{Tag: "de-DE", DecimalSeparator: ",", GroupSeparator: ".", SymbolAfter: true, SymbolSpace: true, Currency: "EUR"}
+	LocaleFrFR = Locale{Tag: "fr-FR", DecimalSeparator: ",", GroupSeparator: narrowNoBreakSpace, SymbolAfter: true, SymbolSpace: true, Currency: "EUR"}
+	LocaleEsES = Locale{Tag: "es-ES", DecimalSeparator: ",", GroupSeparator: ".", SymbolAfter: true, SymbolSpace: true, Currency: "EUR"}
+	LocaleItIT = Locale{Tag: "it-IT", DecimalSeparator: ",", GroupSeparator: ".", SymbolAfter: true, SymbolSpace: true, Currency: "EUR"}
+	LocaleNlNL = Locale{Tag: "nl-NL", DecimalSeparator: ",", GroupSeparator: ".", SymbolSpace: true, Currency: "EUR"}
+	LocaleDeCH = Locale{Tag: "de-CH", DecimalSeparator: ".", GroupSeparator: "’", SymbolSpace: true, Currency: "CHF"}
+	LocaleJaJP = Locale{Tag: "ja-JP", DecimalSeparator: ".", GroupSeparator: ",", Currency: "JPY"}
+)
+
+var locales = map[string]Locale{
+	"en-au": LocaleEnAU,
+	"en-us": LocaleEnUS,
+	"en-gb": LocaleEnGB,
+	"en-ca": LocaleEnCA,
+	"en-nz": LocaleEnNZ,
+	"fr-ca": LocaleFrCA,
+	"de-de": LocaleDeDE,
+	"fr-fr": LocaleFrFR,
+	"es-es": LocaleEsES,
+	"it-it": LocaleItIT,
+	"nl-nl": LocaleNlNL,
+	"de-ch": LocaleDeCH,
+	"ja-jp": LocaleJaJP,
+}
+
+// LookupLocale returns a predefined locale by BCP 47 tag (case-insensitive, "_" or "-")
+func LookupLocale(tag string) (Locale, bool) {
+	l, ok := locales[strings.ToLower(strings.ReplaceAll(tag, "_", "-"))]
+	return l, ok
+}
+
+// currencySymbols maps currency codes to their customary symbols; codes
+// without an entry are rendered using the alphabetic code
+var currencySymbols = map[string]string{
+	"AUD": "$", "USD": "$", "CAD": "$", "NZD": "$", "SGD": "$", "HKD": "$",
+	"EUR": "€", "GBP": "£", "JPY": "¥", "CNY": "¥", "CHF": "CHF",
+	"INR": "₹", "KRW": "₩", "ILS": "₪", "VND": "₫", "NGN": "₦",
+	"PHP": "₱", "THB": "฿", "UAH": "₴", "TRY": "₺", "RUB": "₽",
+	"PLN": "zł", "SEK": "kr", "NOK": "kr", "DKK": "kr", "ZAR": "R", "BRL": "R$",
+}
+
+// qualifiedSymbols disambiguates currencies that share a customary symbol
+// outside the locale whose home currency they are
+var qualifiedSymbols = map[string]string{
+	"AUD": "A$", "USD": "US$", "CAD": "CA$", "NZD": "NZ$", "SGD": "S$", "HKD": "HK$",
+	"JPY": "JP¥", "CNY": "CN¥",
+}
+
+// CurrencySymbol returns the customary symbol for a currency code
+func CurrencySymbol(code string) string {
+	code = strings.ToUpper(code)
+	if symbol, ok := currencySymbols[code]; ok {
+		return symbol
+	}
+	return code
+}
+
+// Symbol returns the symbol used for a currency in the locale: the customary
+// symbol for the home currency, and a qualified one such as "US$" for other
+// currencies whose customary symbol is ambiguous
+func (l Locale) Symbol(code string) string {
+	code = strings.ToUpper(code)
+	if code != l.Currency {
+		if symbol, ok := qualifiedSymbols[code]; ok {
+			return symbol
+		}
+	}
+	return CurrencySymbol(code)
+}
+
+// Format renders the amount for display in the given locale, rounded half-up to
+// the currency's minor unit, e.g. "$1,234.56" (en-AU) or "1.234,56 €" (de-DE)
+func (m Money) Format(locale Locale) string {
+	return m.FormatWith(locale, RoundHalfUp)
+}
+
+// FormatWith renders the amount for display in the given locale using the given rounding mode
+func (m Money) FormatWith(locale Locale, mode RoundingMode) string {
+	exponent := int32(m.Exponent())
+	rounded := roundDecimal(m.Amount, exponent, mode)
+
+	digits := rounded.Abs().StringFixed(exponent)
+	integer, fraction, _ := strings.Cut(digits, ".")
+
+	var b strings.Builder
+	if rounded.IsNegative() {
+		b.WriteString("-")
+	}
+
+	// Alphabetic codes are always spaced from the amount
+	symbol := locale.Symbol(m.Currency)
+	space := locale.SymbolSpace || symbol == strings.ToUpper(m.Currency)
+	if !locale.SymbolAfter {
+		b.WriteString(symbol)
+		if space {
+			b.WriteString(noBreakSpace)
+		}
+	}
+
+	b.WriteString(groupDigits(integer, locale.GroupSeparator))
+	if fraction != "" {
+		b.WriteString(locale.DecimalSeparator)
+		b.WriteString(fraction)
+	}
+
+	if locale.SymbolAfter {
+		if space {
+			b.WriteString(noBreakSpace)
+		}
+		b.WriteString(symbol)
+	}
+
+	return b.String()
+}
+
+// groupDigits inserts sep between groups of three digits from the right
+func groupDigits(integer, sep string) string {
+	if len(integer) <= 3 || sep == "" {
+		return integer
+	}
+	var b strings.Builder
+	head := len(integer) % 3
+	if head > 0 {
+		b.WriteString(integer[:head])
+	}
+	for i := head; i < len(integer); i += 3 {
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(integer[i : i+3])
+	}
+	return b.String()
+}
@@ -0,0 +1,118 @@
+package identifiers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	bsbFormat      = regexp.MustCompile(`^[0-9]{3}-?[0-9]{3}$`)
+	sortCodeFormat = regexp.MustCompile(`^[0-9]{2}-?[0-9]{2}-?[0-9]{2}$`)
+	routingFormat  = regexp.MustCompile(`^[0-9]{9}$`)
+	transitFormat  = regexp.MustCompile(`^([0-9]{5})-?([0-9]{3})$`)
+	transitEFT     = regexp.MustCompile(`^0([0-9]{3})([0-9]{5})$`)
+)
+
+// BSB is an Australian Bank-State-Branch number, stored as six digits
+type BSB string
+
+// ParseBSB parses a BSB written as 062000 or 062-000
+func ParseBSB(s string) (BSB, error) {
+	s = strings.TrimSpace(s)
+	if !bsbFormat.MatchString(s) {
+		return "", fmt.Errorf("BSB %w: must be 6 digits", ErrInvalidFormat)
+	}
+	return BSB(strings.ReplaceAll(s, "-", "")), nil
+}
+
+// String returns the BSB in its usual written form, 062-000
+func (b BSB) String() string {
+	if len(b) != 6 {
+		return string(b)
+	}
+	return string(b[:3]) + "-" + string(b[3:])
+}
+
+// SortCode is a UK bank sort code, stored as six digits
+type SortCode string
+
+// ParseSortCode parses a sort code written as 404784 or 40-47-84
+func ParseSortCode(s string) (SortCode, error) {
+	s = strings.TrimSpace(s)
+	if !sortCodeFormat.MatchString(s) {
+		return "", fmt.Errorf("sort code %w: must be 6 digits", ErrInvalidFormat)
+	}
+	return SortCode(strings.ReplaceAll(s, "-", "")), nil
+}
+
+// String returns the sort code in its usual written form, 40-47-84
+func (c SortCode) String() string {
+	if len(c) != 6 {
+		return string(c)
+	}
+	return string(c[:2]) + "-" + string(c[2:4]) + "-" + string(c[4:])
+}
+
+// RoutingNumber is a nine-digit US ABA routing transit number
+type RoutingNumber string
+
+// ParseRoutingNumber parses a routing number and checks its ABA 3-7-1
+// weighted check digit
+func ParseRoutingNumber(s string) (RoutingNumber, error) {
+	s = strings.TrimSpace(s)
+	if !routingFormat.MatchString(s) {
+		return "", fmt.Errorf("ABA routing number %w: must be 9 digits", ErrInvalidFormat)
+	}
+	weights := [3]int{3, 7, 1}
+	sum := 0
+	for i, c := range s {
+		sum += int(c-'0') * weights[i%3]
+	}
+	if sum%10 != 0 {
+		return "", fmt.Errorf("ABA routing number %w", ErrInvalidCheckDigits)
+	}
+	return RoutingNumber(s), nil
+}
+
+// String returns the routing number
+func (n RoutingNumber) String() string {
+	return string(n)
+}
+
+// TransitNumber is a Canadian routing number: a five-digit branch transit
+// number and the three-digit number of the financial institution
+type TransitNumber struct {
+	Transit     string
+	Institution string
+}
+
+// ParseTransitNumber parses a Canadian routing number in paper (MICR) form,
+// 12345-003 or 12345003, or electronic (EFT) form, 000312345
+func ParseTransitNumber(s string) (TransitNumber, error) {
+	s = strings.TrimSpace(s)
+	if m := transitFormat.FindStringSubmatch(s); m != nil {
+		return TransitNumber{Transit: m[1], Institution: m[2]}, nil
+	}
+	if m := transitEFT.FindStringSubmatch(s); m != nil {
+		return TransitNumber{Transit: m[2], Institution: m[1]}, nil
+	}
+	return TransitNumber{}, fmt.Errorf("transit number %w: must be 12345-003 or 000312345", ErrInvalidFormat)
+}
+
+// String returns the paper form, transit then institution: 12345-003
+func (n TransitNumber) String() string {
+	if n == (TransitNumber{}) {
+		return ""
+	}
+	return n.Transit + "-" + n.Institution
+}
+
+// Electronic returns the nine-digit form used in EFT files: 0, institution,
+// transit
+func (n TransitNumber) Electronic() string {
+	if n == (TransitNumber{}) {
+		return ""
+	}
+	return "0" + n.Institution + n.Transit
+}
@@ -0,0 +1,119 @@
+package identifiers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// IBAN is an International Bank Account Number in electronic format: upper
+// case with no spaces
+type IBAN string
+
+var (
+	ibanFormat = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+	bicFormat  = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+)
+
+// ibanLengths is the IBAN length of each country in the SWIFT IBAN registry
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+	"FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+	"GT": 28, "HN": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26,
+	"IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20,
+	"LU": 20, "LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20,
+	"MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24,
+	"PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24,
+	"SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25,
+	"SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+	"YE": 30,
+}
+
+// ParseIBAN parses an IBAN in electronic or printed form (spaces allowed,
+// any case), checking its length for the country and its mod-97 check digits
+func ParseIBAN(s string) (IBAN, error) {
+	iban := strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	if !ibanFormat.MatchString(iban) {
+		return "", fmt.Errorf("IBAN %w", ErrInvalidFormat)
+	}
+	length, ok := ibanLengths[iban[:2]]
+	if !ok {
+		return "", fmt.Errorf("IBAN %w: %s", ErrUnknownCountry, iban[:2])
+	}
+	if len(iban) != length {
+		return "", fmt.Errorf("IBAN %w: %s IBANs are %d characters", ErrInvalidFormat, iban[:2], length)
+	}
+
+	// Move the country code and check digits to the end and read the result
+	// as a number, letters standing for 10 (A) to 35 (Z); it must be 1 mod 97.
+	// The remainder is accumulated digit by digit to avoid big integers.
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	if remainder != 1 {
+		return "", fmt.Errorf("IBAN %w", ErrInvalidCheckDigits)
+	}
+	return IBAN(iban), nil
+}
+
+// Country returns the ISO 3166-1 alpha-2 country code
+func (i IBAN) Country() string {
+	return string(i[:2])
+}
+
+// BBAN returns the country-specific Basic Bank Account Number
+func (i IBAN) BBAN() string {
+	return string(i[4:])
+}
+
+// String returns the IBAN in electronic format
+func (i IBAN) String() string {
+	return string(i)
+}
+
+// Printed returns the IBAN in groups of four characters, as written on
+// statements and invoices
+func (i IBAN) Printed() string {
+	return group(string(i))
+}
+
+// BIC is an ISO 9362 business identifier code (SWIFT code)
+type BIC string
+
+// ParseBIC parses an 8 or 11 character BIC
+func ParseBIC(s string) (BIC, error) {
+	bic := strings.ToUpper(strings.TrimSpace(s))
+	if !bicFormat.MatchString(bic) {
+		return "", fmt.Errorf("BIC %w: must be 8 or 11 characters", ErrInvalidFormat)
+	}
+	return BIC(bic), nil
+}
+
+// Country returns the ISO 3166-1 alpha-2 country code of the bank
+func (b BIC) Country() string {
+	return string(b[4:6])
+}
+
+// String returns the BIC
+func (b BIC) String() string {
+	return string(b)
+}
+
+// group splits s into space-separated groups of four characters
+func group(s string) string {
+	var b strings.Builder
+	for i, c := range s {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,96 @@
+// Package identifiers parses, validates and masks bank account identifiers:
+// IBANs, Australian BSBs, UK sort codes, US ABA routing numbers and Canadian
+// transit numbers, together with the account numbers used alongside them.
+//
+// Parse functions accept the common written forms (spaces, hyphens, lower
+// case) and return the value in a canonical form. Checksums are verified
+// where the scheme defines one: IBANs (ISO 13616 mod-97) and ABA routing
+// numbers (3-7-1). BSBs, sort codes and Canadian transit numbers carry no
+// check digit, so only their structure can be validated.
+package identifiers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var (
+	// ErrInvalidFormat is returned when a value has the wrong length or characters
+	ErrInvalidFormat = errors.New("has an invalid format")
+
+	// ErrInvalidCheckDigits is returned when a value's check digits do not match
+	ErrInvalidCheckDigits = errors.New("has invalid check digits")
+
+	// ErrUnknownCountry is returned for IBANs from countries outside the IBAN registry
+	ErrUnknownCountry = errors.New("has an unknown country code")
+)
+
+// Scheme identifies how an account is addressed for payments
+type Scheme string
+
+const (
+	SchemeIBAN            Scheme = "IBAN"
+	SchemeBSB             Scheme = "BSB"
+	SchemeSortCode        Scheme = "SORT_CODE"
+	SchemeABA             Scheme = "ABA"
+	SchemeCanadianTransit Scheme = "CA_TRANSIT"
+)
+
+// IsValid checks if the scheme is valid
+func (s Scheme) IsValid() bool {
+	switch s {
+	case SchemeIBAN, SchemeBSB, SchemeSortCode, SchemeABA, SchemeCanadianTransit:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseBankCode parses the bank or branch code of a domestic scheme and
+// returns it in canonical form. IBANs embed their bank code and have none.
+func ParseBankCode(scheme Scheme, code string) (string, error) {
+	switch scheme {
+	case SchemeBSB:
+		bsb, err := ParseBSB(code)
+		return bsb.String(), err
+	case SchemeSortCode:
+		sortCode, err := ParseSortCode(code)
+		return sortCode.String(), err
+	case SchemeABA:
+		routingNumber, err := ParseRoutingNumber(code)
+		return routingNumber.String(), err
+	case SchemeCanadianTransit:
+		transit, err := ParseTransitNumber(code)
+		return transit.String(), err
+	default:
+		return "", fmt.Errorf("scheme %q has no bank code", scheme)
+	}
+}
+
+// accountNumbers are the account number formats of the domestic schemes
+var accountNumbers = map[Scheme]struct {
+	format      *regexp.Regexp
+	description string
+}{
+	// BECS allows at most nine characters
+	SchemeBSB:      {regexp.MustCompile(`^[0-9]{5,9}$`), "must be 5 to 9 digits"},
+	SchemeSortCode: {regexp.MustCompile(`^[0-9]{8}$`), "must be 8 digits"},
+	SchemeABA:      {regexp.MustCompile(`^[0-9]{4,17}$`), "must be 4 to 17 digits"},
+
+	SchemeCanadianTransit: {regexp.MustCompile(`^[0-9]{7,12}$`), "must be 7 to 12 digits"},
+}
+
+// ValidateAccountNumber checks an account number against the format used
+// with the scheme's bank codes. Account numbers carry no check digit that
+// can be verified without the bank's own algorithm.
+func ValidateAccountNumber(scheme Scheme, number string) error {
+	rule, ok := accountNumbers[scheme]
+	if !ok {
+		return fmt.Errorf("scheme %q has no separate account number", scheme)
+	}
+	if !rule.format.MatchString(number) {
+		return fmt.Errorf("account number %w: %s", ErrInvalidFormat, rule.description)
+	}
+	return nil
+}
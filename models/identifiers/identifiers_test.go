@@ -0,0 +1,138 @@
+package identifiers
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseIBAN(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    IBAN
+		wantErr error
+	}{
+		{"electronic", "GB82WEST12345698765432", "GB82WEST12345698765432", nil},
+		{"printed", "GB82 WEST 1234 5698 7654 32", "GB82WEST12345698765432", nil},
+		{"lower case", "de89370400440532013000", "DE89370400440532013000", nil},
+		{"shortest country", "NO9386011117947", "NO9386011117947", nil},
+		{"bad check digits", "GB83WEST12345698765432", "", ErrInvalidCheckDigits},
+		{"transposed digits", "GB82WEST12345698765423", "", ErrInvalidCheckDigits},
+		{"too short", "GB82WEST1234", "", ErrInvalidFormat},
+		{"wrong length for country", "GB82WEST123456987654321", "", ErrInvalidFormat},
+		{"unknown country", "ZZ82WEST12345698765432", "", ErrUnknownCountry},
+		{"punctuation", "GB82-WEST-1234-5698-7654-32", "", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIBAN(tt.value)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("ParseIBAN(%q) = %q, %v; want %q, %v", tt.value, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+
+	iban, _ := ParseIBAN("GB82WEST12345698765432")
+	if iban.Country() != "GB" || iban.BBAN() != "WEST12345698765432" || iban.Printed() != "GB82 WEST 1234 5698 7654 32" {
+		t.Errorf("parts of %s = %s, %s, %q", iban, iban.Country(), iban.BBAN(), iban.Printed())
+	}
+}
+
+func TestParseBankCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		scheme  Scheme
+		value   string
+		want    string
+		wantErr error
+	}{
+		{"BSB", SchemeBSB, "062000", "062-000", nil},
+		{"BSB with hyphen", SchemeBSB, "062-000", "062-000", nil},
+		{"BSB too short", SchemeBSB, "06200", "", ErrInvalidFormat},
+		{"BSB misplaced hyphen", SchemeBSB, "06-2000", "", ErrInvalidFormat},
+		{"sort code", SchemeSortCode, "40-47-84", "40-47-84", nil},
+		{"sort code undelimited", SchemeSortCode, "404784", "40-47-84", nil},
+		{"sort code letters", SchemeSortCode, "40-47-8A", "", ErrInvalidFormat},
+		{"ABA routing number", SchemeABA, "021000021", "021000021", nil},
+		{"ABA bad check digit", SchemeABA, "021000022", "", ErrInvalidCheckDigits},
+		{"ABA too short", SchemeABA, "02100002", "", ErrInvalidFormat},
+		{"transit paper form", SchemeCanadianTransit, "12345-003", "12345-003", nil},
+		{"transit undelimited", SchemeCanadianTransit, "12345003", "12345-003", nil},
+		{"transit electronic form", SchemeCanadianTransit, "000312345", "12345-003", nil},
+		{"transit electronic without leading zero", SchemeCanadianTransit, "100312345", "", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBankCode(tt.scheme, tt.value)
+			if !errors.Is(err, tt.wantErr) || (err == nil && got != tt.want) {
+				t.Errorf("ParseBankCode(%s, %q) = %q, %v; want %q, %v", tt.scheme, tt.value, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := ParseBankCode(SchemeIBAN, "GB82WEST12345698765432"); err == nil {
+		t.Error("ParseBankCode(IBAN) succeeded, want an error")
+	}
+	if transit, _ := ParseTransitNumber("12345-003"); transit.Electronic() != "000312345" {
+		t.Errorf("Electronic() = %q, want 000312345", transit.Electronic())
+	}
+}
+
+func TestParseBIC(t *testing.T) {
+	for value, wantOK := range map[string]bool{
+		"NWBKGB2L":    true,
+		"DEUTDEFF500": true,
+		"deutdeff":    true,
+		"DEUTDEFF50":  false,
+		"DEU1DEFF":    false,
+	} {
+		if _, err := ParseBIC(value); (err == nil) != wantOK {
+			t.Errorf("ParseBIC(%q) = %v, want ok %v", value, err, wantOK)
+		}
+	}
+}
+
+func TestValidateAccountNumber(t *testing.T) {
+	tests := []struct {
+		scheme Scheme
+		value  string
+		wantOK bool
+	}{
+		{SchemeBSB, "12345678", true},
+		{SchemeBSB, "1234567890", false},
+		{SchemeSortCode, "31926819", true},
+		{SchemeSortCode, "3192681", false},
+		{SchemeABA, "000123456789", true},
+		{SchemeCanadianTransit, "1234567", true},
+		{SchemeCanadianTransit, "12-34567", false},
+		{SchemeIBAN, "12345678", false},
+	}
+
+	for _, tt := range tests {
+		if err := ValidateAccountNumber(tt.scheme, tt.value); (err == nil) != tt.wantOK {
+			t.Errorf("ValidateAccountNumber(%s, %q) = %v, want ok %v", tt.scheme, tt.value, err, tt.wantOK)
+		}
+	}
+}
+
+func TestMasking(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"account number", MaskAccountNumber("123456789"), "*****6789"},
+		{"account number with separators", MaskAccountNumber("1234-5678"), "****5678"},
+		{"short account number", MaskAccountNumber("1234"), "1234"},
+		{"IBAN", MaskIBAN("GB82WEST12345698765432"), "GB** **** **** **** **54 32"},
+		{"printed IBAN", MaskIBAN("gb82 west 1234 5698 7654 32"), "GB** **** **** **** **54 32"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
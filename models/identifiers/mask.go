@@ -0,0 +1,37 @@
+package identifiers
+
+import "strings"
+
+// visibleDigits is how many trailing characters masking leaves readable
+const visibleDigits = 4
+
+// MaskAccountNumber masks all but the last four characters of an account
+// or card number, dropping separators: 123456789 becomes *****6789
+func MaskAccountNumber(number string) string {
+	chars := alphanumeric(number)
+	if len(chars) <= visibleDigits {
+		return chars
+	}
+	return strings.Repeat("*", len(chars)-visibleDigits) + chars[len(chars)-visibleDigits:]
+}
+
+// MaskIBAN masks an IBAN apart from its country code and last four
+// characters, in printed form: GB** **** **** **** **54 32
+func MaskIBAN(iban string) string {
+	chars := strings.ToUpper(alphanumeric(iban))
+	if len(chars) <= 2+visibleDigits {
+		return group(chars)
+	}
+	masked := chars[:2] + strings.Repeat("*", len(chars)-2-visibleDigits) + chars[len(chars)-visibleDigits:]
+	return group(masked)
+}
+
+// alphanumeric strips everything but letters and digits from s
+func alphanumeric(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' || r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' {
+			return r
+		}
+		return -1
+	}, s)
+}
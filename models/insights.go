@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+// AccountInsights summarises an account's cash flow over a date range, in
+// total, per period and grouped by transaction type, category and merchant.
+// Amounts are never converted between currencies: every summary holds one
+// CashFlow per currency, sorted by currency code.
+type AccountInsights struct {
+	AccountID string `json:"accountId"`
+
+	// Date range covered, both inclusive, and the length of each period
+	FromDate time.Time     `json:"fromDate"`
+	ToDate   time.Time     `json:"toDate"`
+	Period   InsightPeriod `json:"period"`
+
+	// Cash flow over the whole range
+	Totals []CashFlow `json:"totals"`
+
+	// Average cash flow per period, over every period in the range
+	// including those without transactions
+	PeriodAverages []PeriodAverage `json:"periodAverages"`
+
+	// Cash flow per period, oldest first, including empty periods
+	Periods []PeriodInsight `json:"periods"`
+
+	// Cash flow by transaction type, category and merchant. Transactions
+	// without a category are grouped under UNCATEGORISED; transactions
+	// without a merchant are left out of ByMerchant.
+	ByType     []InsightGroup `json:"byType"`
+	ByCategory []InsightGroup `json:"byCategory"`
+	ByMerchant []InsightGroup `json:"byMerchant"`
+}
+
+// UncategorisedKey groups transactions without a category in AccountInsights.ByCategory
+const UncategorisedKey = "UNCATEGORISED"
+
+// CashFlow totals a group of transactions in one currency
+type CashFlow struct {
+	Currency string `json:"currency"`
+
+	// Number of transactions
+	Count int `json:"count"`
+
+	// Sum of credits (positive amounts)
+	Inflow Money `json:"inflow"`
+
+	// Sum of debits (negative amounts), as a positive amount
+	Outflow Money `json:"outflow"`
+
+	// Inflow less outflow
+	Net Money `json:"net"`
+
+	// Average signed transaction amount, rounded to the currency's minor unit
+	Average Money `json:"average"`
+}
+
+// PeriodAverage is the average cash flow per period in one currency, each
+// amount rounded to the currency's minor unit
+type PeriodAverage struct {
+	Currency string `json:"currency"`
+	Inflow   Money  `json:"inflow"`
+	Outflow  Money  `json:"outflow"`
+	Net      Money  `json:"net"`
+}
+
+// PeriodInsight is the cash flow of one period
+type PeriodInsight struct {
+	// First and last day of the period, both inclusive
+	StartDate time.Time `json:"startDate"`
+	EndDate   time.Time `json:"endDate"`
+
+	CashFlow []CashFlow `json:"cashFlow"`
+}
+
+// InsightGroup is the cash flow of the transactions sharing a key: a
+// transaction type, category or merchant name
+type InsightGroup struct {
+	Key      string     `json:"key"`
+	CashFlow []CashFlow `json:"cashFlow"`
+}
@@ -0,0 +1,71 @@
+package interest
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DayCount is a day count convention: how the days between two dates are
+// counted and how many days make up a year
+type DayCount string
+
+const (
+	// Actual365 counts actual days over a 365-day year (ACT/365 Fixed), as
+	// used for most Australian and UK deposit and card products
+	Actual365 DayCount = "ACT/365"
+	// Actual360 counts actual days over a 360-day year, as used by US and
+	// euro money markets
+	Actual360 DayCount = "ACT/360"
+	// Thirty360 treats every month as 30 days and the year as 360 days
+	// (30/360 bond basis)
+	Thirty360 DayCount = "30/360"
+)
+
+// IsValid checks if the day count convention is valid
+func (dc DayCount) IsValid() bool {
+	switch dc {
+	case Actual365, Actual360, Thirty360:
+		return true
+	default:
+		return false
+	}
+}
+
+// Basis returns the number of days in the convention's year
+func (dc DayCount) Basis() int {
+	if dc == Actual365 {
+		return 365
+	}
+	return 360
+}
+
+// Days returns the number of days from start to end under the convention.
+// Only the calendar dates count, in the location of each time.
+func (dc DayCount) Days(start, end time.Time) int {
+	if dc != Thirty360 {
+		return civilDays(end) - civilDays(start)
+	}
+
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+	return 360*(y2-y1) + 30*int(m2-m1) + (d2 - d1)
+}
+
+// YearFraction returns the period from start to end as a fraction of a year
+func (dc DayCount) YearFraction(start, end time.Time) decimal.Decimal {
+	return decimal.NewFromInt(int64(dc.Days(start, end))).Div(decimal.NewFromInt(int64(dc.Basis())))
+}
+
+// civilDays returns t's calendar date as a day number, ignoring time zones
+// and daylight saving so every day is exactly one apart
+func civilDays(t time.Time) int {
+	year, month, day := t.Date()
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
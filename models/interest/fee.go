@@ -0,0 +1,48 @@
+package interest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// FeeDue reports whether a PERIODIC fee falls due on date for an account
+// opened on opened. Periodic fees fall due on each accrual frequency
+// anniversary of the open date, but not on the open date itself.
+func FeeDue(fee models.ProductFee, opened, date time.Time) (bool, error) {
+	if fee.Type != models.FeeTypePeriodic {
+		return false, nil
+	}
+	period, err := ParsePeriod(fee.AccrualFrequency)
+	if err != nil {
+		return false, err
+	}
+
+	opened = startOfDay(opened)
+	date = startOfDay(date.In(opened.Location()))
+	if !date.After(opened) {
+		return false, nil
+	}
+	return period.Next(opened, date).Equal(date), nil
+}
+
+// FeeAmount returns the fee charged against base, as a negative amount
+// rounded to the currency's minor unit. base is the balance for a
+// balanceRate fee or the transaction amount for a transactionRate fee, and
+// is ignored for a fixed fee. VARIABLE fees without an amount or rate
+// cannot be calculated.
+func FeeAmount(fee models.ProductFee, base models.Money) (models.Money, error) {
+	switch {
+	case fee.Amount != nil:
+		if fee.Amount.Currency != base.Currency {
+			return models.Money{}, fmt.Errorf("currency mismatch: %s != %s", fee.Amount.Currency, base.Currency)
+		}
+		return fee.Amount.Abs().Negate(), nil
+	case fee.BalanceRate != nil:
+		return base.Abs().Multiply(*fee.BalanceRate).Round().Negate(), nil
+	case fee.TransactionRate != nil:
+		return base.Abs().Multiply(*fee.TransactionRate).Round().Negate(), nil
+	}
+	return models.Money{}, fmt.Errorf("fee %q has no amount or rate to calculate", fee.Name)
+}
@@ -0,0 +1,210 @@
+// Package interest calculates interest accrual and fees for the deposit and
+// lending products described by models.Product.
+//
+// Interest accrues daily on the end-of-day balance using a product's
+// (optionally tiered) annual rates and a day count convention. Accrued
+// interest is capitalised at the end of each month: rounded to the
+// currency's minor unit and added to the balance, with the sub-minor-unit
+// residue carried forward so nothing is lost to rounding. Credit balances
+// earn interest and debit balances are charged it, so interest always has
+// the sign of the balance it accrued on.
+package interest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/shopspring/decimal"
+)
+
+// Band is an annual rate applied to all or part of a balance
+type Band struct {
+	Rate decimal.Decimal
+
+	// Tier limits the rate to part of the balance; nil applies it to the
+	// whole balance. Tiers measured in anything but DOLLAR (such as a term
+	// deposit's MONTH tiers) do not depend on the balance and always apply.
+	Tier *models.RateTier
+}
+
+// DepositBands returns a band for each deposit rate tier, or one untiered
+// band for a rate without tiers. Conditional rates such as BONUS are
+// included, so filter rates first to leave them out.
+func DepositBands(rates []models.DepositRate) []Band {
+	var bands []Band
+	for _, rate := range rates {
+		bands = append(bands, rateBands(rate.Rate, rate.Tiers)...)
+	}
+	return bands
+}
+
+// LendingBands returns the bands of the lending rates of the given type,
+// such as PURCHASE or CASH_ADVANCE for a credit card
+func LendingBands(rates []models.LendingRate, rateType models.LendingRateType) []Band {
+	var bands []Band
+	for _, rate := range rates {
+		if rate.Type == rateType {
+			bands = append(bands, rateBands(rate.Rate, rate.Tiers)...)
+		}
+	}
+	return bands
+}
+
+func rateBands(rate decimal.Decimal, tiers []models.RateTier) []Band {
+	if len(tiers) == 0 {
+		return []Band{{Rate: rate}}
+	}
+	bands := make([]Band, len(tiers))
+	for i := range tiers {
+		bands[i] = Band{Rate: rate, Tier: &tiers[i]}
+	}
+	return bands
+}
+
+// portion returns the part of a non-negative balance the band's rate applies to.
+// A PER_TIER rate applies to the slice of the balance within the tier; a
+// WHOLE_BALANCE rate applies to the whole balance when it falls in the tier.
+func (b Band) portion(balance decimal.Decimal) decimal.Decimal {
+	tier := b.Tier
+	if tier == nil || tier.UnitOfMeasure != models.TierUnitDollar {
+		return balance
+	}
+	if tier.RateApplicationMethod != models.RateApplicationPerTier {
+		if tier.Contains(balance) {
+			return balance
+		}
+		return decimal.Zero
+	}
+
+	upper := balance
+	if tier.MaximumValue != nil && upper.GreaterThan(*tier.MaximumValue) {
+		upper = *tier.MaximumValue
+	}
+	if upper.LessThanOrEqual(tier.MinimumValue) {
+		return decimal.Zero
+	}
+	return upper.Sub(tier.MinimumValue)
+}
+
+// AnnualInterest returns a year's simple interest on balance at the bands'
+// rates, unrounded and with the sign of the balance
+func AnnualInterest(bands []Band, balance models.Money) models.Money {
+	amount := balance.Amount.Abs()
+	total := decimal.Zero
+	for _, band := range bands {
+		total = total.Add(band.portion(amount).Mul(band.Rate))
+	}
+	if balance.IsNegative() {
+		total = total.Neg()
+	}
+	return models.Money{Amount: total, Currency: balance.Currency}
+}
+
+// EffectiveRate returns the single annual rate that earns the same interest
+// on balance as the bands, or zero for a zero balance
+func EffectiveRate(bands []Band, balance models.Money) decimal.Decimal {
+	if balance.IsZero() {
+		return decimal.Zero
+	}
+	return AnnualInterest(bands, balance).Amount.Div(balance.Amount)
+}
+
+// Calculator accrues interest at a set of rate bands under a day count convention
+type Calculator struct {
+	Bands    []Band
+	DayCount DayCount
+}
+
+// Accrue returns the unrounded interest on balance held from start to end
+func (c Calculator) Accrue(balance models.Money, start, end time.Time) models.Money {
+	// Divide last: a rounded year fraction such as 1/365 would skew the result
+	annual := AnnualInterest(c.Bands, balance)
+	days := decimal.NewFromInt(int64(c.DayCount.Days(start, end)))
+	annual.Amount = annual.Amount.Mul(days).Div(decimal.NewFromInt(int64(c.DayCount.Basis())))
+	return annual
+}
+
+// NewAccrual starts accumulating daily interest in the given currency
+func (c Calculator) NewAccrual(currency string) *Accrual {
+	return &Accrual{
+		calculator: c,
+		accrued:    models.Money{Amount: decimal.Zero, Currency: currency},
+	}
+}
+
+// Accrual accumulates daily interest between capitalisations
+type Accrual struct {
+	calculator Calculator
+	accrued    models.Money
+}
+
+// AccrueDay adds a day's interest on the end-of-day balance of date
+func (a *Accrual) AccrueDay(balance models.Money, date time.Time) error {
+	if balance.Currency != a.accrued.Currency {
+		return fmt.Errorf("currency mismatch: %s != %s", balance.Currency, a.accrued.Currency)
+	}
+	a.accrued.Amount = a.accrued.Amount.Add(a.calculator.Accrue(balance, date, date.AddDate(0, 0, 1)).Amount)
+	return nil
+}
+
+// Accrued returns the unrounded interest accrued but not yet capitalised
+func (a *Accrual) Accrued() models.Money {
+	return a.accrued
+}
+
+// Capitalise returns the accrued interest rounded to the currency's minor
+// unit and keeps the rounding residue for the next capitalisation
+func (a *Accrual) Capitalise() models.Money {
+	interest := a.accrued.Round()
+	a.accrued.Amount = a.accrued.Amount.Sub(interest.Amount)
+	return interest
+}
+
+// Capitalisation is interest added to the balance at the end of a month
+type Capitalisation struct {
+	Date     time.Time
+	Interest models.Money
+	Balance  models.Money
+}
+
+// Projection is the outcome of accruing interest over a number of days
+type Projection struct {
+	Capitalisations []Capitalisation
+
+	// Interest accrued since the last capitalisation, unrounded
+	Accrued models.Money
+
+	// Balance after the last capitalisation
+	Balance models.Money
+}
+
+// Project accrues interest daily on balance for the given number of days
+// from start, capitalising at the end of each month, assuming no other
+// transactions
+func (c Calculator) Project(balance models.Money, start time.Time, days int) Projection {
+	accrual := c.NewAccrual(balance.Currency)
+	projection := Projection{Balance: balance}
+	date := startOfDay(start)
+	for i := 0; i < days; i++ {
+		// AccrueDay cannot fail: the accrual shares the balance's currency
+		accrual.AccrueDay(projection.Balance, date)
+		if IsMonthEnd(date) {
+			interest := accrual.Capitalise()
+			projection.Balance.Amount = projection.Balance.Amount.Add(interest.Amount)
+			projection.Capitalisations = append(projection.Capitalisations, Capitalisation{
+				Date:     date,
+				Interest: interest,
+				Balance:  projection.Balance,
+			})
+		}
+		date = date.AddDate(0, 0, 1)
+	}
+	projection.Accrued = accrual.Accrued()
+	return projection
+}
+
+// IsMonthEnd reports whether t falls on the last day of its month
+func IsMonthEnd(t time.Time) bool {
+	return t.AddDate(0, 0, 1).Day() == 1
+}
@@ -0,0 +1,212 @@
+package interest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/shopspring/decimal"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func money(amount, currency string) models.Money {
+	m, err := models.NewMoneyFromString(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func TestDayCount_Days(t *testing.T) {
+	tests := []struct {
+		dayCount   DayCount
+		start, end time.Time
+		want       int
+	}{
+		{Actual365, date(2024, time.January, 31), date(2024, time.March, 1), 30},
+		{Actual360, date(2024, time.January, 31), date(2024, time.March, 1), 30},
+		{Thirty360, date(2024, time.January, 31), date(2024, time.March, 1), 31},
+		{Thirty360, date(2024, time.January, 30), date(2024, time.March, 31), 60},
+		{Thirty360, date(2024, time.January, 15), date(2025, time.January, 15), 360},
+		{Actual365, date(2024, time.January, 15), date(2025, time.January, 15), 366},
+	}
+
+	for _, tt := range tests {
+		if got := tt.dayCount.Days(tt.start, tt.end); got != tt.want {
+			t.Errorf("%s Days(%s, %s) = %d, want %d", tt.dayCount, tt.start.Format(time.DateOnly), tt.end.Format(time.DateOnly), got, tt.want)
+		}
+	}
+
+	if got := Actual360.YearFraction(date(2024, time.January, 1), date(2024, time.March, 31)); !got.Equal(decimal.RequireFromString("0.25")) {
+		t.Errorf("ACT/360 YearFraction over 90 days = %s, want 0.25", got)
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	valid := map[string]Period{
+		"P1D":   {Days: 1},
+		"P2W":   {Days: 14},
+		"P1M":   {Months: 1},
+		"P1Y6M": {Years: 1, Months: 6},
+	}
+	for s, want := range valid {
+		got, err := ParsePeriod(s)
+		if err != nil || got != want {
+			t.Errorf("ParsePeriod(%q) = %+v, %v, want %+v", s, got, err, want)
+		}
+	}
+
+	for _, s := range []string{"", "P", "P0D", "PT1H", "1M", "P1.5M"} {
+		if _, err := ParsePeriod(s); err == nil {
+			t.Errorf("ParsePeriod(%q) succeeded, want error", s)
+		}
+	}
+}
+
+func TestPeriod_Occurrence(t *testing.T) {
+	monthly := Period{Months: 1}
+	start := date(2024, time.January, 31)
+	want := []time.Time{
+		date(2024, time.January, 31),
+		date(2024, time.February, 29),
+		date(2024, time.March, 31),
+		date(2024, time.April, 30),
+	}
+	for n, w := range want {
+		if got := monthly.Occurrence(start, n); !got.Equal(w) {
+			t.Errorf("Occurrence(%d) = %s, want %s", n, got.Format(time.DateOnly), w.Format(time.DateOnly))
+		}
+	}
+
+	if got := monthly.Next(start, date(2030, time.June, 1)); !got.Equal(date(2030, time.June, 30)) {
+		t.Errorf("Next = %s, want 2030-06-30", got.Format(time.DateOnly))
+	}
+}
+
+func TestAnnualInterest_Tiers(t *testing.T) {
+	rate := decimal.RequireFromString
+	lower, upper := rate("10000"), rate("250000")
+	tiers := func(method models.RateApplicationMethod) []Band {
+		return []Band{
+			{Rate: rate("0.025"), Tier: &models.RateTier{UnitOfMeasure: models.TierUnitDollar, MinimumValue: rate("0"), MaximumValue: &lower, RateApplicationMethod: method}},
+			{Rate: rate("0.045"), Tier: &models.RateTier{UnitOfMeasure: models.TierUnitDollar, MinimumValue: lower, MaximumValue: &upper, RateApplicationMethod: method}},
+			{Rate: rate("0.01"), Tier: &models.RateTier{UnitOfMeasure: models.TierUnitDollar, MinimumValue: upper, RateApplicationMethod: method}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		bands   []Band
+		balance string
+		want    string
+	}{
+		{"per tier within first", tiers(models.RateApplicationPerTier), "5000", "125"},
+		{"per tier across two", tiers(models.RateApplicationPerTier), "15420.50", "493.9225"},
+		{"per tier across three", tiers(models.RateApplicationPerTier), "300000", "11550"},
+		{"whole balance", tiers(models.RateApplicationWholeBalance), "15420.50", "693.9225"},
+		{"debit balance", tiers(models.RateApplicationPerTier), "-15420.50", "-493.9225"},
+		{"untiered with bonus", []Band{{Rate: rate("0.02")}, {Rate: rate("0.005")}}, "1000", "25"},
+		{"month tier ignores balance", []Band{{Rate: rate("0.0475"), Tier: &models.RateTier{UnitOfMeasure: models.TierUnitMonth, MinimumValue: rate("12"), MaximumValue: &upper}}}, "1000", "47.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AnnualInterest(tt.bands, money(tt.balance, "AUD"))
+			if !got.Equal(money(tt.want, "AUD")) {
+				t.Errorf("AnnualInterest(%s) = %s, want %s", tt.balance, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculator_Project(t *testing.T) {
+	// 3.65% ACT/365 on 10,000 earns exactly 1.00 a day
+	calculator := Calculator{
+		Bands:    []Band{{Rate: decimal.RequireFromString("0.0365")}},
+		DayCount: Actual365,
+	}
+
+	projection := calculator.Project(money("10000.00", "AUD"), date(2024, time.January, 1), 60)
+
+	if len(projection.Capitalisations) != 2 {
+		t.Fatalf("got %d capitalisations, want 2", len(projection.Capitalisations))
+	}
+	january, february := projection.Capitalisations[0], projection.Capitalisations[1]
+	if !january.Date.Equal(date(2024, time.January, 31)) || !january.Interest.Equal(money("31", "AUD")) {
+		t.Errorf("January = %s on %s, want 31 AUD on 2024-01-31", january.Interest, january.Date.Format(time.DateOnly))
+	}
+	// February compounds January's interest: 29 days at 1.0031 = 29.0899
+	if !february.Date.Equal(date(2024, time.February, 29)) || !february.Interest.Equal(money("29.09", "AUD")) {
+		t.Errorf("February = %s on %s, want 29.09 AUD on 2024-02-29", february.Interest, february.Date.Format(time.DateOnly))
+	}
+	if !projection.Balance.Equal(money("10060.09", "AUD")) {
+		t.Errorf("Balance = %s, want 10060.09 AUD", projection.Balance)
+	}
+	// The rounding residue carries forward rather than being lost
+	if !projection.Accrued.Equal(money("-0.0001", "AUD")) {
+		t.Errorf("Accrued = %s, want -0.0001 AUD", projection.Accrued)
+	}
+}
+
+func TestAccrual_CurrencyMismatch(t *testing.T) {
+	accrual := Calculator{DayCount: Actual365}.NewAccrual("AUD")
+	if err := accrual.AccrueDay(money("100", "USD"), date(2024, time.January, 1)); err == nil {
+		t.Error("AccrueDay with a different currency succeeded, want error")
+	}
+}
+
+func TestFeeDue(t *testing.T) {
+	monthly := models.ProductFee{Name: "Monthly account fee", Type: models.FeeTypePeriodic, AccrualFrequency: "P1M"}
+	opened := date(2024, time.January, 31)
+
+	tests := []struct {
+		fee  models.ProductFee
+		date time.Time
+		want bool
+	}{
+		{monthly, opened, false},
+		{monthly, date(2024, time.February, 29), true},
+		{monthly, date(2024, time.March, 29), false},
+		{monthly, date(2024, time.March, 31), true},
+		{models.ProductFee{Type: models.FeeTypeEvent}, date(2024, time.February, 29), false},
+	}
+
+	for _, tt := range tests {
+		got, err := FeeDue(tt.fee, opened, tt.date)
+		if err != nil {
+			t.Fatalf("FeeDue(%s) error: %v", tt.date.Format(time.DateOnly), err)
+		}
+		if got != tt.want {
+			t.Errorf("FeeDue(%s) = %v, want %v", tt.date.Format(time.DateOnly), got, tt.want)
+		}
+	}
+
+	if _, err := FeeDue(models.ProductFee{Type: models.FeeTypePeriodic, AccrualFrequency: "monthly"}, opened, opened); err == nil {
+		t.Error("FeeDue with an invalid frequency succeeded, want error")
+	}
+}
+
+func TestFeeAmount(t *testing.T) {
+	fixed := money("5.00", "AUD")
+	rate := decimal.RequireFromString("0.03")
+
+	got, err := FeeAmount(models.ProductFee{Amount: &fixed}, money("100", "AUD"))
+	if err != nil || !got.Equal(money("-5", "AUD")) {
+		t.Errorf("fixed fee = %s, %v, want -5 AUD", got, err)
+	}
+
+	got, err = FeeAmount(models.ProductFee{TransactionRate: &rate}, money("-45.55", "AUD"))
+	if err != nil || !got.Equal(money("-1.37", "AUD")) {
+		t.Errorf("transaction rate fee = %s, %v, want -1.37 AUD", got, err)
+	}
+
+	if _, err := FeeAmount(models.ProductFee{Amount: &fixed}, money("100", "USD")); err == nil {
+		t.Error("fixed fee in another currency succeeded, want error")
+	}
+	if _, err := FeeAmount(models.ProductFee{Name: "Break cost", Type: models.FeeTypeVariable}, money("100", "AUD")); err == nil {
+		t.Error("variable fee without an amount succeeded, want error")
+	}
+}
@@ -0,0 +1,89 @@
+package interest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// Period is a calendar period parsed from an ISO 8601 duration, such as a
+// product's fee accrual or interest application frequency
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// periodPattern matches date-only ISO 8601 durations; time components have
+// no meaning for daily accrual
+var periodPattern = regexp.MustCompile(`^P(?:([0-9]+)Y)?(?:([0-9]+)M)?(?:([0-9]+)W)?(?:([0-9]+)D)?$`)
+
+// ParsePeriod parses an ISO 8601 duration such as P1D, P1M or P1Y. Weeks
+// are converted to days. Durations with a time component are rejected.
+func ParsePeriod(s string) (Period, error) {
+	match := periodPattern.FindStringSubmatch(s)
+	if match == nil || s == "P" {
+		return Period{}, fmt.Errorf("invalid period %q: must be an ISO 8601 duration of years, months, weeks or days", s)
+	}
+
+	var parts [4]int
+	for i, part := range match[1:] {
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return Period{}, fmt.Errorf("invalid period %q: %w", s, err)
+		}
+		parts[i] = n
+	}
+
+	period := Period{Years: parts[0], Months: parts[1], Days: 7*parts[2] + parts[3]}
+	if period.IsZero() {
+		return Period{}, fmt.Errorf("invalid period %q: must not be zero", s)
+	}
+	return period, nil
+}
+
+// IsZero reports whether the period has no length
+func (p Period) IsZero() bool {
+	return p.Years == 0 && p.Months == 0 && p.Days == 0
+}
+
+// Occurrence returns the date n periods after start. Days past the end of a
+// shorter month fall on its last day, so monthly periods from 31 January run
+// 29 February, 31 March, 30 April.
+func (p Period) Occurrence(start time.Time, n int) time.Time {
+	year, month, day := start.Date()
+	first := time.Date(year+n*p.Years, month+time.Month(n*p.Months), 1, 0, 0, 0, 0, start.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day, last)-1+n*p.Days)
+}
+
+// Next returns the first occurrence of the period from start that falls on
+// or after from
+func (p Period) Next(start, from time.Time) time.Time {
+	from = startOfDay(from.In(start.Location()))
+	start = startOfDay(start)
+
+	// Step from an estimate rather than from start for long-running
+	// schedules; the longest possible period length keeps it an underestimate
+	n := 0
+	if days := civilDays(from) - civilDays(start); days > 0 {
+		longest := 366*p.Years + 31*p.Months + p.Days
+		n = max(days/longest-1, 0)
+	}
+	for {
+		next := p.Occurrence(start, n)
+		if !next.Before(from) {
+			return next
+		}
+		n++
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
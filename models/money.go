@@ -3,6 +3,7 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/shopspring/decimal"
@@ -10,79 +11,110 @@ import (
 
 // Money represents a monetary amount with currency using decimal arithmetic
 // to avoid floating-point precision errors in financial calculations.
+//
+// Money is an immutable value type: every operation returns a new Money and
+// never modifies its receiver, so it is safe to copy and share. Only the
+// decoding methods (UnmarshalJSON, UnmarshalText, Scan) take a
+// pointer receiver.
 type Money struct {
 	Amount   decimal.Decimal `json:"amount"`
 	Currency string          `json:"currency"`
 }
 
 // NewMoney creates a new Money instance with validation
-func NewMoney(amount decimal.Decimal, currency string) (*Money, error) {
+func NewMoney(amount decimal.Decimal, currency string) (Money, error) {
 	if err := validateCurrency(currency); err != nil {
-		return nil, err
+		return Money{}, err
 	}
-	return &Money{
+	return Money{
 		Amount:   amount,
 		Currency: strings.ToUpper(currency),
 	}, nil
 }
 
 // NewMoneyFromString creates Money from string amount and currency
-func NewMoneyFromString(amount, currency string) (*Money, error) {
+func NewMoneyFromString(amount, currency string) (Money, error) {
 	dec, err := decimal.NewFromString(amount)
 	if err != nil {
-		return nil, fmt.Errorf("invalid amount: %w", err)
+		return Money{}, fmt.Errorf("invalid amount: %w", err)
 	}
 	return NewMoney(dec, currency)
 }
 
+// NewMoneyFromMinorUnits creates Money from an integer amount of minor units
+// (e.g. cents for AUD, yen for JPY, fils for KWD) as sent by providers such as Plaid and OBIE
+func NewMoneyFromMinorUnits(units int64, currency string) (Money, error) {
+	exponent, err := CurrencyExponent(currency)
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(decimal.New(units, int32(-exponent)), currency)
+}
+
 // Add returns a new Money instance with the sum of two amounts
-func (m *Money) Add(other *Money) (*Money, error) {
+func (m Money) Add(other Money) (Money, error) {
 	if m.Currency != other.Currency {
-		return nil, fmt.Errorf("currency mismatch: %s != %s", m.Currency, other.Currency)
+		return Money{}, fmt.Errorf("currency mismatch: %s != %s", m.Currency, other.Currency)
 	}
-	return &Money{
+	return Money{
 		Amount:   m.Amount.Add(other.Amount),
 		Currency: m.Currency,
 	}, nil
 }
 
 // Subtract returns a new Money instance with the difference
-func (m *Money) Subtract(other *Money) (*Money, error) {
+func (m Money) Subtract(other Money) (Money, error) {
 	if m.Currency != other.Currency {
-		return nil, fmt.Errorf("currency mismatch: %s != %s", m.Currency, other.Currency)
+		return Money{}, fmt.Errorf("currency mismatch: %s != %s", m.Currency, other.Currency)
 	}
-	return &Money{
+	return Money{
 		Amount:   m.Amount.Sub(other.Amount),
 		Currency: m.Currency,
 	}, nil
 }
 
 // Multiply returns a new Money instance multiplied by a decimal factor
-func (m *Money) Multiply(factor decimal.Decimal) *Money {
-	return &Money{
+func (m Money) Multiply(factor decimal.Decimal) Money {
+	return Money{
 		Amount:   m.Amount.Mul(factor),
 		Currency: m.Currency,
 	}
 }
 
 // Divide returns a new Money instance divided by a decimal divisor
-func (m *Money) Divide(divisor decimal.Decimal) (*Money, error) {
+func (m Money) Divide(divisor decimal.Decimal) (Money, error) {
 	if divisor.IsZero() {
-		return nil, fmt.Errorf("division by zero")
+		return Money{}, fmt.Errorf("division by zero")
 	}
-	return &Money{
+	return Money{
 		Amount:   m.Amount.Div(divisor),
 		Currency: m.Currency,
 	}, nil
 }
 
+// Negate returns a new Money instance with the sign of the amount flipped
+func (m Money) Negate() Money {
+	return Money{
+		Amount:   m.Amount.Neg(),
+		Currency: m.Currency,
+	}
+}
+
+// Abs returns a new Money instance with the absolute amount
+func (m Money) Abs() Money {
+	return Money{
+		Amount:   m.Amount.Abs(),
+		Currency: m.Currency,
+	}
+}
+
 // Equal checks if two Money instances are equal
-func (m *Money) Equal(other *Money) bool {
+func (m Money) Equal(other Money) bool {
 	return m.Currency == other.Currency && m.Amount.Equal(other.Amount)
 }
 
 // GreaterThan checks if this Money is greater than another
-func (m *Money) GreaterThan(other *Money) (bool, error) {
+func (m Money) GreaterThan(other Money) (bool, error) {
 	if m.Currency != other.Currency {
 		return false, fmt.Errorf("currency mismatch: %s != %s", m.Currency, other.Currency)
 	}
@@ -90,7 +122,7 @@ func (m *Money) GreaterThan(other *Money) (bool, error) {
 }
 
 // LessThan checks if this Money is less than another
-func (m *Money) LessThan(other *Money) (bool, error) {
+func (m Money) LessThan(other Money) (bool, error) {
 	if m.Currency != other.Currency {
 		return false, fmt.Errorf("currency mismatch: %s != %s", m.Currency, other.Currency)
 	}
@@ -98,22 +130,57 @@ func (m *Money) LessThan(other *Money) (bool, error) {
 }
 
 // IsZero checks if the amount is zero
-func (m *Money) IsZero() bool {
+func (m Money) IsZero() bool {
 	return m.Amount.IsZero()
 }
 
 // IsNegative checks if the amount is negative
-func (m *Money) IsNegative() bool {
+func (m Money) IsNegative() bool {
 	return m.Amount.IsNegative()
 }
 
+// Exponent returns the number of minor unit digits for the Money currency
+func (m Money) Exponent() int {
+	exponent, err := CurrencyExponent(m.Currency)
+	if err != nil {
+		return 2
+	}
+	return exponent
+}
+
+// Round returns a new Money instance rounded to the minor unit of its currency
+func (m Money) Round() Money {
+	return Money{
+		Amount:   m.Amount.Round(int32(m.Exponent())),
+		Currency: m.Currency,
+	}
+}
+
+// MinorUnits returns the amount as an integer number of minor units.
+// It returns an error if the amount has more precision than the currency allows
+// (call Round first) or does not fit in an int64.
+func (m Money) MinorUnits() (int64, error) {
+	exponent, err := CurrencyExponent(m.Currency)
+	if err != nil {
+		return 0, err
+	}
+	units := m.Amount.Shift(int32(exponent))
+	if !units.IsInteger() {
+		return 0, fmt.Errorf("amount %s has more than %d decimal places for %s", m.Amount.String(), exponent, m.Currency)
+	}
+	if units.GreaterThan(decimal.NewFromInt(math.MaxInt64)) || units.LessThan(decimal.NewFromInt(math.MinInt64)) {
+		return 0, fmt.Errorf("amount %s overflows minor units for %s", m.Amount.String(), m.Currency)
+	}
+	return units.IntPart(), nil
+}
+
 // String returns a human-readable representation
-func (m *Money) String() string {
+func (m Money) String() string {
 	return fmt.Sprintf("%s %s", m.Amount.String(), m.Currency)
 }
 
 // MarshalJSON implements json.Marshaler to ensure precision
-func (m *Money) MarshalJSON() ([]byte, error) {
+func (m Money) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
 		Amount   string `json:"amount"`
 		Currency string `json:"currency"`
@@ -147,16 +214,19 @@ func (m *Money) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// validateCurrency checks if the currency code is valid ISO 4217
-func validateCurrency(currency string) error {
-	currency = strings.ToUpper(currency)
-	validCurrencies := map[string]bool{
-		"AUD": true, "USD": true, "GBP": true, "EUR": true, "CAD": true,
-		"JPY": true, "CHF": true, "CNY": true, "SEK": true, "NZD": true,
+// ParseMoney parses the text form produced by String, e.g. "123.45 AUD"
+func ParseMoney(s string) (Money, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Money{}, fmt.Errorf("invalid money %q: expected \"<amount> <currency>\"", s)
 	}
-	
-	if !validCurrencies[currency] {
-		return fmt.Errorf("invalid currency code: %s", currency)
+	return NewMoneyFromString(fields[0], fields[1])
+}
+
+// validateCurrency checks if the currency code is a monetary ISO 4217 code
+func validateCurrency(currency string) error {
+	if _, err := CurrencyExponent(currency); err != nil {
+		return err
 	}
 	return nil
-}
\ No newline at end of file
+}
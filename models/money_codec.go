@@ -0,0 +1,214 @@
+package models
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// MarshalText implements encoding.TextMarshaler using the "<amount> <currency>" form
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (m *Money) UnmarshalText(text []byte) error {
+	parsed, err := ParseMoney(string(text))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, storing Money as "<amount> <currency>" text
+// so a single column round-trips without precision loss
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan implements sql.Scanner for values written by Value
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return m.UnmarshalText([]byte(v))
+	case []byte:
+		return m.UnmarshalText(v)
+	case nil:
+		return fmt.Errorf("cannot scan NULL into Money")
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+}
+
+// BSON type codes used by the Money codec
+const (
+	bsonString     byte = 0x02
+	bsonDocument   byte = 0x03
+	bsonDecimal128 byte = 0x13
+)
+
+// Decimal128 limits: 34 significant digits and a biased 14-bit exponent
+var (
+	maxDecimal128Coefficient = new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(34), nil), big.NewInt(1))
+	uint64Mask               = new(big.Int).SetUint64(^uint64(0))
+)
+
+const (
+	decimal128ExponentBias = 6176
+	decimal128MaxExponent  = 6111
+)
+
+var errInvalidBSONMoney = errors.New("invalid BSON document for Money")
+
+// MarshalBSONValue implements bson.ValueMarshaler from the MongoDB Go driver
+// (v2), storing Money as the embedded document {amount: Decimal128, currency:
+// string} so amounts keep their precision and stay numeric in queries
+func (m Money) MarshalBSONValue() (byte, []byte, error) {
+	amount, err := encodeDecimal128(m.Amount)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	doc := make([]byte, 4, 48)
+	doc = append(doc, bsonDecimal128)
+	doc = append(doc, "amount\x00"...)
+	doc = append(doc, amount...)
+	doc = append(doc, bsonString)
+	doc = append(doc, "currency\x00"...)
+	doc = binary.LittleEndian.AppendUint32(doc, uint32(len(m.Currency)+1))
+	doc = append(doc, m.Currency...)
+	doc = append(doc, 0, 0)
+	binary.LittleEndian.PutUint32(doc, uint32(len(doc)))
+	return bsonDocument, doc, nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler for documents written by
+// MarshalBSONValue. The amount may also be stored as a decimal string.
+func (m *Money) UnmarshalBSONValue(typ byte, data []byte) error {
+	if typ != bsonDocument {
+		return fmt.Errorf("cannot decode BSON type 0x%02x into Money", typ)
+	}
+	if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data) || data[len(data)-1] != 0 {
+		return errInvalidBSONMoney
+	}
+
+	var amount *decimal.Decimal
+	var currency string
+	elements := data[4 : len(data)-1]
+	for len(elements) > 0 {
+		elementType := elements[0]
+		nameEnd := bytes.IndexByte(elements[1:], 0)
+		if nameEnd < 0 {
+			return errInvalidBSONMoney
+		}
+		name := string(elements[1 : 1+nameEnd])
+		elements = elements[2+nameEnd:]
+
+		switch elementType {
+		case bsonDecimal128:
+			if len(elements) < 16 {
+				return errInvalidBSONMoney
+			}
+			value, err := decodeDecimal128(elements[:16])
+			if err != nil {
+				return err
+			}
+			elements = elements[16:]
+			if name == "amount" {
+				amount = &value
+			}
+		case bsonString:
+			value, size, err := decodeBSONString(elements)
+			if err != nil {
+				return err
+			}
+			elements = elements[size:]
+			switch name {
+			case "amount":
+				parsed, err := decimal.NewFromString(value)
+				if err != nil {
+					return fmt.Errorf("invalid amount: %w", err)
+				}
+				amount = &parsed
+			case "currency":
+				currency = value
+			}
+		default:
+			return fmt.Errorf("unexpected BSON type 0x%02x for Money field %q", elementType, name)
+		}
+	}
+	if amount == nil {
+		return fmt.Errorf("%w: missing amount", errInvalidBSONMoney)
+	}
+
+	parsed, err := NewMoney(*amount, currency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// encodeDecimal128 encodes d as an IEEE 754-2008 decimal128 (BID) value in
+// BSON's little-endian byte order
+func encodeDecimal128(d decimal.Decimal) ([]byte, error) {
+	coefficient := d.Coefficient()
+	negative := coefficient.Sign() < 0
+	coefficient.Abs(coefficient)
+	exponent := int(d.Exponent())
+	if coefficient.Cmp(maxDecimal128Coefficient) > 0 || exponent < -decimal128ExponentBias || exponent > decimal128MaxExponent {
+		return nil, fmt.Errorf("amount %s does not fit in a BSON Decimal128", d.String())
+	}
+
+	low := new(big.Int).And(coefficient, uint64Mask).Uint64()
+	high := new(big.Int).Rsh(coefficient, 64).Uint64()
+	high |= uint64(exponent+decimal128ExponentBias) << 49
+	if negative {
+		high |= 1 << 63
+	}
+
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint64(b[:8], low)
+	binary.LittleEndian.PutUint64(b[8:], high)
+	return b, nil
+}
+
+// decodeDecimal128 decodes a finite decimal128 value written by encodeDecimal128
+// or by MongoDB
+func decodeDecimal128(b []byte) (decimal.Decimal, error) {
+	low := binary.LittleEndian.Uint64(b[:8])
+	high := binary.LittleEndian.Uint64(b[8:])
+	// Combination bits 11 mark infinity, NaN or a coefficient above 34 digits
+	if high>>61&3 == 3 {
+		return decimal.Decimal{}, errors.New("cannot decode infinite, NaN or non-canonical Decimal128 into Money")
+	}
+
+	exponent := int32(high>>49&0x3fff) - decimal128ExponentBias
+	coefficient := new(big.Int).SetUint64(high & (1<<49 - 1))
+	coefficient.Lsh(coefficient, 64).Or(coefficient, new(big.Int).SetUint64(low))
+	if coefficient.Cmp(maxDecimal128Coefficient) > 0 {
+		return decimal.Decimal{}, errors.New("cannot decode non-canonical Decimal128 into Money")
+	}
+	if high>>63 == 1 {
+		coefficient.Neg(coefficient)
+	}
+	return decimal.NewFromBigInt(coefficient, exponent), nil
+}
+
+// decodeBSONString decodes a BSON string value, returning it and its encoded size
+func decodeBSONString(b []byte) (string, int, error) {
+	if len(b) < 5 {
+		return "", 0, errInvalidBSONMoney
+	}
+	length := int(binary.LittleEndian.Uint32(b))
+	if length < 1 || 4+length > len(b) || b[3+length] != 0 {
+		return "", 0, errInvalidBSONMoney
+	}
+	return string(b[4 : 3+length]), 4 + length, nil
+}
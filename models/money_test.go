@@ -1,7 +1,9 @@
 package models
 
 import (
+	"bytes"
 	"encoding/json"
+	"math/rand"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -186,4 +188,418 @@ func TestMoney_Comparison(t *testing.T) {
 	if !less {
 		t.Error("LessThan() should return true")
 	}
-}
\ No newline at end of file
+}
+func TestMoney_MinorUnits(t *testing.T) {
+	tests := []struct {
+		name     string
+		units    int64
+		currency string
+		amount   string
+	}{
+		{name: "AUD cents", units: 12345, currency: "AUD", amount: "123.45"},
+		{name: "JPY has no minor unit", units: 12345, currency: "JPY", amount: "12345"},
+		{name: "KWD fils", units: 12345, currency: "KWD", amount: "12.345"},
+		{name: "CLF four decimals", units: 12345, currency: "CLF", amount: "1.2345"},
+		{name: "negative GBP pence", units: -99, currency: "GBP", amount: "-0.99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			money, err := NewMoneyFromMinorUnits(tt.units, tt.currency)
+			if err != nil {
+				t.Fatalf("NewMoneyFromMinorUnits() error = %v", err)
+			}
+			if !money.Amount.Equal(decimal.RequireFromString(tt.amount)) {
+				t.Errorf("NewMoneyFromMinorUnits() amount = %v, want %v", money.Amount, tt.amount)
+			}
+
+			units, err := money.MinorUnits()
+			if err != nil {
+				t.Fatalf("MinorUnits() error = %v", err)
+			}
+			if units != tt.units {
+				t.Errorf("MinorUnits() = %d, want %d", units, tt.units)
+			}
+		})
+	}
+
+	// Sub-minor precision must be rounded explicitly
+	money, _ := NewMoneyFromString("10.005", "AUD")
+	if _, err := money.MinorUnits(); err == nil {
+		t.Error("MinorUnits() should return error for sub-cent AUD amount")
+	}
+	units, err := money.Round().MinorUnits()
+	if err != nil || units != 1001 {
+		t.Errorf("Round().MinorUnits() = %d, %v, want 1001", units, err)
+	}
+}
+
+func TestMoney_Round(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		want     string
+	}{
+		{"100.456", "AUD", "100.46"},
+		{"100.5", "JPY", "101"},
+		{"1.23456", "BHD", "1.235"},
+		{"-2.345", "USD", "-2.35"},
+	}
+
+	for _, tt := range tests {
+		money, _ := NewMoneyFromString(tt.amount, tt.currency)
+		got := money.Round()
+		if !got.Amount.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("Round(%s %s) = %v, want %v", tt.amount, tt.currency, got.Amount, tt.want)
+		}
+	}
+}
+
+func TestCurrencyRegistry(t *testing.T) {
+	jpy, ok := LookupCurrency("jpy")
+	if !ok || jpy.Exponent != 0 || jpy.Numeric != "392" || !jpy.IsActive() {
+		t.Errorf("LookupCurrency(jpy) = %+v, %v", jpy, ok)
+	}
+
+	aud, ok := LookupCurrencyByNumeric("36")
+	if !ok || aud.Code != "AUD" {
+		t.Errorf("LookupCurrencyByNumeric(36) = %+v, %v", aud, ok)
+	}
+
+	// XCG replaced ANG under the same numeric code
+	xcg, ok := LookupCurrencyByNumeric("532")
+	if !ok || xcg.Code != "XCG" {
+		t.Errorf("LookupCurrencyByNumeric(532) = %+v, want active XCG", xcg)
+	}
+
+	hrk, ok := LookupCurrency("HRK")
+	if !ok || hrk.IsActive() {
+		t.Errorf("LookupCurrency(HRK) = %+v, want historic", hrk)
+	}
+
+	// Non-monetary codes are registered but cannot denominate Money
+	if _, err := NewMoney(decimal.NewFromInt(1), "XAU"); err == nil {
+		t.Error("NewMoney() should reject XAU")
+	}
+	if _, err := NewMoney(decimal.NewFromInt(1), "SGD"); err != nil {
+		t.Errorf("NewMoney() SGD error = %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, c := range Currencies() {
+		if seen[c.Code] {
+			t.Errorf("duplicate currency %s", c.Code)
+		}
+		seen[c.Code] = true
+		if len(c.Code) != 3 || len(c.Numeric) != 3 || !c.Status.IsValid() {
+			t.Errorf("malformed registry entry %+v", c)
+		}
+	}
+}
+
+func TestMoney_RoundWith(t *testing.T) {
+	tests := []struct {
+		amount string
+		mode   RoundingMode
+		want   string
+	}{
+		{"2.345", RoundHalfEven, "2.34"},
+		{"2.355", RoundHalfEven, "2.36"},
+		{"2.345", RoundHalfUp, "2.35"},
+		{"-2.345", RoundHalfUp, "-2.35"},
+		{"2.349", RoundDown, "2.34"},
+		{"-2.349", RoundDown, "-2.34"},
+	}
+
+	for _, tt := range tests {
+		money, _ := NewMoneyFromString(tt.amount, "AUD")
+		got := money.RoundWith(tt.mode)
+		if !got.Amount.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("RoundWith(%s, %s) = %v, want %v", tt.amount, tt.mode, got.Amount, tt.want)
+		}
+	}
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	money, _ := NewMoneyFromString("100.00", "AUD")
+
+	parts, err := money.Allocate(1, 1, 1)
+	if err != nil {
+		t.Fatalf("Allocate() error = %v", err)
+	}
+	want := []string{"33.34", "33.33", "33.33"}
+	for i, part := range parts {
+		if !part.Amount.Equal(decimal.RequireFromString(want[i])) {
+			t.Errorf("Allocate()[%d] = %v, want %v", i, part.Amount, want[i])
+		}
+	}
+
+	parts, _ = money.Allocate(70, 20, 10)
+	want = []string{"70", "20", "10"}
+	for i, part := range parts {
+		if !part.Amount.Equal(decimal.RequireFromString(want[i])) {
+			t.Errorf("Allocate(70,20,10)[%d] = %v, want %v", i, part.Amount, want[i])
+		}
+	}
+
+	if _, err := money.Allocate(); err == nil {
+		t.Error("Allocate() should return error without ratios")
+	}
+	if _, err := money.Allocate(0, 0); err == nil {
+		t.Error("Allocate() should return error for zero ratios")
+	}
+	if _, err := money.Allocate(1, -1); err == nil {
+		t.Error("Allocate() should return error for negative ratio")
+	}
+	unrounded, _ := NewMoneyFromString("1.005", "AUD")
+	if _, err := unrounded.Allocate(1, 1); err == nil {
+		t.Error("Allocate() should return error for sub-cent amount")
+	}
+}
+
+func TestMoney_AllocateProperties(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	currencies := []string{"AUD", "JPY", "KWD", "USD"}
+
+	for i := 0; i < 1000; i++ {
+		currency := currencies[rng.Intn(len(currencies))]
+		money, _ := NewMoneyFromMinorUnits(rng.Int63n(2_000_000)-1_000_000, currency)
+
+		ratios := make([]int, 1+rng.Intn(7))
+		for j := range ratios {
+			ratios[j] = rng.Intn(10)
+		}
+		ratios[rng.Intn(len(ratios))]++
+
+		parts, err := money.Allocate(ratios...)
+		if err != nil {
+			t.Fatalf("Allocate(%v, %v) error = %v", money, ratios, err)
+		}
+
+		// Parts sum exactly to the original amount
+		sum := decimal.Zero
+		for _, part := range parts {
+			sum = sum.Add(part.Amount)
+			if part.Currency != money.Currency {
+				t.Fatalf("Allocate() part currency = %s, want %s", part.Currency, money.Currency)
+			}
+			if _, err := part.MinorUnits(); err != nil {
+				t.Fatalf("Allocate() part %v is not in whole minor units", part)
+			}
+		}
+		if !sum.Equal(money.Amount) {
+			t.Fatalf("Allocate(%v, %v) sum = %v", money, ratios, sum)
+		}
+
+		// Each part is within one minor unit of its exact share
+		total := 0
+		for _, ratio := range ratios {
+			total += ratio
+		}
+		unit := decimal.New(1, int32(-money.Exponent()))
+		for j, part := range parts {
+			exact := money.Amount.Mul(decimal.NewFromInt(int64(ratios[j]))).Div(decimal.NewFromInt(int64(total)))
+			if part.Amount.Sub(exact).Abs().GreaterThanOrEqual(unit) {
+				t.Fatalf("Allocate(%v, %v)[%d] = %v, exact share %v", money, ratios, j, part.Amount, exact)
+			}
+		}
+	}
+}
+
+func TestMoney_SplitProperties(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+
+	for i := 0; i < 1000; i++ {
+		money, _ := NewMoneyFromMinorUnits(rng.Int63n(1_000_000)-500_000, "AUD")
+		n := 1 + rng.Intn(12)
+
+		parts, err := money.Split(n)
+		if err != nil {
+			t.Fatalf("Split(%d) error = %v", n, err)
+		}
+		if len(parts) != n {
+			t.Fatalf("Split(%d) returned %d parts", n, len(parts))
+		}
+
+		sum := decimal.Zero
+		lowest, highest := parts[0].Amount, parts[0].Amount
+		for _, part := range parts {
+			sum = sum.Add(part.Amount)
+			lowest = decimal.Min(lowest, part.Amount)
+			highest = decimal.Max(highest, part.Amount)
+		}
+		if !sum.Equal(money.Amount) {
+			t.Fatalf("Split(%v, %d) sum = %v", money, n, sum)
+		}
+		if highest.Sub(lowest).GreaterThan(decimal.RequireFromString("0.01")) {
+			t.Fatalf("Split(%v, %d) parts differ by more than one cent", money, n)
+		}
+	}
+
+	money, _ := NewMoneyFromString("10.00", "AUD")
+	if _, err := money.Split(0); err == nil {
+		t.Error("Split() should return error for zero parts")
+	}
+}
+
+func TestMoney_Format(t *testing.T) {
+	tests := []struct {
+		amount   string
+		currency string
+		locale   Locale
+		want     string
+	}{
+		{"1234.56", "USD", LocaleEnUS, "$1,234.56"},
+		{"1234.56", "EUR", LocaleDeDE, "1.234,56\u00a0€"},
+		{"1234567.891", "AUD", LocaleEnAU, "$1,234,567.89"},
+		{"-45.5", "AUD", LocaleEnAU, "-$45.50"},
+		{"1234.5", "JPY", LocaleJaJP, "¥1,235"},
+		{"999", "GBP", LocaleEnGB, "£999.00"},
+		{"1234.5", "CHF", LocaleDeCH, "CHF\u00a01’234.50"},
+		{"12.345", "KWD", LocaleEnUS, "KWD\u00a012.345"},
+		{"1234.56", "EUR", LocaleFrFR, "1\u202f234,56\u00a0€"},
+		{"1234.56", "CAD", LocaleFrCA, "1\u00a0234,56\u00a0$"},
+
+		// Dollars and yen are qualified outside their home locale
+		{"1234.56", "USD", LocaleEnAU, "US$1,234.56"},
+		{"1234.56", "AUD", LocaleEnUS, "A$1,234.56"},
+		{"1234.56", "NZD", LocaleEnAU, "NZ$1,234.56"},
+		{"1234.56", "CAD", LocaleFrFR, "1\u202f234,56\u00a0CA$"},
+		{"1234", "CNY", LocaleJaJP, "CN¥1,234.00"},
+		{"1234", "JPY", LocaleEnUS, "JP¥1,234"},
+		{"12.5", "USD", Locale{DecimalSeparator: "."}, "US$12.50"},
+	}
+
+	for _, tt := range tests {
+		money, _ := NewMoneyFromString(tt.amount, tt.currency)
+		if got := money.Format(tt.locale); got != tt.want {
+			t.Errorf("Format(%s %s, %s) = %q, want %q", tt.amount, tt.currency, tt.locale.Tag, got, tt.want)
+		}
+	}
+
+	money, _ := NewMoneyFromString("0.125", "EUR")
+	if got := money.FormatWith(LocaleFrFR, RoundHalfEven); got != "0,12\u00a0€" {
+		t.Errorf("FormatWith(half-even) = %q, want %q", got, "0,12\u00a0€")
+	}
+
+	if locale, ok := LookupLocale("de_DE"); !ok || locale.Tag != "de-DE" {
+		t.Errorf("LookupLocale(de_DE) = %+v, %v", locale, ok)
+	}
+}
+
+func TestMoney_ValueSemantics(t *testing.T) {
+	original, _ := NewMoneyFromString("100.00", "AUD")
+	copied := original
+
+	sum, _ := copied.Add(mustMoney(t, "50.00", "AUD"))
+	_ = copied.Negate()
+	_ = copied.Round()
+
+	if !original.Amount.Equal(decimal.RequireFromString("100")) || !copied.Equal(original) {
+		t.Errorf("operations modified receiver: original = %v, copied = %v", original, copied)
+	}
+	if !sum.Amount.Equal(decimal.RequireFromString("150")) {
+		t.Errorf("Add() = %v, want 150.00 AUD", sum)
+	}
+
+	// Money embedded by value still serializes through its MarshalJSON
+	data, err := json.Marshal(struct{ Amount Money }{original})
+	if err != nil || string(data) != `{"Amount":{"amount":"100","currency":"AUD"}}` {
+		t.Errorf("json.Marshal(embedded) = %s, %v", data, err)
+	}
+}
+
+func TestMoney_TextAndSQLCodecs(t *testing.T) {
+	money, _ := NewMoneyFromString("-1234.567", "KWD")
+
+	text, err := money.MarshalText()
+	if err != nil || string(text) != "-1234.567 KWD" {
+		t.Fatalf("MarshalText() = %q, %v", text, err)
+	}
+	var fromText Money
+	if err := fromText.UnmarshalText(text); err != nil || !fromText.Equal(money) {
+		t.Errorf("UnmarshalText() = %v, %v", fromText, err)
+	}
+
+	value, err := money.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	var scanned Money
+	if err := scanned.Scan(value); err != nil || !scanned.Equal(money) {
+		t.Errorf("Scan(%v) = %v, %v", value, scanned, err)
+	}
+	if err := scanned.Scan([]byte("12.50 usd")); err != nil || scanned.Currency != "USD" {
+		t.Errorf("Scan([]byte) = %v, %v", scanned, err)
+	}
+
+	for _, invalid := range []interface{}{nil, 12.5, "12.50", "abc AUD", "1.00 XXX"} {
+		var m Money
+		if err := m.Scan(invalid); err == nil {
+			t.Errorf("Scan(%v) should return error", invalid)
+		}
+	}
+}
+
+func TestMoney_BSONCodec(t *testing.T) {
+	for _, amount := range []string{"-1234.567", "0", "1", "0.0001", "9999999999999999999999999999999999"} {
+		money := mustMoney(t, amount, "KWD")
+		typ, data, err := money.MarshalBSONValue()
+		if err != nil || typ != bsonDocument {
+			t.Fatalf("MarshalBSONValue(%s) = 0x%02x, %v", amount, typ, err)
+		}
+		var decoded Money
+		if err := decoded.UnmarshalBSONValue(typ, data); err != nil || !decoded.Equal(money) {
+			t.Errorf("UnmarshalBSONValue(%s) = %v, %v", amount, decoded, err)
+		}
+	}
+
+	// 1 is the Decimal128 0x3040000000000000_0000000000000001
+	_, data, _ := mustMoney(t, "1", "AUD").MarshalBSONValue()
+	want := []byte{0x13, 'a', 'm', 'o', 'u', 'n', 't', 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x40, 0x30}
+	if !bytes.Equal(data[4:4+len(want)], want) {
+		t.Errorf("amount element = % x, want % x", data[4:4+len(want)], want)
+	}
+
+	// Amounts stored as decimal strings are accepted too
+	stringAmount := []byte{0, 0, 0, 0,
+		0x02, 'a', 'm', 'o', 'u', 'n', 't', 0, 6, 0, 0, 0, '1', '2', '.', '5', '0', 0,
+		0x02, 'c', 'u', 'r', 'r', 'e', 'n', 'c', 'y', 0, 4, 0, 0, 0, 'u', 's', 'd', 0,
+		0}
+	stringAmount[0] = byte(len(stringAmount))
+	var decoded Money
+	if err := decoded.UnmarshalBSONValue(bsonDocument, stringAmount); err != nil || !decoded.Equal(mustMoney(t, "12.50", "USD")) {
+		t.Errorf("UnmarshalBSONValue(string amount) = %v, %v", decoded, err)
+	}
+
+	valid := data
+	invalid := map[string]struct {
+		typ  byte
+		data []byte
+	}{
+		"not a document":   {bsonString, valid},
+		"truncated":        {bsonDocument, valid[:len(valid)-3]},
+		"missing amount":   {bsonDocument, []byte{5, 0, 0, 0, 0}},
+		"unknown currency": {bsonDocument, bytes.Replace(stringAmount, []byte("usd"), []byte("xyz"), 1)},
+	}
+	for name, tt := range invalid {
+		var m Money
+		if err := m.UnmarshalBSONValue(tt.typ, tt.data); err == nil {
+			t.Errorf("UnmarshalBSONValue(%s) should return error", name)
+		}
+	}
+
+	tooPrecise := mustMoney(t, "1.00000000000000000000000000000000001", "AUD")
+	if _, _, err := tooPrecise.MarshalBSONValue(); err == nil {
+		t.Error("MarshalBSONValue() should reject amounts beyond 34 digits")
+	}
+}
+
+func mustMoney(t *testing.T, amount, currency string) Money {
+	t.Helper()
+	m, err := NewMoneyFromString(amount, currency)
+	if err != nil {
+		t.Fatalf("NewMoneyFromString(%s, %s) error = %v", amount, currency, err)
+	}
+	return m
+}
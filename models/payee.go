@@ -0,0 +1,65 @@
+package models
+
+import (
+	"regexp"
+	"time"
+)
+
+// Payee represents a customer's saved payment beneficiary, aligned with the
+// CDR BankingPayee structure. Exactly one of Domestic, International and
+// Biller is set, matching Type.
+type Payee struct {
+	// Payee identification, assigned when the payee is created
+	ID           string    `json:"id"`
+	CustomerID   string    `json:"customerId"`
+	CreationDate time.Time `json:"creationDate"`
+
+	// Customer-assigned name for the payee
+	Nickname string `json:"nickname"`
+
+	// Payee classification and details
+	Type          PayeeType           `json:"type"`
+	Domestic      *DomesticPayee      `json:"domestic,omitempty"`
+	International *InternationalPayee `json:"international,omitempty"`
+	Biller        *BillerPayee        `json:"biller,omitempty"`
+}
+
+// DomesticPayee identifies an account reached through a national clearing
+// system by bank code and account number
+type DomesticPayee struct {
+	AccountName string `json:"accountName"`
+
+	// Scheme selects how BankCode is interpreted: an Australian BSB, a UK
+	// sort code or a US ABA routing number
+	Scheme        DomesticScheme `json:"scheme"`
+	BankCode      string         `json:"bankCode"`
+	AccountNumber string         `json:"accountNumber"`
+}
+
+// InternationalPayee identifies an overseas account by IBAN, or by account
+// number where the country does not use IBANs, and the bank's BIC
+type InternationalPayee struct {
+	BeneficiaryName string `json:"beneficiaryName"`
+
+	// ISO 3166-1 alpha-2 country of the beneficiary's bank
+	Country string `json:"country"`
+
+	IBAN          string `json:"iban,omitempty"`
+	AccountNumber string `json:"accountNumber,omitempty"`
+	BIC           string `json:"bic"`
+}
+
+// BillerPayee identifies a BPAY biller and the customer's reference with it
+type BillerPayee struct {
+	BillerCode string `json:"billerCode"`
+	BillerName string `json:"billerName"`
+
+	// Customer Reference Number issued by the biller
+	CRN string `json:"crn"`
+}
+
+var (
+	countryCode = regexp.MustCompile(`^[A-Z]{2}$`)
+	billerCode  = regexp.MustCompile(`^[0-9]{3,10}$`)
+	crnFormat   = regexp.MustCompile(`^[0-9]{2,20}$`)
+)
@@ -0,0 +1,100 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPayee_ValidateInput(t *testing.T) {
+	domestic := &DomesticPayee{AccountName: "J Citizen", Scheme: DomesticSchemeBSB, BankCode: "062-000", AccountNumber: "12345678"}
+
+	tests := []struct {
+		name       string
+		payee      Payee
+		wantFields []string
+	}{
+		{
+			name:  "valid domestic",
+			payee: Payee{CustomerID: "cust-001", Nickname: "Rent", Type: PayeeTypeDomestic, Domestic: domestic},
+		},
+		{
+			name: "valid international",
+			payee: Payee{CustomerID: "cust-001", Nickname: "Family", Type: PayeeTypeInternational, International: &InternationalPayee{
+				BeneficiaryName: "A Citizen", Country: "GB", IBAN: "GB82 WEST 1234 5698 7654 32", BIC: "NWBKGB2L",
+			}},
+		},
+		{
+			name:       "details for another type",
+			payee:      Payee{CustomerID: "cust-001", Nickname: "Rent", Type: PayeeTypeBiller, Domestic: domestic},
+			wantFields: []string{"biller", "domestic"},
+		},
+		{
+			name: "IBAN and BIC from another country",
+			payee: Payee{CustomerID: "cust-001", Nickname: "Family", Type: PayeeTypeInternational, International: &InternationalPayee{
+				BeneficiaryName: "A Citizen", Country: "FR", IBAN: "GB82WEST12345698765432", BIC: "NWBKGB2L",
+			}},
+			wantFields: []string{"international.iban", "international.bic"},
+		},
+		{
+			name: "bad ABA check digit",
+			payee: Payee{CustomerID: "cust-001", Nickname: "US", Type: PayeeTypeDomestic, Domestic: &DomesticPayee{
+				AccountName: "J Doe", Scheme: DomesticSchemeABA, BankCode: "021000022", AccountNumber: "000123456789",
+			}},
+			wantFields: []string{"domestic.bankCode"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payee.ValidateInput()
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("ValidateInput() = %v, want nil", err)
+				}
+				return
+			}
+			ve, ok := AsValidationError(err)
+			if !ok {
+				t.Fatalf("ValidateInput() = %v, want a ValidationError", err)
+			}
+			got := make(map[string]bool)
+			for _, f := range ve.Fields {
+				got[f.Field] = true
+			}
+			for _, field := range tt.wantFields {
+				if !got[field] {
+					t.Errorf("missing error for %s in %v", field, ve.Fields)
+				}
+			}
+			if len(ve.Fields) != len(tt.wantFields) {
+				t.Errorf("got %d field errors %v, want %v", len(ve.Fields), ve.Fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestPayee_ValidateInputOrder(t *testing.T) {
+	payee := Payee{
+		CustomerID: "cust-001",
+		Nickname:   "Mixed",
+		Type:       PayeeTypeInternational,
+		Domestic:   &DomesticPayee{AccountName: "J Citizen", Scheme: DomesticSchemeBSB, BankCode: "062-000", AccountNumber: "12345678"},
+		Biller:     &BillerPayee{},
+	}
+
+	// Details are checked in domestic, international, biller order on every call
+	want := []string{"domestic", "international", "biller"}
+	for range 20 {
+		ve, ok := AsValidationError(payee.ValidateInput())
+		if !ok {
+			t.Fatal("ValidateInput() should fail for mismatched details")
+		}
+		var got []string
+		for _, f := range ve.Fields {
+			got = append(got, f.Field)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("fields = %v, want %v", got, want)
+		}
+	}
+}
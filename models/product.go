@@ -0,0 +1,149 @@
+package models
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Product describes a banking product offered to customers, aligned with the
+// CDR BankingProductV4 structure. Rates are decimal fractions (0.0125 is
+// 1.25% p.a.) and frequencies are ISO 8601 durations such as P1D or P1M.
+type Product struct {
+	// Product identification
+	ID          string          `json:"id"`
+	Category    ProductCategory `json:"category"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Brand       string          `json:"brand"`
+
+	// Period the product is offered in; EffectiveTo is omitted while it is
+	// offered indefinitely
+	EffectiveFrom time.Time  `json:"effectiveFrom"`
+	EffectiveTo   *time.Time `json:"effectiveTo,omitempty"`
+	LastUpdated   time.Time  `json:"lastUpdated"`
+
+	// Tailored products have terms negotiated per customer, so the published
+	// rates and fees are indicative only
+	IsTailored     bool   `json:"isTailored"`
+	ApplicationURI string `json:"applicationUri,omitempty"`
+
+	// Product details
+	Features     []ProductFeature     `json:"features,omitempty"`
+	Constraints  []ProductConstraint  `json:"constraints,omitempty"`
+	Eligibility  []ProductEligibility `json:"eligibility,omitempty"`
+	Fees         []ProductFee         `json:"fees,omitempty"`
+	DepositRates []DepositRate        `json:"depositRates,omitempty"`
+	LendingRates []LendingRate        `json:"lendingRates,omitempty"`
+}
+
+// ProductFeature describes a feature of the product; AdditionalValue holds
+// the CDR value for the feature type, e.g. the number of FREE_TXNS
+type ProductFeature struct {
+	Type            ProductFeatureType `json:"featureType"`
+	AdditionalValue string             `json:"additionalValue,omitempty"`
+	AdditionalInfo  string             `json:"additionalInfo,omitempty"`
+}
+
+// ProductConstraint bounds the balance or credit limit of accounts opened
+// with the product
+type ProductConstraint struct {
+	Type           ProductConstraintType `json:"constraintType"`
+	Amount         Money                 `json:"amount"`
+	AdditionalInfo string                `json:"additionalInfo,omitempty"`
+}
+
+// ProductEligibility is a criterion applicants must meet; AdditionalValue
+// holds the CDR value for the eligibility type, e.g. the age for MIN_AGE
+type ProductEligibility struct {
+	Type            EligibilityType `json:"eligibilityType"`
+	AdditionalValue string          `json:"additionalValue,omitempty"`
+	AdditionalInfo  string          `json:"additionalInfo,omitempty"`
+}
+
+// ProductFee is a fee charged on the product. Exactly one of Amount,
+// BalanceRate and TransactionRate is set, except for VARIABLE fees, which
+// describe their calculation in AdditionalInfo.
+type ProductFee struct {
+	Name string  `json:"name"`
+	Type FeeType `json:"feeType"`
+
+	Amount          *Money           `json:"amount,omitempty"`
+	BalanceRate     *decimal.Decimal `json:"balanceRate,omitempty"`
+	TransactionRate *decimal.Decimal `json:"transactionRate,omitempty"`
+
+	// How often a PERIODIC fee is charged
+	AccrualFrequency string `json:"accrualFrequency,omitempty"`
+	AdditionalInfo   string `json:"additionalInfo,omitempty"`
+}
+
+// DepositRate is an interest rate paid on credit balances. A rate with tiers
+// applies only to balances within them; tiered products list one rate per
+// tier.
+type DepositRate struct {
+	Type DepositRateType `json:"depositRateType"`
+	Rate decimal.Decimal `json:"rate"`
+
+	// How often interest is calculated and how often it is paid
+	CalculationFrequency string `json:"calculationFrequency,omitempty"`
+	ApplicationFrequency string `json:"applicationFrequency,omitempty"`
+
+	Tiers          []RateTier `json:"tiers,omitempty"`
+	AdditionalInfo string     `json:"additionalInfo,omitempty"`
+}
+
+// LendingRate is an interest rate charged on debit balances
+type LendingRate struct {
+	Type LendingRateType `json:"lendingRateType"`
+	Rate decimal.Decimal `json:"rate"`
+
+	// Rate including fees, as required for advertised lending rates
+	ComparisonRate *decimal.Decimal `json:"comparisonRate,omitempty"`
+
+	// How often interest is calculated and how often it is charged
+	CalculationFrequency string `json:"calculationFrequency,omitempty"`
+	ApplicationFrequency string `json:"applicationFrequency,omitempty"`
+
+	Tiers          []RateTier `json:"tiers,omitempty"`
+	AdditionalInfo string     `json:"additionalInfo,omitempty"`
+}
+
+// RateTier is the range of a balance (or term) a rate applies to.
+// MaximumValue is omitted for an open-ended top tier.
+type RateTier struct {
+	Name                  string                `json:"name"`
+	UnitOfMeasure         TierUnit              `json:"unitOfMeasure"`
+	MinimumValue          decimal.Decimal       `json:"minimumValue"`
+	MaximumValue          *decimal.Decimal      `json:"maximumValue,omitempty"`
+	RateApplicationMethod RateApplicationMethod `json:"rateApplicationMethod,omitempty"`
+}
+
+// IsEffective reports whether the product is offered at t
+func (p *Product) IsEffective(t time.Time) bool {
+	if t.Before(p.EffectiveFrom) {
+		return false
+	}
+	return p.EffectiveTo == nil || t.Before(*p.EffectiveTo)
+}
+
+// Contains reports whether value falls within the tier. As in CDR, the
+// maximum is exclusive so adjacent tiers can share a bound, except for a
+// discrete tier whose maximum equals its minimum.
+func (t RateTier) Contains(value decimal.Decimal) bool {
+	if value.LessThan(t.MinimumValue) {
+		return false
+	}
+	if t.MaximumValue == nil || value.LessThan(*t.MaximumValue) {
+		return true
+	}
+	return t.MaximumValue.Equal(t.MinimumValue) && value.Equal(t.MinimumValue)
+}
+
+// isoDuration matches ISO 8601 durations such as P1D, P1M and P1Y6M
+var isoDuration = regexp.MustCompile(`^P(?:[0-9]+Y)?(?:[0-9]+M)?(?:[0-9]+W)?(?:[0-9]+D)?(?:T(?:[0-9]+H)?(?:[0-9]+M)?(?:[0-9]+S)?)?$`)
+
+// validDuration reports whether s is a non-empty ISO 8601 duration
+func validDuration(s string) bool {
+	return isoDuration.MatchString(s) && s != "P" && s[len(s)-1] != 'T'
+}
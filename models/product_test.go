@@ -0,0 +1,118 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRateTier_Contains(t *testing.T) {
+	d := decimal.RequireFromString
+	upper, term := d("10000"), d("12")
+	bounded := RateTier{MinimumValue: d("0"), MaximumValue: &upper}
+	open := RateTier{MinimumValue: d("10000")}
+	discrete := RateTier{MinimumValue: term, MaximumValue: &term}
+
+	tests := []struct {
+		name  string
+		tier  RateTier
+		value string
+		want  bool
+	}{
+		{"bounded minimum", bounded, "0", true},
+		{"bounded inside", bounded, "9999.99", true},
+		{"bounded maximum is exclusive", bounded, "10000", false},
+		{"open minimum", open, "10000", true},
+		{"open above", open, "1000000", true},
+		{"open below", open, "9999.99", false},
+		{"discrete", discrete, "12", true},
+		{"discrete other", discrete, "13", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tier.Contains(d(tt.value)); got != tt.want {
+				t.Errorf("Contains(%s) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProduct_IsEffective(t *testing.T) {
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	product := &Product{EffectiveFrom: from, EffectiveTo: &to}
+
+	if product.IsEffective(from.Add(-time.Second)) {
+		t.Error("effective before EffectiveFrom")
+	}
+	if !product.IsEffective(from) {
+		t.Error("not effective at EffectiveFrom")
+	}
+	if product.IsEffective(to) {
+		t.Error("effective at EffectiveTo")
+	}
+	product.EffectiveTo = nil
+	if !product.IsEffective(to.AddDate(10, 0, 0)) {
+		t.Error("open-ended product not effective")
+	}
+}
+
+func TestProductFee_Validate(t *testing.T) {
+	amount := &Money{Amount: decimal.NewFromInt(10), Currency: "AUD"}
+	rate := decimal.RequireFromString("0.02")
+	tests := []struct {
+		name       string
+		fee        ProductFee
+		wantFields []string
+	}{
+		{
+			name: "event fee with amount",
+			fee:  ProductFee{Name: "Dishonour", Type: FeeTypeEvent, Amount: amount},
+		},
+		{
+			name: "variable fee without amount",
+			fee:  ProductFee{Name: "Break cost", Type: FeeTypeVariable},
+		},
+		{
+			name:       "amount and rate",
+			fee:        ProductFee{Name: "Cash advance", Type: FeeTypeWithdrawal, Amount: amount, TransactionRate: &rate},
+			wantFields: []string{"amount"},
+		},
+		{
+			name:       "periodic fee without frequency",
+			fee:        ProductFee{Name: "Monthly", Type: FeeTypePeriodic, Amount: amount},
+			wantFields: []string{"accrualFrequency"},
+		},
+		{
+			name:       "missing amount",
+			fee:        ProductFee{Name: "Late payment", Type: FeeTypeEvent},
+			wantFields: []string{"amount"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fee.Validate()
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			ve, ok := AsValidationError(err)
+			if !ok {
+				t.Fatalf("Validate() = %v, want a ValidationError", err)
+			}
+			if len(ve.Fields) != len(tt.wantFields) {
+				t.Fatalf("got field errors %v, want %v", ve.Fields, tt.wantFields)
+			}
+			for i, field := range tt.wantFields {
+				if ve.Fields[i].Field != field {
+					t.Errorf("field %d = %s, want %s", i, ve.Fields[i].Field, field)
+				}
+			}
+		})
+	}
+}
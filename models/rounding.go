@@ -0,0 +1,126 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode represents how amounts are rounded to a currency's minor unit
+type RoundingMode string
+
+const (
+	// RoundHalfEven rounds half-way values to the nearest even digit (banker's rounding)
+	RoundHalfEven RoundingMode = "HALF_EVEN"
+	// RoundHalfUp rounds half-way values away from zero
+	RoundHalfUp RoundingMode = "HALF_UP"
+	// RoundDown truncates towards zero
+	RoundDown RoundingMode = "DOWN"
+)
+
+// IsValid checks if the rounding mode is valid
+func (rm RoundingMode) IsValid() bool {
+	switch rm {
+	case RoundHalfEven, RoundHalfUp, RoundDown:
+		return true
+	default:
+		return false
+	}
+}
+
+// roundDecimal rounds d to places decimal places using the given mode
+func roundDecimal(d decimal.Decimal, places int32, mode RoundingMode) decimal.Decimal {
+	switch mode {
+	case RoundHalfEven:
+		return d.RoundBank(places)
+	case RoundDown:
+		return d.Truncate(places)
+	default:
+		return d.Round(places)
+	}
+}
+
+// RoundWith returns a new Money instance rounded to the minor unit of its currency using mode
+func (m Money) RoundWith(mode RoundingMode) Money {
+	return Money{
+		Amount:   roundDecimal(m.Amount, int32(m.Exponent()), mode),
+		Currency: m.Currency,
+	}
+}
+
+// Allocate splits the amount into parts proportional to ratios without losing
+// minor units. Leftover units are handed out one at a time to the parts with the
+// largest remainders (earliest part wins ties), so the parts always sum to the
+// original amount. The amount must already be expressed in whole minor units.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, fmt.Errorf("at least one ratio is required")
+	}
+
+	total := 0
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("ratios must be non-negative: %d", ratio)
+		}
+		total += ratio
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("ratios must not all be zero")
+	}
+
+	exponent := int32(m.Exponent())
+	units := m.Amount.Shift(exponent)
+	if !units.IsInteger() {
+		return nil, fmt.Errorf("amount %s has more than %d decimal places for %s", m.Amount.String(), exponent, m.Currency)
+	}
+
+	divisor := decimal.NewFromInt(int64(total))
+	shares := make([]decimal.Decimal, len(ratios))
+	remainders := make([]decimal.Decimal, len(ratios))
+	allocated := decimal.Zero
+	for i, ratio := range ratios {
+		share, remainder := units.Mul(decimal.NewFromInt(int64(ratio))).QuoRem(divisor, 0)
+		shares[i] = share
+		remainders[i] = remainder.Abs()
+		allocated = allocated.Add(share)
+	}
+
+	// Distribute the leftover units (always fewer than len(ratios))
+	leftover := units.Sub(allocated)
+	step := decimal.NewFromInt(int64(leftover.Sign()))
+	for n := leftover.Abs().IntPart(); n > 0; n-- {
+		best := -1
+		for i := range remainders {
+			if ratios[i] == 0 {
+				continue
+			}
+			if best == -1 || remainders[i].GreaterThan(remainders[best]) {
+				best = i
+			}
+		}
+		shares[best] = shares[best].Add(step)
+		remainders[best] = decimal.NewFromInt(-1)
+	}
+
+	result := make([]Money, len(shares))
+	for i, share := range shares {
+		result[i] = Money{
+			Amount:   share.Shift(-exponent),
+			Currency: m.Currency,
+		}
+	}
+	return result, nil
+}
+
+// Split divides the amount into n parts that differ by at most one minor unit
+// and sum exactly to the original amount
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("split count must be positive: %d", n)
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
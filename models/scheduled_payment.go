@@ -0,0 +1,136 @@
+package models
+
+import "time"
+
+// ScheduledPayment represents a recurring outgoing payment following the BIAN
+// Standing Order and Direct Debit Mandate domains. Standing orders are pushed
+// by the account holder; direct debits are collected by a creditor under a
+// mandate the account holder authorised.
+type ScheduledPayment struct {
+	// Scheduled payment identification
+	ID        string `json:"id"`
+	AccountID string `json:"accountId"`
+
+	// Scheduled payment classification
+	Type   ScheduledPaymentType   `json:"type"`
+	Status ScheduledPaymentStatus `json:"status"`
+
+	// Payee of a standing order, or creditor collecting a direct debit
+	CounterpartyName string `json:"counterpartyName"`
+	Reference        string `json:"reference,omitempty"`
+
+	// Mandate authorising a direct debit (direct debits only)
+	MandateReference string `json:"mandateReference,omitempty"`
+
+	// Amount paid out per execution, positive. For direct debits this is the
+	// expected amount; the creditor may collect a different one.
+	Amount Money `json:"amount"`
+
+	// Schedule: executions start on StartDate and recur by Frequency until
+	// EndDate (inclusive) when set
+	Frequency Frequency  `json:"frequency"`
+	StartDate time.Time  `json:"startDate"`
+	EndDate   *time.Time `json:"endDate,omitempty"`
+
+	// Execution history and forecast. NextExecutionDate is omitted once the
+	// schedule has ended.
+	LastExecutionDate *time.Time `json:"lastExecutionDate,omitempty"`
+	NextExecutionDate *time.Time `json:"nextExecutionDate,omitempty"`
+}
+
+// NextExecution returns the first execution date on or after from, or false
+// when the schedule ends before then. Dates are calendar days in the location
+// of StartDate; only LAST_BUSINESS_DAY skips weekends, and no frequency
+// adjusts for public holidays.
+func (s *ScheduledPayment) NextExecution(from time.Time) (time.Time, bool) {
+	start := startOfDay(s.StartDate)
+	from = startOfDay(from.In(start.Location()))
+
+	n := 0
+	if from.After(start) {
+		n = s.Frequency.estimate(start, from)
+	}
+	for {
+		next := s.Frequency.occurrence(start, n)
+		if next.IsZero() {
+			return time.Time{}, false
+		}
+		if !next.Before(from) {
+			if s.EndDate != nil && next.After(*s.EndDate) {
+				return time.Time{}, false
+			}
+			return next, true
+		}
+		n++
+	}
+}
+
+// ExecutionDates returns the execution dates from from to to, both inclusive
+func (s *ScheduledPayment) ExecutionDates(from, to time.Time) []time.Time {
+	var dates []time.Time
+	for {
+		next, ok := s.NextExecution(from)
+		if !ok || next.After(to) {
+			return dates
+		}
+		dates = append(dates, next)
+		from = next.AddDate(0, 0, 1)
+	}
+}
+
+// occurrence returns the nth execution date of a schedule starting on start,
+// or the zero time for an unknown frequency
+func (f Frequency) occurrence(start time.Time, n int) time.Time {
+	switch f {
+	case FrequencyWeekly:
+		return start.AddDate(0, 0, 7*n)
+	case FrequencyFortnightly:
+		return start.AddDate(0, 0, 14*n)
+	case FrequencyMonthly:
+		// Days past the end of a shorter month fall on its last day
+		first := time.Date(start.Year(), start.Month()+time.Month(n), 1, 0, 0, 0, 0, start.Location())
+		return first.AddDate(0, 0, min(start.Day(), daysIn(first))-1)
+	case FrequencyLastBusinessDay:
+		if lastBusinessDay(start).Before(start) {
+			n++
+		}
+		return lastBusinessDay(time.Date(start.Year(), start.Month()+time.Month(n), 1, 0, 0, 0, 0, start.Location()))
+	}
+	return time.Time{}
+}
+
+// estimate returns an occurrence index no later than the first execution on
+// or after from, so NextExecution need not step from the start
+func (f Frequency) estimate(start, from time.Time) int {
+	days := int(from.Sub(start).Hours() / 24)
+	months := (from.Year()-start.Year())*12 + int(from.Month()-start.Month())
+	switch f {
+	case FrequencyWeekly:
+		return max(days/7-1, 0)
+	case FrequencyFortnightly:
+		return max(days/14-1, 0)
+	case FrequencyMonthly, FrequencyLastBusinessDay:
+		return max(months-1, 0)
+	}
+	return 0
+}
+
+// lastBusinessDay returns the last weekday of t's month
+func lastBusinessDay(t time.Time) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	day := first.AddDate(0, 1, -1)
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, -1)
+	}
+	return day
+}
+
+// daysIn returns the number of days in t's month
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
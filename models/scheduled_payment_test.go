@@ -0,0 +1,160 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestScheduledPayment_NextExecution(t *testing.T) {
+	endDate := date(2024, time.March, 31)
+
+	tests := []struct {
+		name      string
+		frequency Frequency
+		start     time.Time
+		end       *time.Time
+		from      time.Time
+		want      time.Time
+		wantOK    bool
+	}{
+		{
+			name:      "before start",
+			frequency: FrequencyMonthly,
+			start:     date(2024, time.January, 15),
+			from:      date(2024, time.January, 1),
+			want:      date(2024, time.January, 15),
+			wantOK:    true,
+		},
+		{
+			name:      "on an execution date",
+			frequency: FrequencyWeekly,
+			start:     date(2024, time.January, 1),
+			from:      date(2024, time.January, 15),
+			want:      date(2024, time.January, 15),
+			wantOK:    true,
+		},
+		{
+			name:      "weekly between executions",
+			frequency: FrequencyWeekly,
+			start:     date(2024, time.January, 1),
+			from:      date(2024, time.January, 16),
+			want:      date(2024, time.January, 22),
+			wantOK:    true,
+		},
+		{
+			name:      "time of day ignored",
+			frequency: FrequencyWeekly,
+			start:     date(2024, time.January, 1),
+			from:      time.Date(2024, time.January, 15, 23, 59, 0, 0, time.UTC),
+			want:      date(2024, time.January, 15),
+			wantOK:    true,
+		},
+		{
+			name:      "fortnightly",
+			frequency: FrequencyFortnightly,
+			start:     date(2024, time.January, 5),
+			from:      date(2024, time.January, 20),
+			want:      date(2024, time.February, 2),
+			wantOK:    true,
+		},
+		{
+			name:      "monthly clamps to short month",
+			frequency: FrequencyMonthly,
+			start:     date(2024, time.January, 31),
+			from:      date(2024, time.February, 1),
+			want:      date(2024, time.February, 29),
+			wantOK:    true,
+		},
+		{
+			name:      "monthly returns to start day after short month",
+			frequency: FrequencyMonthly,
+			start:     date(2024, time.January, 31),
+			from:      date(2024, time.March, 1),
+			want:      date(2024, time.March, 31),
+			wantOK:    true,
+		},
+		{
+			name:      "monthly across year end",
+			frequency: FrequencyMonthly,
+			start:     date(2023, time.June, 10),
+			from:      date(2023, time.December, 11),
+			want:      date(2024, time.January, 10),
+			wantOK:    true,
+		},
+		{
+			name:      "last business day skips weekend",
+			frequency: FrequencyLastBusinessDay,
+			start:     date(2024, time.January, 1),
+			from:      date(2024, time.March, 1),
+			want:      date(2024, time.March, 29), // 31st is a Sunday
+			wantOK:    true,
+		},
+		{
+			name:      "last business day starting after it in the first month",
+			frequency: FrequencyLastBusinessDay,
+			start:     date(2024, time.August, 31), // Saturday, after Friday the 30th
+			from:      date(2024, time.August, 31),
+			want:      date(2024, time.September, 30),
+			wantOK:    true,
+		},
+		{
+			name:      "on the end date",
+			frequency: FrequencyMonthly,
+			start:     date(2024, time.January, 31),
+			end:       &endDate,
+			from:      date(2024, time.March, 2),
+			want:      date(2024, time.March, 31),
+			wantOK:    true,
+		},
+		{
+			name:      "after the end date",
+			frequency: FrequencyMonthly,
+			start:     date(2024, time.January, 31),
+			end:       &endDate,
+			from:      date(2024, time.April, 1),
+			wantOK:    false,
+		},
+		{
+			name:      "unknown frequency",
+			frequency: Frequency("DAILY"),
+			start:     date(2024, time.January, 1),
+			from:      date(2024, time.January, 1),
+			wantOK:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payment := &ScheduledPayment{Frequency: tt.frequency, StartDate: tt.start, EndDate: tt.end}
+			got, ok := payment.NextExecution(tt.from)
+			if ok != tt.wantOK || !got.Equal(tt.want) {
+				t.Errorf("NextExecution(%s) = %s, %v; want %s, %v",
+					tt.from.Format(time.DateOnly), got.Format(time.DateOnly), ok, tt.want.Format(time.DateOnly), tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestScheduledPayment_ExecutionDates(t *testing.T) {
+	payment := &ScheduledPayment{Frequency: FrequencyLastBusinessDay, StartDate: date(2024, time.January, 1)}
+
+	got := payment.ExecutionDates(date(2024, time.May, 1), date(2024, time.August, 31))
+	want := []time.Time{
+		date(2024, time.May, 31),
+		date(2024, time.June, 28),
+		date(2024, time.July, 31),
+		date(2024, time.August, 30),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d dates %v, want %v", len(got), got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("date %d = %s, want %s", i, got[i].Format(time.DateOnly), want[i].Format(time.DateOnly))
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"regexp"
+	"time"
+)
 
 // Transaction represents a payment transaction following BIAN Payment Execution domain
 type Transaction struct {
@@ -18,6 +21,11 @@ type Transaction struct {
 	Description  string `json:"description"`
 	MerchantName string `json:"merchantName,omitempty"`
 	
+	// Enrichment (optional), filled in by a domains.Enricher rather than the provider
+	Category             TransactionCategory `json:"category,omitempty"`
+	MerchantCategoryCode string              `json:"merchantCategoryCode,omitempty"`
+	CleanMerchantName    string              `json:"cleanMerchantName,omitempty"`
+	
 	// Transaction dates
 	PostingDate time.Time `json:"postingDate"`
 	ValueDate   time.Time `json:"valueDate"`
@@ -27,4 +35,12 @@ type Transaction struct {
 	
 	// Account reference
 	AccountID string `json:"accountId"`
-}
\ No newline at end of file
+}
+
+// mccFormat matches ISO 18245 merchant category codes
+var mccFormat = regexp.MustCompile(`^[0-9]{4}$`)
+
+// ValidMCC reports whether code is a 4-digit ISO 18245 merchant category code
+func ValidMCC(code string) bool {
+	return mccFormat.MatchString(code)
+}
@@ -0,0 +1,655 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models/identifiers"
+	"github.com/shopspring/decimal"
+)
+
+// Validator is implemented by models that can check their own invariants
+type Validator interface {
+	Validate() error
+}
+
+// FieldError describes a single invalid field
+type FieldError struct {
+	// JSON path of the field, e.g. "amount.currency"
+	Field string `json:"field"`
+
+	// Human-readable reason the value was rejected
+	Message string `json:"message"`
+}
+
+// ValidationError is returned by Validate when one or more fields are invalid
+type ValidationError struct {
+	Fields []FieldError `json:"fields"`
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		parts = append(parts, f.Field+": "+f.Message)
+	}
+	return "validation failed: " + strings.Join(parts, "; ")
+}
+
+// AsValidationError extracts a ValidationError from an error chain
+func AsValidationError(err error) (*ValidationError, bool) {
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		return ve, true
+	}
+	return nil, false
+}
+
+// FieldErrors accumulates field errors while validating a model
+type FieldErrors struct {
+	fields []FieldError
+}
+
+// Add records an invalid field
+func (v *FieldErrors) Add(field, format string, args ...interface{}) {
+	v.fields = append(v.fields, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Required records an error if value is empty
+func (v *FieldErrors) Required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		v.Add(field, "is required")
+	}
+}
+
+// RequiredTime records an error if t is the zero time
+func (v *FieldErrors) RequiredTime(field string, t time.Time) {
+	if t.IsZero() {
+		v.Add(field, "is required")
+	}
+}
+
+// Nested merges the field errors of a nested model under prefix (or at the
+// top level when prefix is empty)
+func (v *FieldErrors) Nested(prefix string, err error) {
+	if err == nil {
+		return
+	}
+	ve, ok := AsValidationError(err)
+	if !ok {
+		v.Add(prefix, "%s", err.Error())
+		return
+	}
+	for _, f := range ve.Fields {
+		if prefix != "" {
+			f.Field = prefix + "." + f.Field
+		}
+		v.fields = append(v.fields, f)
+	}
+}
+
+// Err returns a *ValidationError if any field errors were recorded, otherwise nil
+func (v *FieldErrors) Err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: v.fields}
+}
+
+// Validate checks that the currency is a monetary ISO 4217 code
+func (m Money) Validate() error {
+	var v FieldErrors
+	if m.Currency == "" {
+		v.Add("currency", "is required")
+	} else if err := validateCurrency(m.Currency); err != nil {
+		v.Add("currency", "must be an ISO 4217 currency code")
+	} else if m.Currency != strings.ToUpper(m.Currency) {
+		v.Add("currency", "must be upper case")
+	}
+	return v.Err()
+}
+
+// Validate checks account identification, classification and lifecycle dates
+func (a *Account) Validate() error {
+	var v FieldErrors
+	v.Required("id", a.ID)
+	v.Required("accountNumber", a.AccountNumber)
+	if a.Identifier != nil {
+		v.Nested("identifier", a.Identifier.Validate())
+	}
+	if !a.AccountType.IsValid() {
+		v.Add("accountType", "unknown account type %q", a.AccountType)
+	}
+	if !a.Status.IsValid() {
+		v.Add("status", "unknown account status %q", a.Status)
+	}
+	v.RequiredTime("openDate", a.OpenDate)
+	if a.CloseDate != nil && !a.OpenDate.IsZero() && a.CloseDate.Before(a.OpenDate) {
+		v.Add("closeDate", "must not be before openDate")
+	}
+	if a.Status == AccountStatusClosed && a.CloseDate == nil {
+		v.Add("closeDate", "is required when status is CLOSED")
+	}
+	if err := validateCurrency(a.Currency); err != nil {
+		v.Add("currency", "must be an ISO 4217 currency code")
+	}
+	return v.Err()
+}
+
+// Validate checks the bank code, account number and IBAN against the scheme
+func (i *AccountIdentifier) Validate() error {
+	var v FieldErrors
+	switch {
+	case i.Scheme == identifiers.SchemeIBAN:
+		if _, err := identifiers.ParseIBAN(i.IBAN); err != nil {
+			v.Add("iban", "%v", err)
+		}
+		if i.BankCode != "" {
+			v.Add("bankCode", "must not be set for IBAN identifiers")
+		}
+		if i.AccountNumber != "" {
+			v.Add("accountNumber", "must not be set for IBAN identifiers")
+		}
+	case i.Scheme.IsValid():
+		if _, err := identifiers.ParseBankCode(i.Scheme, i.BankCode); err != nil {
+			v.Add("bankCode", "%v", err)
+		}
+		if err := identifiers.ValidateAccountNumber(i.Scheme, i.AccountNumber); err != nil {
+			v.Add("accountNumber", "%v", err)
+		}
+		if i.IBAN != "" {
+			v.Add("iban", "must only be set for IBAN identifiers")
+		}
+	default:
+		v.Add("scheme", "unknown identifier scheme %q", i.Scheme)
+	}
+	return v.Err()
+}
+
+// Validate checks the balance type, amount and timestamp
+func (b *Balance) Validate() error {
+	var v FieldErrors
+	if !b.BalanceType.IsValid() {
+		v.Add("balanceType", "unknown balance type %q", b.BalanceType)
+	}
+	v.Nested("amount", b.Amount.Validate())
+	v.RequiredTime("timestamp", b.Timestamp)
+	return v.Err()
+}
+
+// Validate checks transaction identification, amount and dates
+func (t *Transaction) Validate() error {
+	var v FieldErrors
+	v.Required("id", t.ID)
+	v.Required("accountId", t.AccountID)
+	if !t.TransactionType.IsValid() {
+		v.Add("transactionType", "unknown transaction type %q", t.TransactionType)
+	}
+	v.Nested("amount", t.Amount.Validate())
+	v.RequiredTime("postingDate", t.PostingDate)
+	v.RequiredTime("valueDate", t.ValueDate)
+	if t.Category != "" && !t.Category.IsValid() {
+		v.Add("category", "unknown transaction category %q", t.Category)
+	}
+	if t.MerchantCategoryCode != "" && !ValidMCC(t.MerchantCategoryCode) {
+		v.Add("merchantCategoryCode", "must be a 4-digit ISO 18245 merchant category code")
+	}
+	if t.RunningBalance != nil {
+		v.Nested("runningBalance", t.RunningBalance.Validate())
+		if t.RunningBalance.Currency != t.Amount.Currency {
+			v.Add("runningBalance.currency", "must match amount currency %s", t.Amount.Currency)
+		}
+	}
+	return v.Err()
+}
+
+// Validate checks consent identification, scopes and lifecycle dates
+func (c *Consent) Validate() error {
+	var v FieldErrors
+	v.Required("id", c.ID)
+	if !c.Status.IsValid() {
+		v.Add("status", "unknown consent status %q", c.Status)
+	}
+	if len(c.Scopes) == 0 {
+		v.Add("scopes", "must contain at least one scope")
+	}
+	for i, scope := range c.Scopes {
+		if strings.TrimSpace(scope) == "" {
+			v.Add(fmt.Sprintf("scopes[%d]", i), "must not be empty")
+		}
+	}
+	v.RequiredTime("grantDate", c.GrantDate)
+	v.RequiredTime("expiryDate", c.ExpiryDate)
+	if !c.GrantDate.IsZero() && !c.ExpiryDate.IsZero() && !c.ExpiryDate.After(c.GrantDate) {
+		v.Add("expiryDate", "must be after grantDate")
+	}
+	if c.RevocationDate != nil && c.RevocationDate.Before(c.GrantDate) {
+		v.Add("revocationDate", "must not be before grantDate")
+	}
+	if c.Status == ConsentStatusRevoked && c.RevocationDate == nil {
+		v.Add("revocationDate", "is required when status is REVOKED")
+	}
+	return v.Err()
+}
+
+// Validate checks card identification, that the PAN is masked, and the
+// expiry and credit terms
+func (c *Card) Validate() error {
+	var v FieldErrors
+	v.Required("id", c.ID)
+	v.Required("accountId", c.AccountID)
+	if !maskedPAN.MatchString(c.MaskedPAN) {
+		v.Add("maskedPan", "must mask all but the last four digits")
+	}
+	if !c.Scheme.IsValid() {
+		v.Add("scheme", "unknown card scheme %q", c.Scheme)
+	}
+	if !c.Status.IsValid() {
+		v.Add("status", "unknown card status %q", c.Status)
+	}
+	if c.ExpiryMonth < 1 || c.ExpiryMonth > 12 {
+		v.Add("expiryMonth", "must be between 1 and 12")
+	}
+	if c.ExpiryYear < 2000 {
+		v.Add("expiryYear", "must be a four-digit year")
+	}
+	if c.CreditFacility != nil {
+		v.Nested("creditFacility", c.CreditFacility.Validate())
+	}
+	return v.Err()
+}
+
+// Validate checks that credit amounts share the limit's currency and the
+// statement dates are consistent
+func (f *CreditFacility) Validate() error {
+	var v FieldErrors
+	v.Nested("creditLimit", f.CreditLimit.Validate())
+	if f.CreditLimit.IsNegative() {
+		v.Add("creditLimit", "must not be negative")
+	}
+	for _, amount := range []struct {
+		field string
+		money Money
+	}{
+		{"availableCredit", f.AvailableCredit},
+		{"statementBalance", f.StatementBalance},
+		{"minimumPayment", f.MinimumPayment},
+	} {
+		if amount.money.Currency != f.CreditLimit.Currency {
+			v.Add(amount.field+".currency", "must match creditLimit currency %s", f.CreditLimit.Currency)
+		}
+	}
+	if f.MinimumPayment.IsNegative() {
+		v.Add("minimumPayment", "must not be negative")
+	}
+	v.RequiredTime("paymentDueDate", f.PaymentDueDate)
+	if f.StatementCycle.StatementDay < 1 || f.StatementCycle.StatementDay > 28 {
+		v.Add("statementCycle.statementDay", "must be between 1 and 28")
+	}
+	if !f.StatementCycle.NextStatementDate.After(f.StatementCycle.LastStatementDate) {
+		v.Add("statementCycle.nextStatementDate", "must be after lastStatementDate")
+	}
+	if f.PaymentDueDate.Before(f.StatementCycle.LastStatementDate) {
+		v.Add("paymentDueDate", "must not be before lastStatementDate")
+	}
+	return v.Err()
+}
+
+// Validate checks identification, classification, amount and schedule
+func (s *ScheduledPayment) Validate() error {
+	var v FieldErrors
+	v.Required("id", s.ID)
+	v.Required("accountId", s.AccountID)
+	if !s.Type.IsValid() {
+		v.Add("type", "unknown scheduled payment type %q", s.Type)
+	}
+	if !s.Status.IsValid() {
+		v.Add("status", "unknown scheduled payment status %q", s.Status)
+	}
+	v.Required("counterpartyName", s.CounterpartyName)
+	if s.Type == ScheduledPaymentTypeDirectDebit {
+		v.Required("mandateReference", s.MandateReference)
+	}
+	v.Nested("amount", s.Amount.Validate())
+	if s.Amount.IsNegative() || s.Amount.IsZero() {
+		v.Add("amount", "must be positive")
+	}
+	if !s.Frequency.IsValid() {
+		v.Add("frequency", "unknown frequency %q", s.Frequency)
+	}
+	v.RequiredTime("startDate", s.StartDate)
+	if s.EndDate != nil && s.EndDate.Before(s.StartDate) {
+		v.Add("endDate", "must not be before startDate")
+	}
+	if s.NextExecutionDate != nil && s.NextExecutionDate.Before(startOfDay(s.StartDate)) {
+		v.Add("nextExecutionDate", "must not be before startDate")
+	}
+	return v.Err()
+}
+
+// Validate checks a stored payee: its identification and its details
+func (p *Payee) Validate() error {
+	var v FieldErrors
+	v.Required("id", p.ID)
+	v.RequiredTime("creationDate", p.CreationDate)
+	v.Nested("", p.ValidateInput())
+	return v.Err()
+}
+
+// ValidateInput checks a payee submitted for creation, before an ID and
+// creation date are assigned: the type must match the one details object
+// set, and bank codes, IBANs and BICs must be well formed with valid check
+// digits where the scheme has them
+func (p *Payee) ValidateInput() error {
+	var v FieldErrors
+	v.Required("customerId", p.CustomerID)
+	v.Required("nickname", p.Nickname)
+
+	details := []struct {
+		t   PayeeType
+		set bool
+	}{
+		{PayeeTypeDomestic, p.Domestic != nil},
+		{PayeeTypeInternational, p.International != nil},
+		{PayeeTypeBiller, p.Biller != nil},
+	}
+	if !p.Type.IsValid() {
+		v.Add("type", "unknown payee type %q", p.Type)
+	}
+	for _, detail := range details {
+		field := strings.ToLower(string(detail.t))
+		if detail.t == p.Type && !detail.set {
+			v.Add(field, "is required for %s payees", p.Type)
+		}
+		if detail.t != p.Type && detail.set {
+			v.Add(field, "must not be set for %s payees", p.Type)
+		}
+	}
+
+	if p.Type == PayeeTypeDomestic && p.Domestic != nil {
+		v.Nested("domestic", p.Domestic.Validate())
+	}
+	if p.Type == PayeeTypeInternational && p.International != nil {
+		v.Nested("international", p.International.Validate())
+	}
+	if p.Type == PayeeTypeBiller && p.Biller != nil {
+		v.Nested("biller", p.Biller.Validate())
+	}
+	return v.Err()
+}
+
+// Validate checks the account name, and the bank code against its scheme
+func (d *DomesticPayee) Validate() error {
+	var v FieldErrors
+	v.Required("accountName", d.AccountName)
+	if !d.Scheme.IsValid() {
+		v.Add("scheme", "unknown domestic scheme %q", d.Scheme)
+	} else {
+		scheme := identifiers.Scheme(d.Scheme)
+		if _, err := identifiers.ParseBankCode(scheme, d.BankCode); err != nil {
+			v.Add("bankCode", "%v", err)
+		}
+		if err := identifiers.ValidateAccountNumber(scheme, d.AccountNumber); err != nil {
+			v.Add("accountNumber", "%v", err)
+		}
+	}
+	return v.Err()
+}
+
+// Validate checks the beneficiary, IBAN or account number, and BIC
+func (i *InternationalPayee) Validate() error {
+	var v FieldErrors
+	v.Required("beneficiaryName", i.BeneficiaryName)
+	if !countryCode.MatchString(i.Country) {
+		v.Add("country", "must be an ISO 3166-1 alpha-2 country code")
+	}
+	switch {
+	case i.IBAN != "" && i.AccountNumber != "":
+		v.Add("accountNumber", "must not be set with iban")
+	case i.IBAN != "":
+		if iban, err := identifiers.ParseIBAN(i.IBAN); err != nil {
+			v.Add("iban", "%v", err)
+		} else if iban.Country() != i.Country {
+			v.Add("iban", "must be issued in country %s", i.Country)
+		}
+	default:
+		v.Required("accountNumber", i.AccountNumber)
+	}
+	if bic, err := identifiers.ParseBIC(i.BIC); err != nil {
+		v.Add("bic", "%v", err)
+	} else if countryCode.MatchString(i.Country) && bic.Country() != i.Country {
+		v.Add("bic", "must belong to a bank in country %s", i.Country)
+	}
+	return v.Err()
+}
+
+// Validate checks the BPAY biller code and customer reference number
+func (b *BillerPayee) Validate() error {
+	var v FieldErrors
+	if !billerCode.MatchString(b.BillerCode) {
+		v.Add("billerCode", "must be 3 to 10 digits")
+	}
+	v.Required("billerName", b.BillerName)
+	if !crnFormat.MatchString(b.CRN) {
+		v.Add("crn", "must be 2 to 20 digits")
+	}
+	return v.Err()
+}
+
+// maskedPAN matches a card number showing at most its last four digits
+var maskedPAN = regexp.MustCompile(`^[*Xx•]{8,15}[0-9]{4}$`)
+
+// Validate checks the currency pair, rate and timestamp
+func (r *ExchangeRate) Validate() error {
+	var v FieldErrors
+	if err := validateCurrency(r.BaseCurrency); err != nil {
+		v.Add("baseCurrency", "must be an ISO 4217 currency code")
+	}
+	if err := validateCurrency(r.QuoteCurrency); err != nil {
+		v.Add("quoteCurrency", "must be an ISO 4217 currency code")
+	}
+	if !r.Rate.IsPositive() {
+		v.Add("rate", "must be positive")
+	}
+	v.RequiredTime("timestamp", r.Timestamp)
+	return v.Err()
+}
+
+// Validate checks the summary currency and that every entry is in the reporting currency
+func (s *CustomerBalanceSummary) Validate() error {
+	var v FieldErrors
+	v.Required("customerId", s.CustomerID)
+	if err := validateCurrency(s.ReportingCurrency); err != nil {
+		v.Add("reportingCurrency", "must be an ISO 4217 currency code")
+	}
+	if s.Total.Currency != s.ReportingCurrency {
+		v.Add("total.currency", "must match reportingCurrency %s", s.ReportingCurrency)
+	}
+	for i, entry := range s.Accounts {
+		if entry.ConvertedBalance.Currency != s.ReportingCurrency {
+			v.Add(fmt.Sprintf("accounts[%d].convertedBalance.currency", i), "must match reportingCurrency %s", s.ReportingCurrency)
+		}
+	}
+	return v.Err()
+}
+
+// Validate checks the product's identification, effective period and details
+func (p *Product) Validate() error {
+	var v FieldErrors
+	v.Required("id", p.ID)
+	v.Required("name", p.Name)
+	if !p.Category.IsValid() {
+		v.Add("category", "unknown product category %q", p.Category)
+	}
+	v.RequiredTime("effectiveFrom", p.EffectiveFrom)
+	if p.EffectiveTo != nil && !p.EffectiveTo.After(p.EffectiveFrom) {
+		v.Add("effectiveTo", "must be after effectiveFrom")
+	}
+	v.RequiredTime("lastUpdated", p.LastUpdated)
+	for i, feature := range p.Features {
+		if !feature.Type.IsValid() {
+			v.Add(fmt.Sprintf("features[%d].featureType", i), "unknown feature type %q", feature.Type)
+		}
+	}
+	for i := range p.Constraints {
+		v.Nested(fmt.Sprintf("constraints[%d]", i), p.Constraints[i].Validate())
+	}
+	for i, eligibility := range p.Eligibility {
+		if !eligibility.Type.IsValid() {
+			v.Add(fmt.Sprintf("eligibility[%d].eligibilityType", i), "unknown eligibility type %q", eligibility.Type)
+		}
+	}
+	for i := range p.Fees {
+		v.Nested(fmt.Sprintf("fees[%d]", i), p.Fees[i].Validate())
+	}
+	for i := range p.DepositRates {
+		v.Nested(fmt.Sprintf("depositRates[%d]", i), p.DepositRates[i].Validate())
+	}
+	for i := range p.LendingRates {
+		v.Nested(fmt.Sprintf("lendingRates[%d]", i), p.LendingRates[i].Validate())
+	}
+	return v.Err()
+}
+
+// Validate checks the constraint type and amount
+func (c *ProductConstraint) Validate() error {
+	var v FieldErrors
+	if !c.Type.IsValid() {
+		v.Add("constraintType", "unknown constraint type %q", c.Type)
+	}
+	v.Nested("amount", c.Amount.Validate())
+	if c.Amount.IsNegative() {
+		v.Add("amount", "must not be negative")
+	}
+	return v.Err()
+}
+
+// Validate checks that the fee has exactly one amount or rate, unless it is VARIABLE
+func (f *ProductFee) Validate() error {
+	var v FieldErrors
+	v.Required("name", f.Name)
+	if !f.Type.IsValid() {
+		v.Add("feeType", "unknown fee type %q", f.Type)
+	}
+	set := 0
+	if f.Amount != nil {
+		set++
+		v.Nested("amount", f.Amount.Validate())
+	}
+	if f.BalanceRate != nil {
+		set++
+		validateRate(&v, "balanceRate", *f.BalanceRate)
+	}
+	if f.TransactionRate != nil {
+		set++
+		validateRate(&v, "transactionRate", *f.TransactionRate)
+	}
+	switch {
+	case set > 1:
+		v.Add("amount", "only one of amount, balanceRate and transactionRate may be set")
+	case set == 0 && f.Type != FeeTypeVariable:
+		v.Add("amount", "one of amount, balanceRate and transactionRate is required")
+	}
+	if f.Type == FeeTypePeriodic && !validDuration(f.AccrualFrequency) {
+		v.Add("accrualFrequency", "must be an ISO 8601 duration for PERIODIC fees")
+	}
+	return v.Err()
+}
+
+// Validate checks the rate type, rate, frequencies and tiers
+func (r *DepositRate) Validate() error {
+	var v FieldErrors
+	if !r.Type.IsValid() {
+		v.Add("depositRateType", "unknown deposit rate type %q", r.Type)
+	}
+	validateRate(&v, "rate", r.Rate)
+	validateFrequencies(&v, r.CalculationFrequency, r.ApplicationFrequency)
+	validateTiers(&v, r.Tiers)
+	return v.Err()
+}
+
+// Validate checks the rate type, rates, frequencies and tiers
+func (r *LendingRate) Validate() error {
+	var v FieldErrors
+	if !r.Type.IsValid() {
+		v.Add("lendingRateType", "unknown lending rate type %q", r.Type)
+	}
+	validateRate(&v, "rate", r.Rate)
+	if r.ComparisonRate != nil {
+		validateRate(&v, "comparisonRate", *r.ComparisonRate)
+	}
+	validateFrequencies(&v, r.CalculationFrequency, r.ApplicationFrequency)
+	validateTiers(&v, r.Tiers)
+	return v.Err()
+}
+
+// Validate checks the tier's unit, bounds and application method
+func (t *RateTier) Validate() error {
+	var v FieldErrors
+	v.Required("name", t.Name)
+	if !t.UnitOfMeasure.IsValid() {
+		v.Add("unitOfMeasure", "unknown unit %q", t.UnitOfMeasure)
+	}
+	if t.MinimumValue.IsNegative() {
+		v.Add("minimumValue", "must not be negative")
+	}
+	if t.MaximumValue != nil && t.MaximumValue.LessThan(t.MinimumValue) {
+		v.Add("maximumValue", "must not be less than minimumValue")
+	}
+	if t.RateApplicationMethod != "" && !t.RateApplicationMethod.IsValid() {
+		v.Add("rateApplicationMethod", "unknown rate application method %q", t.RateApplicationMethod)
+	}
+	return v.Err()
+}
+
+// validateRate checks that a rate is a fraction between 0 and 1 (0% to 100%)
+func validateRate(v *FieldErrors, field string, rate decimal.Decimal) {
+	if rate.IsNegative() || rate.GreaterThan(decimal.NewFromInt(1)) {
+		v.Add(field, "must be a decimal fraction between 0 and 1")
+	}
+}
+
+// validateFrequencies checks optional interest calculation and application frequencies
+func validateFrequencies(v *FieldErrors, calculation, application string) {
+	if calculation != "" && !validDuration(calculation) {
+		v.Add("calculationFrequency", "must be an ISO 8601 duration")
+	}
+	if application != "" && !validDuration(application) {
+		v.Add("applicationFrequency", "must be an ISO 8601 duration")
+	}
+}
+
+// validateTiers checks each tier of a rate
+func validateTiers(v *FieldErrors, tiers []RateTier) {
+	for i := range tiers {
+		v.Nested(fmt.Sprintf("tiers[%d]", i), tiers[i].Validate())
+	}
+}
+
+// Ensure models implement the validation contract
+var (
+	_ Validator = Money{}
+	_ Validator = (*Account)(nil)
+	_ Validator = (*AccountIdentifier)(nil)
+	_ Validator = (*Product)(nil)
+	_ Validator = (*ProductConstraint)(nil)
+	_ Validator = (*ProductFee)(nil)
+	_ Validator = (*DepositRate)(nil)
+	_ Validator = (*LendingRate)(nil)
+	_ Validator = (*RateTier)(nil)
+	_ Validator = (*Balance)(nil)
+	_ Validator = (*Transaction)(nil)
+	_ Validator = (*Consent)(nil)
+	_ Validator = (*Card)(nil)
+	_ Validator = (*CreditFacility)(nil)
+	_ Validator = (*ScheduledPayment)(nil)
+	_ Validator = (*Payee)(nil)
+	_ Validator = (*DomesticPayee)(nil)
+	_ Validator = (*InternationalPayee)(nil)
+	_ Validator = (*BillerPayee)(nil)
+	_ Validator = (*ExchangeRate)(nil)
+	_ Validator = (*CustomerBalanceSummary)(nil)
+)
@@ -0,0 +1,165 @@
+package models
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// invalidFields returns the sorted field paths of a validation error, or nil
+// when err is nil
+func invalidFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	ve, ok := AsValidationError(err)
+	if !ok {
+		t.Fatalf("error %v is not a ValidationError", err)
+	}
+	fields := make([]string, len(ve.Fields))
+	for i, f := range ve.Fields {
+		fields[i] = f.Field
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+func checkFields(t *testing.T, name string, err error, want ...string) {
+	t.Helper()
+	sort.Strings(want)
+	if got := invalidFields(t, err); !reflect.DeepEqual(got, want) && (len(got) > 0 || len(want) > 0) {
+		t.Errorf("%s: invalid fields = %v, want %v", name, got, want)
+	}
+}
+
+func TestAccount_Validate(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	valid := func() *Account {
+		return &Account{
+			ID:            "acc-001",
+			AccountNumber: "123",
+			AccountType:   AccountTypeChecking,
+			Status:        AccountStatusOpen,
+			OpenDate:      now,
+			Currency:      "AUD",
+		}
+	}
+	checkFields(t, "valid account", valid().Validate())
+
+	account := valid()
+	account.ID = ""
+	account.CloseDate = &earlier
+	checkFields(t, "missing ID, closed before opening", account.Validate(), "id", "closeDate")
+}
+
+func TestTransaction_Validate(t *testing.T) {
+	now := time.Now()
+	valid := func() *Transaction {
+		return &Transaction{
+			ID:              "tx-001",
+			AccountID:       "acc-001",
+			TransactionType: TransactionTypeDebit,
+			Amount:          Money{Amount: decimal.NewFromInt(-1), Currency: "AUD"},
+			PostingDate:     now,
+			ValueDate:       now,
+		}
+	}
+	checkFields(t, "valid transaction", valid().Validate())
+
+	tests := []struct {
+		name   string
+		modify func(*Transaction)
+		want   []string
+	}{
+		{"unknown currency", func(tx *Transaction) { tx.Amount.Currency = "ZZZ" }, []string{"amount.currency"}},
+		{"unknown category", func(tx *Transaction) { tx.Category = "GAMBLING" }, []string{"category"}},
+		{"malformed MCC", func(tx *Transaction) { tx.MerchantCategoryCode = "54a1" }, []string{"merchantCategoryCode"}},
+		{"running balance currency", func(tx *Transaction) {
+			tx.RunningBalance = &Money{Amount: decimal.NewFromInt(10), Currency: "USD"}
+		}, []string{"runningBalance.currency"}},
+		{"missing dates", func(tx *Transaction) { tx.PostingDate, tx.ValueDate = time.Time{}, time.Time{} }, []string{"postingDate", "valueDate"}},
+	}
+	for _, tt := range tests {
+		tx := valid()
+		tt.modify(tx)
+		checkFields(t, tt.name, tx.Validate(), tt.want...)
+	}
+}
+
+func TestBalance_Validate(t *testing.T) {
+	valid := func() *Balance {
+		return &Balance{
+			BalanceType: BalanceTypeAvailable,
+			Amount:      Money{Amount: decimal.RequireFromString("12.50"), Currency: "AUD"},
+			Timestamp:   time.Now(),
+		}
+	}
+	checkFields(t, "valid balance", valid().Validate())
+
+	balance := valid()
+	balance.BalanceType = "RESERVED"
+	balance.Amount.Currency = ""
+	balance.Timestamp = time.Time{}
+	checkFields(t, "invalid balance", balance.Validate(), "balanceType", "amount.currency", "timestamp")
+}
+
+func TestConsent_Validate(t *testing.T) {
+	now := time.Now()
+	valid := func() *Consent {
+		return &Consent{
+			ID:         "consent-001",
+			Status:     ConsentStatusActive,
+			Scopes:     []string{"accounts:read"},
+			GrantDate:  now.AddDate(0, -1, 0),
+			ExpiryDate: now.AddDate(0, 11, 0),
+		}
+	}
+	checkFields(t, "valid consent", valid().Validate())
+
+	revoked := now.AddDate(0, -2, 0)
+	tests := []struct {
+		name   string
+		modify func(*Consent)
+		want   []string
+	}{
+		{"unknown status", func(c *Consent) { c.Status = "PAUSED" }, []string{"status"}},
+		{"no scopes", func(c *Consent) { c.Scopes = nil }, []string{"scopes"}},
+		{"blank scope", func(c *Consent) { c.Scopes = append(c.Scopes, " ") }, []string{"scopes[1]"}},
+		{"expires before grant", func(c *Consent) { c.ExpiryDate = c.GrantDate }, []string{"expiryDate"}},
+		{"revoked without date", func(c *Consent) { c.Status = ConsentStatusRevoked }, []string{"revocationDate"}},
+		{"revoked before grant", func(c *Consent) {
+			c.Status = ConsentStatusRevoked
+			c.RevocationDate = &revoked
+		}, []string{"revocationDate"}},
+	}
+	for _, tt := range tests {
+		consent := valid()
+		tt.modify(consent)
+		checkFields(t, tt.name, consent.Validate(), tt.want...)
+	}
+}
+
+func TestAsValidationError(t *testing.T) {
+	var v FieldErrors
+	if v.Err() != nil {
+		t.Error("empty FieldErrors should produce a nil error")
+	}
+	v.Add("limit", "must be between %d and %d", 1, 500)
+	v.Nested("amount", (&Money{Amount: decimal.NewFromInt(1)}).Validate())
+
+	wrapped := errors.Join(errors.New("context"), v.Err())
+	ve, ok := AsValidationError(wrapped)
+	if !ok {
+		t.Fatal("AsValidationError() should unwrap a joined ValidationError")
+	}
+	checkFields(t, "nested", ve, "limit", "amount.currency")
+	if ve.Fields[0].Message != "must be between 1 and 500" {
+		t.Errorf("message = %q", ve.Fields[0].Message)
+	}
+}
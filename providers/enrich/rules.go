@@ -0,0 +1,171 @@
+// Package enrich provides a rules-based domains.Enricher that categorises
+// transactions and cleans up merchant names from a local rules file.
+package enrich
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+//go:embed rules.json
+var defaultRules []byte
+
+// Rule classifies the transactions it matches. The first matching rule wins.
+type Rule struct {
+	// Pattern is a case-insensitive regular expression matched against the
+	// merchant name and the description; empty matches every transaction
+	Pattern string `json:"pattern,omitempty"`
+
+	// TransactionType restricts the rule to one transaction type
+	TransactionType models.TransactionType `json:"transactionType,omitempty"`
+
+	// Category, merchant category code and clean merchant name to assign
+	Category models.TransactionCategory `json:"category"`
+	MCC      string                     `json:"mcc,omitempty"`
+	Merchant string                     `json:"merchant,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// matches reports whether the rule applies to tx
+func (r *Rule) matches(tx *models.Transaction) bool {
+	if r.TransactionType != "" && r.TransactionType != tx.TransactionType {
+		return false
+	}
+	return r.pattern == nil || r.pattern.MatchString(tx.MerchantName) || r.pattern.MatchString(tx.Description)
+}
+
+// RulesEnricher implements domains.Enricher with an ordered list of rules.
+// Transactions no rule matches keep an empty category and merchant category
+// code, but still get a cleaned merchant name.
+type RulesEnricher struct {
+	rules []Rule
+}
+
+// Ensure RulesEnricher implements the enrichment interface
+var _ domains.Enricher = (*RulesEnricher)(nil)
+
+// NewRulesEnricher creates an enricher from the given rules, in priority order
+func NewRulesEnricher(rules ...Rule) (*RulesEnricher, error) {
+	e := &RulesEnricher{rules: make([]Rule, len(rules))}
+	for i, rule := range rules {
+		if rule.Pattern == "" && rule.TransactionType == "" {
+			return nil, fmt.Errorf("rule %d: pattern or transactionType is required", i)
+		}
+		if rule.TransactionType != "" && !rule.TransactionType.IsValid() {
+			return nil, fmt.Errorf("rule %d: unknown transaction type %q", i, rule.TransactionType)
+		}
+		if !rule.Category.IsValid() {
+			return nil, fmt.Errorf("rule %d: unknown category %q", i, rule.Category)
+		}
+		if rule.MCC != "" && !models.ValidMCC(rule.MCC) {
+			return nil, fmt.Errorf("rule %d: merchant category code %q must be 4 digits", i, rule.MCC)
+		}
+		if rule.Pattern != "" {
+			pattern, err := regexp.Compile("(?i)" + rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: invalid pattern: %w", i, err)
+			}
+			rule.pattern = pattern
+		}
+		e.rules[i] = rule
+	}
+	return e, nil
+}
+
+// NewDefaultRulesEnricher creates an enricher from the bundled rules, which
+// cover common Australian merchants and the mock provider's sample data
+func NewDefaultRulesEnricher() *RulesEnricher {
+	e, err := LoadRulesEnricher(bytes.NewReader(defaultRules))
+	if err != nil {
+		panic(fmt.Sprintf("invalid bundled enrichment rules: %v", err))
+	}
+	return e
+}
+
+// rulesFile is the JSON layout of a rules file
+type rulesFile struct {
+	Rules []Rule `json:"rules"`
+}
+
+// LoadRulesEnricher creates an enricher from a JSON rules file
+func LoadRulesEnricher(r io.Reader) (*RulesEnricher, error) {
+	var f rulesFile
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, fmt.Errorf("invalid rules file: %w", err)
+	}
+	e, err := NewRulesEnricher(f.Rules...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rules file: %w", err)
+	}
+	return e, nil
+}
+
+// LoadRulesEnricherFile creates an enricher from a JSON rules file on disk
+func LoadRulesEnricherFile(path string) (*RulesEnricher, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadRulesEnricher(file)
+}
+
+// Enrich applies the first matching rule to tx
+func (e *RulesEnricher) Enrich(ctx context.Context, tx *models.Transaction) error {
+	tx.CleanMerchantName = CleanMerchantName(tx.MerchantName)
+	for i := range e.rules {
+		rule := &e.rules[i]
+		if !rule.matches(tx) {
+			continue
+		}
+		tx.Category = rule.Category
+		tx.MerchantCategoryCode = rule.MCC
+		if rule.Merchant != "" {
+			tx.CleanMerchantName = rule.Merchant
+		}
+		break
+	}
+	return nil
+}
+
+// processorPrefix matches payment processor prefixes such as "SQ *" and "PAYPAL *"
+var processorPrefix = regexp.MustCompile(`(?i)^(?:sq|sp|pp|paypal|zlr|ls)\s*\*\s*`)
+
+// storeNumber matches trailing store and terminal numbers such as "1234" or "#12"
+var storeNumber = regexp.MustCompile(`(?:\s+#?[0-9]+)+$`)
+
+// CleanMerchantName tidies a raw merchant name: it drops payment processor
+// prefixes and trailing store numbers, collapses whitespace and converts
+// names written entirely in capitals to title case. Words of three letters
+// or fewer stay in capitals, as they are usually acronyms such as ATM or BP.
+func CleanMerchantName(name string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	name = processorPrefix.ReplaceAllString(name, "")
+	name = storeNumber.ReplaceAllString(name, "")
+	if name != strings.ToUpper(name) {
+		return name
+	}
+
+	words := strings.Fields(name)
+	for i, word := range words {
+		runes := []rune(strings.ToLower(word))
+		if len(runes) <= 3 {
+			continue
+		}
+		runes[0] = unicode.ToUpper(runes[0])
+		words[i] = string(runes)
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,178 @@
+package enrich
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+func TestNewRulesEnricher_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr string
+	}{
+		{"no pattern or type", Rule{Category: models.TransactionCategoryGroceries}, "pattern or transactionType is required"},
+		{"unknown type", Rule{TransactionType: "REFUND", Category: models.TransactionCategoryGroceries}, "unknown transaction type"},
+		{"unknown category", Rule{Pattern: "coles", Category: "SNACKS"}, "unknown category"},
+		{"short mcc", Rule{Pattern: "coles", Category: models.TransactionCategoryGroceries, MCC: "541"}, "merchant category code"},
+		{"non-numeric mcc", Rule{Pattern: "coles", Category: models.TransactionCategoryGroceries, MCC: "54A1"}, "merchant category code"},
+		{"invalid pattern", Rule{Pattern: "coles(", Category: models.TransactionCategoryGroceries}, "invalid pattern"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRulesEnricher(Rule{Pattern: "woolworths", Category: models.TransactionCategoryGroceries}, tt.rule)
+			if err == nil || !strings.Contains(err.Error(), "rule 1: "+tt.wantErr) {
+				t.Errorf("NewRulesEnricher() error = %v, want rule 1: %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRulesEnricher_Precedence(t *testing.T) {
+	enricher, err := NewRulesEnricher(
+		Rule{TransactionType: models.TransactionTypeFee, Category: models.TransactionCategoryFees},
+		Rule{Pattern: "uber\\s*eats", Category: models.TransactionCategoryDining, MCC: "5812", Merchant: "Uber Eats"},
+		Rule{Pattern: "\\buber\\b", Category: models.TransactionCategoryTransport, MCC: "4121", Merchant: "Uber"},
+		Rule{Pattern: "salary", TransactionType: models.TransactionTypeCredit, Category: models.TransactionCategorySalary},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		tx           models.Transaction
+		wantCategory models.TransactionCategory
+		wantMCC      string
+		wantMerchant string
+	}{
+		{
+			name:         "earlier rule wins",
+			tx:           models.Transaction{TransactionType: models.TransactionTypeDebit, MerchantName: "UBER EATS SYDNEY"},
+			wantCategory: models.TransactionCategoryDining, wantMCC: "5812", wantMerchant: "Uber Eats",
+		},
+		{
+			name:         "later rule when earlier ones miss",
+			tx:           models.Transaction{TransactionType: models.TransactionTypeDebit, MerchantName: "UBER *TRIP"},
+			wantCategory: models.TransactionCategoryTransport, wantMCC: "4121", wantMerchant: "Uber",
+		},
+		{
+			name:         "type rule before pattern rules",
+			tx:           models.Transaction{TransactionType: models.TransactionTypeFee, Description: "Uber Eats late fee"},
+			wantCategory: models.TransactionCategoryFees,
+		},
+		{
+			name:         "description matched",
+			tx:           models.Transaction{TransactionType: models.TransactionTypeCredit, Description: "ACME Salary"},
+			wantCategory: models.TransactionCategorySalary,
+		},
+		{
+			name:         "type restriction",
+			tx:           models.Transaction{TransactionType: models.TransactionTypeDebit, Description: "Salary refund"},
+			wantCategory: "",
+		},
+		{
+			name:         "no match keeps a cleaned merchant name",
+			tx:           models.Transaction{TransactionType: models.TransactionTypeDebit, MerchantName: "SQ *LOCAL BAKERY 1234"},
+			wantCategory: "", wantMerchant: "Local Bakery",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := tt.tx
+			if err := enricher.Enrich(context.Background(), &tx); err != nil {
+				t.Fatal(err)
+			}
+			if tx.Category != tt.wantCategory || tx.MerchantCategoryCode != tt.wantMCC || tx.CleanMerchantName != tt.wantMerchant {
+				t.Errorf("enriched = %q, %q, %q; want %q, %q, %q",
+					tx.Category, tx.MerchantCategoryCode, tx.CleanMerchantName, tt.wantCategory, tt.wantMCC, tt.wantMerchant)
+			}
+		})
+	}
+}
+
+func TestDefaultRules(t *testing.T) {
+	enricher := NewDefaultRulesEnricher()
+
+	for _, rule := range enricher.rules {
+		if rule.MCC != "" && !models.ValidMCC(rule.MCC) {
+			t.Errorf("bundled rule %q has merchant category code %q", rule.Pattern, rule.MCC)
+		}
+	}
+
+	tx := models.Transaction{TransactionType: models.TransactionTypeDebit, MerchantName: "WOOLWORTHS 1234 SYDNEY"}
+	if err := enricher.Enrich(context.Background(), &tx); err != nil {
+		t.Fatal(err)
+	}
+	if tx.Category != models.TransactionCategoryGroceries || tx.MerchantCategoryCode != "5411" || tx.CleanMerchantName != "Woolworths" {
+		t.Errorf("enriched = %q, %q, %q; want GROCERIES, 5411, Woolworths", tx.Category, tx.MerchantCategoryCode, tx.CleanMerchantName)
+	}
+}
+
+func TestLoadRulesEnricherFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	valid := write("valid.json", `{"rules": [{"pattern": "coles", "category": "GROCERIES", "mcc": "5411"}]}`)
+	if enricher, err := LoadRulesEnricherFile(valid); err != nil || len(enricher.rules) != 1 {
+		t.Fatalf("LoadRulesEnricherFile() = %v, %v", enricher, err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{"missing file", filepath.Join(dir, "missing.json"), ""},
+		{"malformed JSON", write("malformed.json", `{"rules": [`), "invalid rules file"},
+		{"invalid mcc", write("mcc.json", `{"rules": [{"pattern": "coles", "category": "GROCERIES", "mcc": "54111"}]}`), "invalid rules file: rule 0: merchant category code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRulesEnricherFile(tt.path)
+			if err == nil {
+				t.Fatal("LoadRulesEnricherFile() should fail")
+			}
+			if tt.wantErr == "" && !errors.Is(err, fs.ErrNotExist) {
+				t.Errorf("error = %v, want fs.ErrNotExist", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCleanMerchantName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"WOOLWORTHS 1234 SYDNEY", "Woolworths 1234 Sydney"},
+		{"SQ *LOCAL  BAKERY #12", "Local Bakery"},
+		{"PAYPAL *NETFLIX", "Netflix"},
+		{"BP CONNECT 4021", "BP Connect"},
+		{"Local Cafe", "Local Cafe"},
+	}
+	for _, tt := range tests {
+		if got := CleanMerchantName(tt.name); got != tt.want {
+			t.Errorf("CleanMerchantName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package fx
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/shopspring/decimal"
+)
+
+//go:embed rates.json
+var defaultFixture []byte
+
+// ErrRateUnavailable is returned, wrapped, for pairs the provider cannot
+// price. It is domains.ErrRateUnavailable, so APIs can report it as a
+// request error without importing this package.
+var ErrRateUnavailable = domains.ErrRateUnavailable
+
+// StaticProvider implements domains.FXService from a fixed set of rates.
+// Pairs that are not quoted directly are resolved through their inverse or
+// through a single intermediate currency (e.g. AUD/EUR via USD).
+type StaticProvider struct {
+	rates map[string]*models.ExchangeRate
+}
+
+// Ensure StaticProvider implements the FX domain interface
+var _ domains.FXService = (*StaticProvider)(nil)
+
+// NewStaticProvider creates a provider from the given rates
+func NewStaticProvider(rates ...*models.ExchangeRate) *StaticProvider {
+	p := &StaticProvider{rates: make(map[string]*models.ExchangeRate)}
+	for _, rate := range rates {
+		p.rates[rate.Pair()] = rate
+	}
+	return p
+}
+
+// NewDefaultStaticProvider creates a provider from the bundled rate fixture
+func NewDefaultStaticProvider() *StaticProvider {
+	p, err := LoadStaticProvider(bytes.NewReader(defaultFixture))
+	if err != nil {
+		panic(fmt.Sprintf("invalid bundled rate fixture: %v", err))
+	}
+	return p
+}
+
+// fixture is the JSON layout of a rate fixture file
+type fixture struct {
+	Source    string    `json:"source"`
+	Timestamp time.Time `json:"timestamp"`
+	Rates     []struct {
+		BaseCurrency  string          `json:"baseCurrency"`
+		QuoteCurrency string          `json:"quoteCurrency"`
+		Rate          decimal.Decimal `json:"rate"`
+		Timestamp     *time.Time      `json:"timestamp,omitempty"`
+	} `json:"rates"`
+}
+
+// LoadStaticProvider creates a provider from a JSON rate fixture
+func LoadStaticProvider(r io.Reader) (*StaticProvider, error) {
+	var f fixture
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, fmt.Errorf("invalid rate fixture: %w", err)
+	}
+
+	rates := make([]*models.ExchangeRate, 0, len(f.Rates))
+	for _, entry := range f.Rates {
+		timestamp := f.Timestamp
+		if entry.Timestamp != nil {
+			timestamp = *entry.Timestamp
+		}
+		rate, err := models.NewExchangeRate(entry.BaseCurrency, entry.QuoteCurrency, entry.Rate, timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate fixture entry %s/%s: %w", entry.BaseCurrency, entry.QuoteCurrency, err)
+		}
+		rate.Source = f.Source
+		rates = append(rates, rate)
+	}
+	return NewStaticProvider(rates...), nil
+}
+
+// LoadStaticProviderFile creates a provider from a JSON rate fixture on disk
+func LoadStaticProviderFile(path string) (*StaticProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadStaticProvider(file)
+}
+
+// RetrieveExchangeRate returns the rate for a currency pair
+func (p *StaticProvider) RetrieveExchangeRate(ctx context.Context, baseCurrency, quoteCurrency string) (*models.ExchangeRate, error) {
+	baseCurrency = strings.ToUpper(baseCurrency)
+	quoteCurrency = strings.ToUpper(quoteCurrency)
+
+	if baseCurrency == quoteCurrency {
+		return models.NewExchangeRate(baseCurrency, quoteCurrency, decimal.NewFromInt(1), time.Now())
+	}
+
+	if rate, ok := p.lookup(baseCurrency, quoteCurrency); ok {
+		return rate, nil
+	}
+
+	// Cross rate through a single intermediate currency
+	for _, intermediate := range p.currencies() {
+		first, ok := p.lookup(baseCurrency, intermediate)
+		if !ok {
+			continue
+		}
+		second, ok := p.lookup(intermediate, quoteCurrency)
+		if !ok {
+			continue
+		}
+		timestamp := first.Timestamp
+		if second.Timestamp.Before(timestamp) {
+			timestamp = second.Timestamp
+		}
+		return &models.ExchangeRate{
+			BaseCurrency:  baseCurrency,
+			QuoteCurrency: quoteCurrency,
+			Rate:          first.Rate.Mul(second.Rate).Round(16),
+			Timestamp:     timestamp,
+			Source:        first.Source,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s/%s", ErrRateUnavailable, baseCurrency, quoteCurrency)
+}
+
+// RetrieveExchangeRates returns rates from baseCurrency to every other known currency
+func (p *StaticProvider) RetrieveExchangeRates(ctx context.Context, baseCurrency string) ([]*models.ExchangeRate, error) {
+	baseCurrency = strings.ToUpper(baseCurrency)
+	if _, err := models.CurrencyExponent(baseCurrency); err != nil {
+		return nil, err
+	}
+
+	var rates []*models.ExchangeRate
+	for _, currency := range p.currencies() {
+		if currency == baseCurrency {
+			continue
+		}
+		rate, err := p.RetrieveExchangeRate(ctx, baseCurrency, currency)
+		if err != nil {
+			continue
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+// ConvertAmount converts amount into quoteCurrency at the current rate
+func (p *StaticProvider) ConvertAmount(ctx context.Context, amount models.Money, quoteCurrency string) (models.Money, *models.ExchangeRate, error) {
+	rate, err := p.RetrieveExchangeRate(ctx, amount.Currency, quoteCurrency)
+	if err != nil {
+		return models.Money{}, nil, err
+	}
+	converted, err := rate.Convert(amount)
+	if err != nil {
+		return models.Money{}, nil, err
+	}
+	return converted, rate, nil
+}
+
+// lookup finds a directly quoted rate or the inverse of one
+func (p *StaticProvider) lookup(baseCurrency, quoteCurrency string) (*models.ExchangeRate, bool) {
+	if rate, ok := p.rates[baseCurrency+"/"+quoteCurrency]; ok {
+		return rate, true
+	}
+	if rate, ok := p.rates[quoteCurrency+"/"+baseCurrency]; ok {
+		return rate.Inverse(), true
+	}
+	return nil, false
+}
+
+// currencies returns every currency that appears in a quoted pair, sorted
+func (p *StaticProvider) currencies() []string {
+	seen := make(map[string]bool)
+	for _, rate := range p.rates {
+		seen[rate.BaseCurrency] = true
+		seen[rate.QuoteCurrency] = true
+	}
+	result := make([]string, 0, len(seen))
+	for currency := range seen {
+		result = append(result, currency)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,146 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/shopspring/decimal"
+)
+
+func TestStaticProvider_RetrieveExchangeRate(t *testing.T) {
+	ctx := context.Background()
+	p := NewDefaultStaticProvider()
+	usdAUD := decimal.RequireFromString("1.5230")
+	usdEUR := decimal.RequireFromString("0.9210")
+
+	tests := []struct {
+		name  string
+		base  string
+		quote string
+		want  decimal.Decimal
+	}{
+		{"direct", "USD", "AUD", usdAUD},
+		{"lower case", "usd", "aud", usdAUD},
+		{"inverse", "AUD", "USD", decimal.NewFromInt(1).DivRound(usdAUD, 16)},
+		{"cross through USD", "AUD", "EUR", decimal.NewFromInt(1).DivRound(usdAUD, 16).Mul(usdEUR).Round(16)},
+		{"same currency", "KWD", "KWD", decimal.NewFromInt(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := p.RetrieveExchangeRate(ctx, tt.base, tt.quote)
+			if err != nil {
+				t.Fatalf("RetrieveExchangeRate() error = %v", err)
+			}
+			if rate.Pair() != strings.ToUpper(tt.base+"/"+tt.quote) {
+				t.Errorf("pair = %s, want %s/%s", rate.Pair(), tt.base, tt.quote)
+			}
+			if !rate.Rate.Equal(tt.want) {
+				t.Errorf("rate = %s, want %s", rate.Rate, tt.want)
+			}
+		})
+	}
+
+	_, err := p.RetrieveExchangeRate(ctx, "AUD", "KWD")
+	if !errors.Is(err, ErrRateUnavailable) || !errors.Is(err, domains.ErrRateUnavailable) {
+		t.Errorf("RetrieveExchangeRate(AUD/KWD) error = %v, want ErrRateUnavailable", err)
+	}
+	if errors.Is(err, domains.ErrNotFound) {
+		t.Error("an unavailable rate must not read as a missing resource")
+	}
+}
+
+func TestStaticProvider_CrossRateTimestamp(t *testing.T) {
+	older := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	audUSD, _ := models.NewExchangeRate("AUD", "USD", decimal.RequireFromString("0.65"), newer)
+	usdJPY, _ := models.NewExchangeRate("USD", "JPY", decimal.RequireFromString("150"), older)
+
+	rate, err := NewStaticProvider(audUSD, usdJPY).RetrieveExchangeRate(context.Background(), "AUD", "JPY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rate.Rate.Equal(decimal.RequireFromString("97.5")) {
+		t.Errorf("rate = %s, want 97.5", rate.Rate)
+	}
+	// A cross rate is only as fresh as its older leg
+	if !rate.Timestamp.Equal(older) {
+		t.Errorf("timestamp = %s, want %s", rate.Timestamp, older)
+	}
+}
+
+func TestStaticProvider_ConvertAmount(t *testing.T) {
+	ctx := context.Background()
+	p := NewDefaultStaticProvider()
+
+	usd, _ := models.NewMoneyFromString("10.01", "USD")
+	aud, rate, err := p.ConvertAmount(ctx, usd, "AUD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 10.01 x 1.5230 = 15.24523, rounded to cents
+	if aud.Currency != "AUD" || !aud.Amount.Equal(decimal.RequireFromString("15.25")) || rate.Pair() != "USD/AUD" {
+		t.Errorf("ConvertAmount() = %v at %s", aud, rate.Pair())
+	}
+
+	rates, err := p.RetrieveExchangeRates(ctx, "USD")
+	if err != nil || len(rates) != 10 {
+		t.Errorf("RetrieveExchangeRates(USD) = %d rates, %v; want 10", len(rates), err)
+	}
+	if _, err := p.RetrieveExchangeRates(ctx, "XYZ"); err == nil {
+		t.Error("RetrieveExchangeRates() should reject an unknown currency")
+	}
+}
+
+func TestLoadStaticProvider(t *testing.T) {
+	p, err := LoadStaticProvider(strings.NewReader(`{
+		"source": "ECB",
+		"timestamp": "2026-01-02T00:00:00Z",
+		"rates": [
+			{"baseCurrency": "EUR", "quoteCurrency": "GBP", "rate": "0.8567"},
+			{"baseCurrency": "EUR", "quoteCurrency": "CHF", "rate": "0.9565", "timestamp": "2026-01-03T00:00:00Z"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("LoadStaticProvider() error = %v", err)
+	}
+	gbp, err := p.RetrieveExchangeRate(context.Background(), "EUR", "GBP")
+	if err != nil || gbp.Source != "ECB" || !gbp.Timestamp.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("EUR/GBP = %+v, %v; want the fixture source and timestamp", gbp, err)
+	}
+	chf, err := p.RetrieveExchangeRate(context.Background(), "EUR", "CHF")
+	if err != nil || !chf.Timestamp.Equal(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("EUR/CHF = %+v, %v; want the entry's own timestamp", chf, err)
+	}
+
+	invalid := map[string]string{
+		"malformed JSON":   `{"rates": [`,
+		"unknown currency": `{"rates": [{"baseCurrency": "EUR", "quoteCurrency": "XYZ", "rate": "1"}]}`,
+		"zero rate":        `{"rates": [{"baseCurrency": "EUR", "quoteCurrency": "GBP", "rate": "0"}]}`,
+	}
+	for name, fixture := range invalid {
+		if _, err := LoadStaticProvider(strings.NewReader(fixture)); err == nil {
+			t.Errorf("LoadStaticProvider(%s) should fail", name)
+		}
+	}
+}
+
+func TestLoadStaticProviderFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(`{"rates": [{"baseCurrency": "USD", "quoteCurrency": "AUD", "rate": "1.5"}]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadStaticProviderFile(path); err != nil {
+		t.Errorf("LoadStaticProviderFile() error = %v", err)
+	}
+	if _, err := LoadStaticProviderFile(filepath.Join(t.TempDir(), "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadStaticProviderFile(missing) error = %v, want os.ErrNotExist", err)
+	}
+}
@@ -0,0 +1,181 @@
+package mock
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/shopspring/decimal"
+)
+
+// EventSource implementation
+func (p *Provider) Subscribe(ctx context.Context, filter domains.EventFilter) (<-chan models.Event, error) {
+	return p.events.Subscribe(ctx, filter)
+}
+
+// PostTransaction books a transaction against its account, updates the
+// current and available balances and emits TRANSACTION_POSTED and
+// BALANCE_CHANGED events. A missing ID or posting/value date is filled in.
+func (p *Provider) PostTransaction(tx *models.Transaction) error {
+	p.mu.Lock()
+
+	account, exists := p.accounts[tx.AccountID]
+	if !exists {
+		p.mu.Unlock()
+		return fmt.Errorf("account %w: %s", domains.ErrNotFound, tx.AccountID)
+	}
+	if tx.Amount.Currency != account.Currency {
+		p.mu.Unlock()
+		return fmt.Errorf("transaction currency %s does not match account currency %s", tx.Amount.Currency, account.Currency)
+	}
+
+	now := time.Now()
+	if tx.ID == "" {
+		tx.ID = p.nextTransactionID()
+	} else if _, exists := p.transactions[tx.ID]; exists {
+		p.mu.Unlock()
+		return fmt.Errorf("transaction already exists: %s", tx.ID)
+	}
+	if tx.PostingDate.IsZero() {
+		tx.PostingDate = now
+	}
+	if tx.ValueDate.IsZero() {
+		tx.ValueDate = tx.PostingDate
+	}
+	if err := tx.Validate(); err != nil {
+		p.mu.Unlock()
+		return err
+	}
+
+	// Replace rather than mutate balances so readers holding the old values are unaffected
+	var changed []*models.Balance
+	balances := make([]*models.Balance, 0, len(p.balances[tx.AccountID]))
+	for _, balance := range p.balances[tx.AccountID] {
+		updated := *balance
+		if balance.BalanceType == models.BalanceTypeCurrent || balance.BalanceType == models.BalanceTypeAvailable {
+			amount, err := balance.Amount.Add(tx.Amount)
+			if err != nil {
+				p.mu.Unlock()
+				return err
+			}
+			updated.Amount = amount
+			updated.Timestamp = now
+			changed = append(changed, &updated)
+		}
+		if balance.BalanceType == models.BalanceTypeCurrent {
+			runningBalance := updated.Amount
+			tx.RunningBalance = &runningBalance
+		}
+		balances = append(balances, &updated)
+	}
+
+	p.balances[tx.AccountID] = balances
+	p.transactions[tx.ID] = tx
+	p.mu.Unlock()
+
+	p.events.Publish(models.Event{
+		Type:        models.EventTypeTransactionPosted,
+		AccountID:   tx.AccountID,
+		Transaction: tx,
+		Timestamp:   now,
+	})
+	for _, balance := range changed {
+		p.events.Publish(models.Event{
+			Type:      models.EventTypeBalanceChanged,
+			AccountID: tx.AccountID,
+			Balance:   balance,
+			Timestamp: now,
+		})
+	}
+	return nil
+}
+
+// UpdateConsentStatus changes a consent's status and emits a
+// CONSENT_STATUS_CHANGED event. Revoking sets the revocation date.
+func (p *Provider) UpdateConsentStatus(consentID string, status models.ConsentStatus) error {
+	if !status.IsValid() {
+		return fmt.Errorf("invalid consent status: %s", status)
+	}
+
+	p.mu.Lock()
+	consent, exists := p.consents[consentID]
+	if !exists {
+		p.mu.Unlock()
+		return fmt.Errorf("consent %w: %s", domains.ErrNotFound, consentID)
+	}
+	if consent.Status == status {
+		p.mu.Unlock()
+		return nil
+	}
+
+	now := time.Now()
+	updated := *consent
+	updated.Status = status
+	if status == models.ConsentStatusRevoked && updated.RevocationDate == nil {
+		updated.RevocationDate = &now
+	}
+	p.consents[consentID] = &updated
+	p.mu.Unlock()
+
+	p.events.Publish(models.Event{
+		Type:      models.EventTypeConsentStatusChanged,
+		Consent:   &updated,
+		Timestamp: now,
+	})
+	return nil
+}
+
+// Simulate posts a random card transaction to a random account every interval
+// until ctx is cancelled, which is handy for exercising live subscriptions
+func (p *Provider) Simulate(ctx context.Context, interval time.Duration) {
+	merchants := []string{"Woolworths", "Coles", "Shell", "Uber", "JB Hi-Fi", "Bunnings"}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		p.mu.RLock()
+		accountIDs := make([]string, 0, len(p.accounts))
+		for id := range p.accounts {
+			accountIDs = append(accountIDs, id)
+		}
+		sort.Strings(accountIDs)
+		account := p.accounts[accountIDs[rng.Intn(len(accountIDs))]]
+		p.mu.RUnlock()
+
+		merchant := merchants[rng.Intn(len(merchants))]
+		amount, err := models.NewMoney(decimal.New(-int64(rng.Intn(10000)+100), -2), account.Currency)
+		if err != nil {
+			continue
+		}
+
+		p.PostTransaction(&models.Transaction{
+			TransactionType: models.TransactionTypeDebit,
+			Amount:          amount,
+			Description:     "Card purchase",
+			MerchantName:    merchant,
+			AccountID:       account.ID,
+		})
+	}
+}
+
+// nextTransactionID returns an unused sequential transaction ID; callers must hold p.mu
+func (p *Provider) nextTransactionID() string {
+	for n := len(p.transactions) + 1; ; n++ {
+		id := fmt.Sprintf("tx-%03d", n)
+		if _, exists := p.transactions[id]; !exists {
+			return id
+		}
+	}
+}
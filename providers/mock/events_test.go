@@ -0,0 +1,157 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+// drain returns the events waiting on events without blocking for more
+func drain(events <-chan models.Event) []models.Event {
+	var received []models.Event
+	for {
+		select {
+		case event := <-events:
+			received = append(received, event)
+		case <-time.After(20 * time.Millisecond):
+			return received
+		}
+	}
+}
+
+func debit(accountID, amount, currency string) *models.Transaction {
+	money, err := models.NewMoneyFromString(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return &models.Transaction{
+		AccountID:       accountID,
+		TransactionType: models.TransactionTypeDebit,
+		Amount:          money,
+		Description:     "Test debit",
+	}
+}
+
+func TestPostTransaction(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := NewProvider()
+
+	before, _ := p.RetrieveCurrentAccountBalance(ctx, "acc-001")
+	events, _ := p.Subscribe(ctx, domains.EventFilter{AccountID: "acc-001"})
+
+	tx := debit("acc-001", "-12.34", "AUD")
+	if err := p.PostTransaction(tx); err != nil {
+		t.Fatalf("PostTransaction() error = %v", err)
+	}
+
+	// Missing fields are filled in and the transaction is retrievable
+	if tx.ID == "" || tx.PostingDate.IsZero() || !tx.ValueDate.Equal(tx.PostingDate) {
+		t.Errorf("posted transaction = %+v, want an ID and dates", tx)
+	}
+	if stored, err := p.RetrievePaymentTransaction(ctx, tx.ID); err != nil || stored.ID != tx.ID {
+		t.Errorf("RetrievePaymentTransaction(%s) = %v, %v", tx.ID, stored, err)
+	}
+
+	// Current and available balances move by the amount; the running balance matches
+	after, _ := p.RetrieveCurrentAccountBalance(ctx, "acc-001")
+	want := before.Amount.Amount.Add(tx.Amount.Amount)
+	if !after.Amount.Amount.Equal(want) || !tx.RunningBalance.Amount.Equal(want) {
+		t.Errorf("balance = %s, running balance = %s, want %s", after.Amount.Amount, tx.RunningBalance.Amount, want)
+	}
+	// Balances held by earlier readers are replaced, not mutated
+	if before.Amount.Amount.Equal(want) {
+		t.Error("PostTransaction modified a previously returned balance")
+	}
+
+	received := drain(events)
+	if len(received) != 3 {
+		t.Fatalf("received %d events, want TRANSACTION_POSTED and two BALANCE_CHANGED", len(received))
+	}
+	if received[0].Type != models.EventTypeTransactionPosted || received[0].Transaction.ID != tx.ID {
+		t.Errorf("first event = %s %+v", received[0].Type, received[0].Transaction)
+	}
+	changed := map[models.BalanceType]bool{}
+	for _, event := range received[1:] {
+		if event.Type != models.EventTypeBalanceChanged || event.AccountID != "acc-001" {
+			t.Errorf("event = %s for %s, want BALANCE_CHANGED for acc-001", event.Type, event.AccountID)
+		}
+		changed[event.Balance.BalanceType] = true
+	}
+	if !changed[models.BalanceTypeCurrent] || !changed[models.BalanceTypeAvailable] {
+		t.Errorf("changed balances = %v, want CURRENT and AVAILABLE", changed)
+	}
+}
+
+func TestPostTransaction_Errors(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := NewProvider()
+	events, _ := p.Subscribe(ctx, domains.EventFilter{})
+
+	if err := p.PostTransaction(debit("acc-999", "-1.00", "AUD")); !errors.Is(err, domains.ErrNotFound) {
+		t.Errorf("unknown account error = %v, want ErrNotFound", err)
+	}
+	if err := p.PostTransaction(debit("acc-001", "-1.00", "USD")); err == nil {
+		t.Error("PostTransaction() should reject a currency that differs from the account's")
+	}
+	duplicate := debit("acc-001", "-1.00", "AUD")
+	duplicate.ID = "tx-001"
+	if err := p.PostTransaction(duplicate); err == nil {
+		t.Error("PostTransaction() should reject an existing transaction ID")
+	}
+	invalid := debit("acc-001", "-1.00", "AUD")
+	invalid.TransactionType = "REFUND"
+	if err := p.PostTransaction(invalid); err == nil {
+		t.Error("PostTransaction() should reject an invalid transaction")
+	}
+
+	// Rejected postings emit nothing
+	if received := drain(events); len(received) != 0 {
+		t.Errorf("received %d events for rejected postings", len(received))
+	}
+}
+
+func TestUpdateConsentStatus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := NewProvider()
+
+	before, _ := p.RetrieveConsent(ctx, "consent-001")
+	events, _ := p.Subscribe(ctx, domains.EventFilter{ConsentID: "consent-001"})
+
+	if err := p.UpdateConsentStatus("consent-001", models.ConsentStatusRevoked); err != nil {
+		t.Fatalf("UpdateConsentStatus() error = %v", err)
+	}
+	after, _ := p.RetrieveConsent(ctx, "consent-001")
+	if after.Status != models.ConsentStatusRevoked || after.RevocationDate == nil {
+		t.Errorf("consent = %s revoked %v, want REVOKED with a revocation date", after.Status, after.RevocationDate)
+	}
+	if before.Status != models.ConsentStatusActive {
+		t.Error("UpdateConsentStatus() modified a previously returned consent")
+	}
+
+	received := drain(events)
+	if len(received) != 1 || received[0].Type != models.EventTypeConsentStatusChanged || received[0].Consent.Status != models.ConsentStatusRevoked {
+		t.Fatalf("events = %+v, want one CONSENT_STATUS_CHANGED to REVOKED", received)
+	}
+
+	// Setting the current status again is a no-op without an event
+	if err := p.UpdateConsentStatus("consent-001", models.ConsentStatusRevoked); err != nil {
+		t.Errorf("repeat update error = %v", err)
+	}
+	if received := drain(events); len(received) != 0 {
+		t.Errorf("repeat update emitted %d events", len(received))
+	}
+
+	if err := p.UpdateConsentStatus("consent-999", models.ConsentStatusActive); !errors.Is(err, domains.ErrNotFound) {
+		t.Errorf("unknown consent error = %v, want ErrNotFound", err)
+	}
+	if err := p.UpdateConsentStatus("consent-001", "PAUSED"); err == nil {
+		t.Error("UpdateConsentStatus() should reject an unknown status")
+	}
+}
@@ -0,0 +1,154 @@
+package mock
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/models/interest"
+)
+
+// WithInterestSimulation posts the interest and periodic fees the sample
+// accounts' products would have produced over the last days days, so
+// statements and projections have realistic interest and fee history
+func WithInterestSimulation(days int) Option {
+	return func(p *Provider) {
+		// Sample products always have valid frequencies and currencies
+		_ = p.SimulateDays(time.Now().AddDate(0, 0, -days), days)
+	}
+}
+
+// SimulateDays accrues a day's interest on every account with a product for
+// each day from start, and posts transactions through PostTransaction:
+// interest earned (CREDIT) or charged (DEBIT) at the end of each month, and
+// PERIODIC fees (FEE) on their due dates. Credit balances accrue at the
+// product's deposit rates and debit balances at its PURCHASE lending rate,
+// both ACT/365. Accrual uses each account's current balance, which already
+// includes everything posted so far.
+func (p *Provider) SimulateDays(start time.Time, days int) error {
+	p.mu.RLock()
+	var accounts []*models.Account
+	products := make(map[string]*models.Product)
+	for _, account := range p.accounts {
+		if product, exists := p.products[account.ProductID]; exists {
+			accounts = append(accounts, account)
+			products[account.ID] = product
+		}
+	}
+	p.mu.RUnlock()
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].ID < accounts[j].ID })
+
+	type accruals struct {
+		earned  *interest.Accrual
+		charged *interest.Accrual
+	}
+	byAccount := make(map[string]accruals, len(accounts))
+	for _, account := range accounts {
+		product := products[account.ID]
+		byAccount[account.ID] = accruals{
+			earned: interest.Calculator{
+				Bands:    interest.DepositBands(product.DepositRates),
+				DayCount: interest.Actual365,
+			}.NewAccrual(account.Currency),
+			charged: interest.Calculator{
+				Bands:    interest.LendingBands(product.LendingRates, models.LendingRatePurchase),
+				DayCount: interest.Actual365,
+			}.NewAccrual(account.Currency),
+		}
+	}
+
+	year, month, day := start.Date()
+	date := time.Date(year, month, day, 0, 0, 0, 0, start.Location())
+	for i := 0; i < days; i++ {
+		for _, account := range accounts {
+			balance, err := p.currentBalance(account.ID)
+			if err != nil {
+				return err
+			}
+
+			accrual := byAccount[account.ID]
+			if balance.IsNegative() {
+				err = accrual.charged.AccrueDay(balance, date)
+			} else {
+				err = accrual.earned.AccrueDay(balance, date)
+			}
+			if err != nil {
+				return err
+			}
+
+			if interest.IsMonthEnd(date) {
+				if err := p.postInterest(account.ID, accrual.earned.Capitalise(), "Interest Payment", date); err != nil {
+					return err
+				}
+				if err := p.postInterest(account.ID, accrual.charged.Capitalise(), "Interest Charged", date); err != nil {
+					return err
+				}
+			}
+
+			if err := p.postPeriodicFees(account, products[account.ID], balance, date); err != nil {
+				return err
+			}
+		}
+		date = date.AddDate(0, 0, 1)
+	}
+	return nil
+}
+
+// postInterest posts capitalised interest, skipping months where none accrued
+func (p *Provider) postInterest(accountID string, amount models.Money, description string, date time.Time) error {
+	if amount.IsZero() {
+		return nil
+	}
+	txType := models.TransactionTypeCredit
+	if amount.IsNegative() {
+		txType = models.TransactionTypeDebit
+	}
+	return p.PostTransaction(&models.Transaction{
+		TransactionType: txType,
+		Amount:          amount,
+		Description:     description,
+		MerchantName:    "Bank Interest",
+		PostingDate:     date,
+		AccountID:       accountID,
+	})
+}
+
+// postPeriodicFees posts the product's PERIODIC fees that fall due on date
+func (p *Provider) postPeriodicFees(account *models.Account, product *models.Product, balance models.Money, date time.Time) error {
+	for _, fee := range product.Fees {
+		due, err := interest.FeeDue(fee, account.OpenDate, date)
+		if err != nil {
+			return fmt.Errorf("product %s fee %q: %w", product.ID, fee.Name, err)
+		}
+		if !due {
+			continue
+		}
+		amount, err := interest.FeeAmount(fee, balance)
+		if err != nil {
+			return fmt.Errorf("product %s fee %q: %w", product.ID, fee.Name, err)
+		}
+		if err := p.PostTransaction(&models.Transaction{
+			TransactionType: models.TransactionTypeFee,
+			Amount:          amount,
+			Description:     fee.Name,
+			PostingDate:     date,
+			AccountID:       account.ID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// currentBalance returns the account's CURRENT balance
+func (p *Provider) currentBalance(accountID string) (models.Money, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, balance := range p.balances[accountID] {
+		if balance.BalanceType == models.BalanceTypeCurrent {
+			return balance.Amount, nil
+		}
+	}
+	return models.Money{}, fmt.Errorf("account %s has no current balance", accountID)
+}
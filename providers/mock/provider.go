@@ -3,10 +3,15 @@ package mock
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/serverlesscloud/bian-go/domains"
 	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/models/identifiers"
+	"github.com/serverlesscloud/bian-go/providers/fx"
 	"github.com/shopspring/decimal"
 )
 
@@ -16,17 +21,42 @@ type Provider struct {
 	transactions map[string]*models.Transaction
 	balances     map[string][]*models.Balance
 	consents     map[string]*models.Consent
+	customers    map[string][]string
+	cards        map[string]*models.Card
+	scheduled    map[string]*models.ScheduledPayment
+	payees       map[string]*models.Payee
+	products     map[string]*models.Product
+	fx           *fx.StaticProvider
+	events       *domains.EventBus
+	
+	// mu guards the maps above; PostTransaction, UpdateConsentStatus and the
+	// payee writes may run concurrently with reads
+	mu sync.RWMutex
 }
 
+// Option configures a mock provider. Options run after the sample data is
+// loaded, so they can build on it.
+type Option func(*Provider)
+
 // NewProvider creates a new mock provider with sample data
-func NewProvider() *Provider {
+func NewProvider(opts ...Option) *Provider {
 	p := &Provider{
 		accounts:     make(map[string]*models.Account),
 		transactions: make(map[string]*models.Transaction),
 		balances:     make(map[string][]*models.Balance),
 		consents:     make(map[string]*models.Consent),
+		customers:    make(map[string][]string),
+		cards:        make(map[string]*models.Card),
+		scheduled:    make(map[string]*models.ScheduledPayment),
+		payees:       make(map[string]*models.Payee),
+		products:     make(map[string]*models.Product),
+		fx:           fx.NewDefaultStaticProvider(),
+		events:       domains.NewEventBus(),
 	}
 	p.loadSampleData()
+	for _, opt := range opts {
+		opt(p)
+	}
 	return p
 }
 
@@ -35,20 +65,35 @@ var _ domains.AccountService = (*Provider)(nil)
 var _ domains.TransactionService = (*Provider)(nil)
 var _ domains.BalanceService = (*Provider)(nil)
 var _ domains.ConsentService = (*Provider)(nil)
+var _ domains.AccountConsentRetriever = (*Provider)(nil)
+var _ domains.CustomerService = (*Provider)(nil)
+var _ domains.FXService = (*Provider)(nil)
+var _ domains.CardService = (*Provider)(nil)
+var _ domains.StandingOrderService = (*Provider)(nil)
+var _ domains.DirectDebitService = (*Provider)(nil)
+var _ domains.PayeeService = (*Provider)(nil)
+var _ domains.ProductService = (*Provider)(nil)
+var _ domains.EventSource = (*Provider)(nil)
 
 // AccountService implementation
 func (p *Provider) RetrieveCurrentAccount(ctx context.Context, accountID string) (*models.Account, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
 	account, exists := p.accounts[accountID]
 	if !exists {
-		return nil, fmt.Errorf("account not found: %s", accountID)
+		return nil, fmt.Errorf("account %w: %s", domains.ErrNotFound, accountID)
 	}
 	return account, nil
 }
 
 func (p *Provider) RetrieveCurrentAccountBalance(ctx context.Context, accountID string) (*models.Balance, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
 	balances, exists := p.balances[accountID]
 	if !exists {
-		return nil, fmt.Errorf("account not found: %s", accountID)
+		return nil, fmt.Errorf("account %w: %s", domains.ErrNotFound, accountID)
 	}
 	
 	// Return current balance
@@ -58,22 +103,28 @@ func (p *Provider) RetrieveCurrentAccountBalance(ctx context.Context, accountID
 		}
 	}
 	
-	return nil, fmt.Errorf("current balance not found for account: %s", accountID)
+	return nil, fmt.Errorf("current balance %w for account: %s", domains.ErrNotFound, accountID)
 }
 
 // TransactionService implementation
 func (p *Provider) RetrievePaymentTransaction(ctx context.Context, transactionID string) (*models.Transaction, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
 	transaction, exists := p.transactions[transactionID]
 	if !exists {
-		return nil, fmt.Errorf("transaction not found: %s", transactionID)
+		return nil, fmt.Errorf("transaction %w: %s", domains.ErrNotFound, transactionID)
 	}
 	return transaction, nil
 }
 
 func (p *Provider) RetrievePaymentTransactionHistory(ctx context.Context, accountID string, opts domains.HistoryOptions) ([]*models.Transaction, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
 	// Check if account exists
 	if _, exists := p.accounts[accountID]; !exists {
-		return nil, fmt.Errorf("account not found: %s", accountID)
+		return nil, fmt.Errorf("account %w: %s", domains.ErrNotFound, accountID)
 	}
 	
 	var transactions []*models.Transaction
@@ -118,30 +169,330 @@ func (p *Provider) RetrievePaymentTransactionHistory(ctx context.Context, accoun
 
 // BalanceService implementation
 func (p *Provider) RetrieveAccountBalance(ctx context.Context, accountID string) ([]*models.Balance, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
 	balances, exists := p.balances[accountID]
 	if !exists {
-		return nil, fmt.Errorf("account not found: %s", accountID)
+		return nil, fmt.Errorf("account %w: %s", domains.ErrNotFound, accountID)
 	}
 	return balances, nil
 }
 
 // ConsentService implementation
 func (p *Provider) RetrieveConsent(ctx context.Context, consentID string) (*models.Consent, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
 	consent, exists := p.consents[consentID]
 	if !exists {
-		return nil, fmt.Errorf("consent not found: %s", consentID)
+		return nil, fmt.Errorf("consent %w: %s", domains.ErrNotFound, consentID)
 	}
 	return consent, nil
 }
 
 func (p *Provider) RetrieveConsentStatus(ctx context.Context, consentID string) (models.ConsentStatus, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
 	consent, exists := p.consents[consentID]
 	if !exists {
-		return "", fmt.Errorf("consent not found: %s", consentID)
+		return "", fmt.Errorf("consent %w: %s", domains.ErrNotFound, consentID)
 	}
 	return consent.Status, nil
 }
 
+// AccountConsentRetriever implementation
+func (p *Provider) RetrieveAccountConsents(ctx context.Context, accountID string) ([]*models.Consent, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	if _, exists := p.accounts[accountID]; !exists {
+		return nil, fmt.Errorf("account %w: %s", domains.ErrNotFound, accountID)
+	}
+	
+	consents := make([]*models.Consent, 0)
+	for _, consent := range p.consents {
+		for _, id := range consent.AccountIDs {
+			if id == accountID {
+				consents = append(consents, consent)
+				break
+			}
+		}
+	}
+	
+	sort.Slice(consents, func(i, j int) bool {
+		return consents[i].ID < consents[j].ID
+	})
+	return consents, nil
+}
+
+// CustomerService implementation
+func (p *Provider) RetrieveCustomerAccounts(ctx context.Context, customerID string) ([]*models.Account, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	accountIDs, exists := p.customers[customerID]
+	if !exists {
+		return nil, fmt.Errorf("customer %w: %s", domains.ErrNotFound, customerID)
+	}
+	
+	accounts := make([]*models.Account, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		if account, ok := p.accounts[accountID]; ok {
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts, nil
+}
+
+// FXService implementation (backed by the bundled rate fixture)
+func (p *Provider) RetrieveExchangeRate(ctx context.Context, baseCurrency, quoteCurrency string) (*models.ExchangeRate, error) {
+	return p.fx.RetrieveExchangeRate(ctx, baseCurrency, quoteCurrency)
+}
+
+func (p *Provider) RetrieveExchangeRates(ctx context.Context, baseCurrency string) ([]*models.ExchangeRate, error) {
+	return p.fx.RetrieveExchangeRates(ctx, baseCurrency)
+}
+
+func (p *Provider) ConvertAmount(ctx context.Context, amount models.Money, quoteCurrency string) (models.Money, *models.ExchangeRate, error) {
+	return p.fx.ConvertAmount(ctx, amount, quoteCurrency)
+}
+
+// CardService implementation
+func (p *Provider) RetrieveCard(ctx context.Context, cardID string) (*models.Card, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	card, exists := p.cards[cardID]
+	if !exists {
+		return nil, fmt.Errorf("card %w: %s", domains.ErrNotFound, cardID)
+	}
+	return p.withAvailableCredit(card), nil
+}
+
+func (p *Provider) RetrieveAccountCard(ctx context.Context, accountID string) (*models.Card, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	if _, exists := p.accounts[accountID]; !exists {
+		return nil, fmt.Errorf("account %w: %s", domains.ErrNotFound, accountID)
+	}
+	for _, card := range p.cards {
+		if card.AccountID == accountID {
+			return p.withAvailableCredit(card), nil
+		}
+	}
+	return nil, fmt.Errorf("card %w: account %s has no card", domains.ErrNotFound, accountID)
+}
+
+// withAvailableCredit returns a copy of the card with available credit
+// derived from the account's current balance, so posted transactions are
+// reflected. Callers must hold p.mu.
+func (p *Provider) withAvailableCredit(card *models.Card) *models.Card {
+	result := *card
+	if card.CreditFacility == nil {
+		return &result
+	}
+	facility := *card.CreditFacility
+	for _, balance := range p.balances[card.AccountID] {
+		if balance.BalanceType == models.BalanceTypeCurrent {
+			// Amounts owed are negative current balances
+			if available, err := facility.CreditLimit.Add(balance.Amount); err == nil {
+				facility.AvailableCredit = available
+			}
+		}
+	}
+	result.CreditFacility = &facility
+	return &result
+}
+
+// StandingOrderService implementation
+func (p *Provider) RetrieveStandingOrder(ctx context.Context, standingOrderID string) (*models.ScheduledPayment, error) {
+	return p.retrieveScheduledPayment(models.ScheduledPaymentTypeStandingOrder, "standing order", standingOrderID)
+}
+
+func (p *Provider) RetrieveAccountStandingOrders(ctx context.Context, accountID string) ([]*models.ScheduledPayment, error) {
+	return p.retrieveAccountScheduledPayments(models.ScheduledPaymentTypeStandingOrder, accountID)
+}
+
+// DirectDebitService implementation
+func (p *Provider) RetrieveDirectDebit(ctx context.Context, directDebitID string) (*models.ScheduledPayment, error) {
+	return p.retrieveScheduledPayment(models.ScheduledPaymentTypeDirectDebit, "direct debit", directDebitID)
+}
+
+func (p *Provider) RetrieveAccountDirectDebits(ctx context.Context, accountID string) ([]*models.ScheduledPayment, error) {
+	return p.retrieveAccountScheduledPayments(models.ScheduledPaymentTypeDirectDebit, accountID)
+}
+
+func (p *Provider) retrieveScheduledPayment(paymentType models.ScheduledPaymentType, resource, id string) (*models.ScheduledPayment, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	payment, exists := p.scheduled[id]
+	if !exists || payment.Type != paymentType {
+		return nil, fmt.Errorf("%s %w: %s", resource, domains.ErrNotFound, id)
+	}
+	return withNextExecution(payment, time.Now()), nil
+}
+
+func (p *Provider) retrieveAccountScheduledPayments(paymentType models.ScheduledPaymentType, accountID string) ([]*models.ScheduledPayment, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	if _, exists := p.accounts[accountID]; !exists {
+		return nil, fmt.Errorf("account %w: %s", domains.ErrNotFound, accountID)
+	}
+	
+	now := time.Now()
+	var payments []*models.ScheduledPayment
+	for _, payment := range p.scheduled {
+		if payment.AccountID == accountID && payment.Type == paymentType {
+			payments = append(payments, withNextExecution(payment, now))
+		}
+	}
+	
+	// Soonest first; ended schedules last
+	sort.Slice(payments, func(i, j int) bool {
+		a, b := payments[i].NextExecutionDate, payments[j].NextExecutionDate
+		if (a == nil) != (b == nil) {
+			return b == nil
+		}
+		if a != nil && !a.Equal(*b) {
+			return a.Before(*b)
+		}
+		return payments[i].ID < payments[j].ID
+	})
+	return payments, nil
+}
+
+// withNextExecution returns a copy of the payment with its next execution
+// date as of now, so the forecast never goes stale
+func withNextExecution(payment *models.ScheduledPayment, now time.Time) *models.ScheduledPayment {
+	result := *payment
+	result.NextExecutionDate = nil
+	if payment.Status == models.ScheduledPaymentStatusActive || payment.Status == models.ScheduledPaymentStatusSuspended {
+		if next, ok := payment.NextExecution(now); ok {
+			result.NextExecutionDate = &next
+		}
+	}
+	return &result
+}
+
+// PayeeService implementation
+func (p *Provider) RetrievePayees(ctx context.Context, customerID string) ([]*models.Payee, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	if _, exists := p.customers[customerID]; !exists {
+		return nil, fmt.Errorf("customer %w: %s", domains.ErrNotFound, customerID)
+	}
+	
+	payees := make([]*models.Payee, 0)
+	for _, payee := range p.payees {
+		if payee.CustomerID == customerID {
+			payees = append(payees, payee)
+		}
+	}
+	sort.Slice(payees, func(i, j int) bool {
+		if payees[i].Nickname != payees[j].Nickname {
+			return payees[i].Nickname < payees[j].Nickname
+		}
+		return payees[i].ID < payees[j].ID
+	})
+	return payees, nil
+}
+
+func (p *Provider) RetrievePayee(ctx context.Context, payeeID string) (*models.Payee, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	payee, exists := p.payees[payeeID]
+	if !exists {
+		return nil, fmt.Errorf("payee %w: %s", domains.ErrNotFound, payeeID)
+	}
+	return payee, nil
+}
+
+func (p *Provider) CreatePayee(ctx context.Context, payee *models.Payee) (*models.Payee, error) {
+	if err := payee.ValidateInput(); err != nil {
+		return nil, err
+	}
+	
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	
+	if _, exists := p.customers[payee.CustomerID]; !exists {
+		return nil, fmt.Errorf("customer %w: %s", domains.ErrNotFound, payee.CustomerID)
+	}
+	
+	created := *payee
+	created.ID = "payee-" + uuid.New().String()[:8]
+	created.CreationDate = time.Now()
+	p.payees[created.ID] = &created
+	return &created, nil
+}
+
+func (p *Provider) DeletePayee(ctx context.Context, payeeID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	
+	if _, exists := p.payees[payeeID]; !exists {
+		return fmt.Errorf("payee %w: %s", domains.ErrNotFound, payeeID)
+	}
+	delete(p.payees, payeeID)
+	return nil
+}
+
+// ProductService implementation
+func (p *Provider) RetrieveProducts(ctx context.Context, filter domains.ProductFilter) ([]*models.Product, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	now := time.Now()
+	products := make([]*models.Product, 0)
+	for _, product := range p.products {
+		if filter.Category != "" && product.Category != filter.Category {
+			continue
+		}
+		current := product.IsEffective(now)
+		future := now.Before(product.EffectiveFrom)
+		switch filter.Effective {
+		case domains.ProductEffectiveFuture:
+			if !future {
+				continue
+			}
+		case domains.ProductEffectiveAll:
+			if !current && !future {
+				continue
+			}
+		default:
+			if !current {
+				continue
+			}
+		}
+		products = append(products, product)
+	}
+	sort.Slice(products, func(i, j int) bool {
+		if products[i].Name != products[j].Name {
+			return products[i].Name < products[j].Name
+		}
+		return products[i].ID < products[j].ID
+	})
+	return products, nil
+}
+
+func (p *Provider) RetrieveProduct(ctx context.Context, productID string) (*models.Product, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	
+	product, exists := p.products[productID]
+	if !exists {
+		return nil, fmt.Errorf("product %w: %s", domains.ErrNotFound, productID)
+	}
+	return product, nil
+}
+
 // loadSampleData populates the provider with realistic test data
 func (p *Provider) loadSampleData() {
 	now := time.Now()
@@ -156,6 +507,12 @@ func (p *Provider) loadSampleData() {
 		Status:        models.AccountStatusOpen,
 		OpenDate:      now.AddDate(-2, 0, 0),
 		Currency:      "AUD",
+		ProductID:     "prod-001",
+		Identifier: &models.AccountIdentifier{
+			Scheme:        identifiers.SchemeBSB,
+			BankCode:      "062-000",
+			AccountNumber: "123456789",
+		},
 	}
 	
 	p.accounts["acc-002"] = &models.Account{
@@ -166,6 +523,12 @@ func (p *Provider) loadSampleData() {
 		Status:        models.AccountStatusOpen,
 		OpenDate:      now.AddDate(-1, -6, 0),
 		Currency:      "AUD",
+		ProductID:     "prod-002",
+		Identifier: &models.AccountIdentifier{
+			Scheme:        identifiers.SchemeBSB,
+			BankCode:      "062-000",
+			AccountNumber: "987654321",
+		},
 	}
 	
 	p.accounts["acc-003"] = &models.Account{
@@ -176,8 +539,17 @@ func (p *Provider) loadSampleData() {
 		Status:        models.AccountStatusOpen,
 		OpenDate:      now.AddDate(-3, 0, 0),
 		Currency:      "USD",
+		ProductID:     "prod-003",
+		Identifier: &models.AccountIdentifier{
+			Scheme:        identifiers.SchemeABA,
+			BankCode:      "021000021",
+			AccountNumber: "555666777",
+		},
 	}
 	
+	// Sample customers (account holdings)
+	p.customers["cust-001"] = []string{"acc-001", "acc-002", "acc-003"}
+	
 	// Sample balances
 	currentBalance1, _ := models.NewMoney(decimal.NewFromFloat(2547.83), "AUD")
 	availableBalance1, _ := models.NewMoney(decimal.NewFromFloat(2547.83), "AUD")
@@ -185,12 +557,12 @@ func (p *Provider) loadSampleData() {
 	p.balances["acc-001"] = []*models.Balance{
 		{
 			BalanceType: models.BalanceTypeCurrent,
-			Amount:      *currentBalance1,
+			Amount:      currentBalance1,
 			Timestamp:   now,
 		},
 		{
 			BalanceType: models.BalanceTypeAvailable,
-			Amount:      *availableBalance1,
+			Amount:      availableBalance1,
 			Timestamp:   now,
 		},
 	}
@@ -201,12 +573,12 @@ func (p *Provider) loadSampleData() {
 	p.balances["acc-002"] = []*models.Balance{
 		{
 			BalanceType: models.BalanceTypeCurrent,
-			Amount:      *currentBalance2,
+			Amount:      currentBalance2,
 			Timestamp:   now,
 		},
 		{
 			BalanceType: models.BalanceTypeAvailable,
-			Amount:      *availableBalance2,
+			Amount:      availableBalance2,
 			Timestamp:   now,
 		},
 	}
@@ -217,12 +589,12 @@ func (p *Provider) loadSampleData() {
 	p.balances["acc-003"] = []*models.Balance{
 		{
 			BalanceType: models.BalanceTypeCurrent,
-			Amount:      *currentBalance3,
+			Amount:      currentBalance3,
 			Timestamp:   now,
 		},
 		{
 			BalanceType: models.BalanceTypeAvailable,
-			Amount:      *availableBalance3,
+			Amount:      availableBalance3,
 			Timestamp:   now,
 		},
 	}
@@ -230,11 +602,24 @@ func (p *Provider) loadSampleData() {
 	// Sample transactions
 	p.loadSampleTransactions(now)
 	
+	// Sample cards
+	p.loadSampleCards(now)
+	
+	// Sample standing orders and direct debits
+	p.loadSampleScheduledPayments(now)
+	
+	// Sample payees
+	p.loadSamplePayees(now)
+	
+	// Sample product catalogue
+	p.loadSampleProducts(now)
+	
 	// Sample consents
 	p.consents["consent-001"] = &models.Consent{
 		ID:         "consent-001",
 		Status:     models.ConsentStatusActive,
 		Scopes:     []string{"account:read", "transaction:read", "balance:read"},
+		AccountIDs: []string{"acc-001", "acc-002"},
 		GrantDate:  now.AddDate(0, -1, 0),
 		ExpiryDate: now.AddDate(0, 11, 0),
 	}
@@ -244,6 +629,7 @@ func (p *Provider) loadSampleData() {
 		ID:         "consent-002",
 		Status:     models.ConsentStatusExpired,
 		Scopes:     []string{"account:read", "balance:read"},
+		AccountIDs: []string{"acc-001"},
 		GrantDate:  now.AddDate(0, -14, 0),
 		ExpiryDate: expiredDate,
 	}
@@ -253,6 +639,7 @@ func (p *Provider) loadSampleData() {
 		ID:             "consent-003",
 		Status:         models.ConsentStatusRevoked,
 		Scopes:         []string{"account:read", "transaction:read"},
+		AccountIDs:     []string{"acc-003"},
 		GrantDate:      now.AddDate(0, -3, 0),
 		ExpiryDate:     now.AddDate(0, 9, 0),
 		RevocationDate: &revokedDate,
@@ -293,7 +680,7 @@ func (p *Provider) loadSampleTransactions(now time.Time) {
 			ID:              tx.id,
 			Reference:       fmt.Sprintf("REF-%s", tx.id),
 			TransactionType: tx.txType,
-			Amount:          *amount,
+			Amount:          amount,
 			Description:     tx.description,
 			MerchantName:    tx.merchant,
 			PostingDate:     postingDate,
@@ -301,4 +688,350 @@ func (p *Provider) loadSampleTransactions(now time.Time) {
 			AccountID:       tx.accountID,
 		}
 	}
+}
+
+func (p *Provider) loadSampleCards(now time.Time) {
+	creditLimit, _ := models.NewMoneyFromString("5000.00", "USD")
+	availableCredit, _ := models.NewMoneyFromString("3749.25", "USD")
+	statementBalance, _ := models.NewMoneyFromString("1161.25", "USD")
+	minimumPayment, _ := models.NewMoneyFromString("25.00", "USD")
+	cycle, _ := models.NewStatementCycle(15, now)
+	
+	p.cards["card-001"] = &models.Card{
+		ID:             "card-001",
+		AccountID:      "acc-003",
+		MaskedPAN:      identifiers.MaskAccountNumber("4111 1111 1111 4242"),
+		Scheme:         models.CardSchemeVisa,
+		CardholderName: "J SMITH",
+		Status:         models.CardStatusActive,
+		ExpiryMonth:    int(now.Month()),
+		ExpiryYear:     now.Year() + 3,
+		CreditFacility: &models.CreditFacility{
+			CreditLimit:      creditLimit,
+			AvailableCredit:  availableCredit,
+			StatementBalance: statementBalance,
+			MinimumPayment:   minimumPayment,
+			PaymentDueDate:   cycle.LastStatementDate.AddDate(0, 0, 25),
+			StatementCycle:   cycle,
+		},
+	}
+}
+
+func (p *Provider) loadSampleScheduledPayments(now time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	lastUtilityDebit := today.AddDate(0, 0, -7)
+	swimClubEnd := today.AddDate(0, -6, 0)
+	
+	payments := []struct {
+		id           string
+		accountID    string
+		paymentType  models.ScheduledPaymentType
+		status       models.ScheduledPaymentStatus
+		counterparty string
+		reference    string
+		mandate      string
+		amount       string
+		currency     string
+		frequency    models.Frequency
+		startDate    time.Time
+		endDate      *time.Time
+		lastDate     *time.Time
+	}{
+		{"so-001", "acc-001", models.ScheduledPaymentTypeStandingOrder, models.ScheduledPaymentStatusActive, "J Citizen", "Rent", "", "450.00", "AUD", models.FrequencyWeekly, today.AddDate(0, -6, -3), nil, nil},
+		{"so-002", "acc-001", models.ScheduledPaymentTypeStandingOrder, models.ScheduledPaymentStatusActive, "High Interest Savings", "Monthly savings", "", "500.00", "AUD", models.FrequencyLastBusinessDay, today.AddDate(-1, 0, 0), nil, nil},
+		{"so-003", "acc-001", models.ScheduledPaymentTypeStandingOrder, models.ScheduledPaymentStatusCompleted, "Local Swim Club", "Membership", "", "20.00", "AUD", models.FrequencyMonthly, today.AddDate(-2, 0, 0), &swimClubEnd, nil},
+		
+		{"dd-001", "acc-001", models.ScheduledPaymentTypeDirectDebit, models.ScheduledPaymentStatusActive, "Energy Australia", "Electricity", "EA-55123-DDR", "89.99", "AUD", models.FrequencyMonthly, lastUtilityDebit.AddDate(-1, 0, 0), nil, &lastUtilityDebit},
+		{"dd-002", "acc-001", models.ScheduledPaymentTypeDirectDebit, models.ScheduledPaymentStatusActive, "Fitness First", "Gym membership", "FF-20931", "32.95", "AUD", models.FrequencyFortnightly, today.AddDate(0, 0, -67), nil, nil},
+		{"dd-003", "acc-003", models.ScheduledPaymentTypeDirectDebit, models.ScheduledPaymentStatusSuspended, "Streaming Co", "Subscription", "SC-778812", "15.99", "USD", models.FrequencyMonthly, today.AddDate(0, -9, 4), nil, nil},
+	}
+	
+	for _, sp := range payments {
+		amount, _ := models.NewMoneyFromString(sp.amount, sp.currency)
+		p.scheduled[sp.id] = &models.ScheduledPayment{
+			ID:                sp.id,
+			AccountID:         sp.accountID,
+			Type:              sp.paymentType,
+			Status:            sp.status,
+			CounterpartyName:  sp.counterparty,
+			Reference:         sp.reference,
+			MandateReference:  sp.mandate,
+			Amount:            amount,
+			Frequency:         sp.frequency,
+			StartDate:         sp.startDate,
+			EndDate:           sp.endDate,
+			LastExecutionDate: sp.lastDate,
+		}
+	}
+}
+
+func (p *Provider) loadSamplePayees(now time.Time) {
+	payees := []*models.Payee{
+		{
+			ID:       "payee-001",
+			Nickname: "Landlord",
+			Type:     models.PayeeTypeDomestic,
+			Domestic: &models.DomesticPayee{
+				AccountName:   "J Citizen",
+				Scheme:        models.DomesticSchemeBSB,
+				BankCode:      "062-000",
+				AccountNumber: "12345678",
+			},
+		},
+		{
+			ID:       "payee-002",
+			Nickname: "Mum (UK)",
+			Type:     models.PayeeTypeInternational,
+			International: &models.InternationalPayee{
+				BeneficiaryName: "M Citizen",
+				Country:         "GB",
+				IBAN:            "GB82WEST12345698765432",
+				BIC:             "NWBKGB2L",
+			},
+		},
+		{
+			ID:       "payee-003",
+			Nickname: "Electricity",
+			Type:     models.PayeeTypeBiller,
+			Biller: &models.BillerPayee{
+				BillerCode: "23796",
+				BillerName: "Energy Australia",
+				CRN:        "4001234567",
+			},
+		},
+		{
+			ID:       "payee-004",
+			Nickname: "US Brokerage",
+			Type:     models.PayeeTypeDomestic,
+			Domestic: &models.DomesticPayee{
+				AccountName:   "J Smith",
+				Scheme:        models.DomesticSchemeABA,
+				BankCode:      "021000021",
+				AccountNumber: "000123456789",
+			},
+		},
+	}
+	
+	for i, payee := range payees {
+		payee.CustomerID = "cust-001"
+		payee.CreationDate = now.AddDate(0, -len(payees)+i, 0)
+		p.payees[payee.ID] = payee
+	}
+}
+
+func (p *Provider) loadSampleProducts(now time.Time) {
+	rate := decimal.RequireFromString
+	ratePtr := func(s string) *decimal.Decimal {
+		r := rate(s)
+		return &r
+	}
+	money := func(amount, currency string) models.Money {
+		m, _ := models.NewMoneyFromString(amount, currency)
+		return m
+	}
+	fee := func(amount, currency string) *models.Money {
+		m := money(amount, currency)
+		return &m
+	}
+	balanceTier := func(name, min string, max *decimal.Decimal, method models.RateApplicationMethod) []models.RateTier {
+		return []models.RateTier{{
+			Name:                  name,
+			UnitOfMeasure:         models.TierUnitDollar,
+			MinimumValue:          rate(min),
+			MaximumValue:          max,
+			RateApplicationMethod: method,
+		}}
+	}
+	lastUpdated := now.AddDate(0, -1, 0)
+	
+	products := []*models.Product{
+		{
+			ID:            "prod-001",
+			Category:      models.ProductCategoryTransAndSavingsAccounts,
+			Name:          "Everyday Checking",
+			Description:   "Transaction account with unlimited free transactions and a Visa debit card",
+			Brand:         "BIAN Go Bank",
+			EffectiveFrom: now.AddDate(-5, 0, 0),
+			LastUpdated:   lastUpdated,
+			Features: []models.ProductFeature{
+				{Type: models.ProductFeatureUnlimitedTxns},
+				{Type: models.ProductFeatureCardAccess, AdditionalValue: "VISA"},
+				{Type: models.ProductFeatureDigitalWallet, AdditionalValue: "Apple Pay, Google Pay"},
+				{Type: models.ProductFeatureNPPPayID},
+			},
+			Eligibility: []models.ProductEligibility{
+				{Type: models.EligibilityMinAge, AdditionalValue: "14"},
+				{Type: models.EligibilityNaturalPerson},
+			},
+			Fees: []models.ProductFee{
+				{
+					Name:             "Monthly account fee",
+					Type:             models.FeeTypePeriodic,
+					Amount:           fee("5.00", "AUD"),
+					AccrualFrequency: "P1M",
+					AdditionalInfo:   "Waived in months with deposits of $2,000 or more",
+				},
+				{
+					Name:   "Overseas ATM withdrawal",
+					Type:   models.FeeTypeWithdrawal,
+					Amount: fee("5.00", "AUD"),
+				},
+				{
+					Name:            "International transaction fee",
+					Type:            models.FeeTypePurchase,
+					TransactionRate: ratePtr("0.03"),
+				},
+			},
+			DepositRates: []models.DepositRate{
+				{
+					Type:                 models.DepositRateVariable,
+					Rate:                 rate("0.0001"),
+					CalculationFrequency: "P1D",
+					ApplicationFrequency: "P1M",
+				},
+			},
+		},
+		{
+			ID:            "prod-002",
+			Category:      models.ProductCategoryTransAndSavingsAccounts,
+			Name:          "High Interest Savings",
+			Description:   "Online savings account with tiered variable rates and a monthly bonus",
+			Brand:         "BIAN Go Bank",
+			EffectiveFrom: now.AddDate(-3, 0, 0),
+			LastUpdated:   lastUpdated,
+			Features: []models.ProductFeature{
+				{Type: models.ProductFeatureBonusRewards, AdditionalInfo: "Bonus rate for months with no withdrawals"},
+			},
+			Constraints: []models.ProductConstraint{
+				{Type: models.ProductConstraintMaxBalance, Amount: money("5000000.00", "AUD")},
+			},
+			Eligibility: []models.ProductEligibility{
+				{Type: models.EligibilityNaturalPerson},
+				{Type: models.EligibilityResidencyStatus, AdditionalInfo: "Australian residents for tax purposes"},
+			},
+			DepositRates: []models.DepositRate{
+				{
+					Type:                 models.DepositRateVariable,
+					Rate:                 rate("0.0250"),
+					CalculationFrequency: "P1D",
+					ApplicationFrequency: "P1M",
+					Tiers:                balanceTier("Up to $10,000", "0", ratePtr("10000"), models.RateApplicationPerTier),
+				},
+				{
+					Type:                 models.DepositRateVariable,
+					Rate:                 rate("0.0450"),
+					CalculationFrequency: "P1D",
+					ApplicationFrequency: "P1M",
+					Tiers:                balanceTier("$10,000 to $250,000", "10000", ratePtr("250000"), models.RateApplicationPerTier),
+				},
+				{
+					Type:                 models.DepositRateVariable,
+					Rate:                 rate("0.0100"),
+					CalculationFrequency: "P1D",
+					ApplicationFrequency: "P1M",
+					Tiers:                balanceTier("Over $250,000", "250000", nil, models.RateApplicationPerTier),
+				},
+				{
+					Type:                 models.DepositRateBonus,
+					Rate:                 rate("0.0050"),
+					CalculationFrequency: "P1D",
+					ApplicationFrequency: "P1M",
+					AdditionalInfo:       "Paid for months with a deposit of at least $200 and no withdrawals",
+				},
+			},
+		},
+		{
+			ID:            "prod-003",
+			Category:      models.ProductCategoryCredAndChrgCards,
+			Name:          "Platinum Credit Card",
+			Description:   "USD credit card with up to 55 days interest free on purchases",
+			Brand:         "BIAN Go Bank",
+			EffectiveFrom: now.AddDate(-6, 0, 0),
+			LastUpdated:   lastUpdated,
+			Features: []models.ProductFeature{
+				{Type: models.ProductFeatureInterestFree, AdditionalValue: "P55D"},
+				{Type: models.ProductFeatureAdditionalCards, AdditionalValue: "4"},
+				{Type: models.ProductFeatureCardAccess, AdditionalValue: "VISA"},
+			},
+			Constraints: []models.ProductConstraint{
+				{Type: models.ProductConstraintMinLimit, Amount: money("2000.00", "USD")},
+				{Type: models.ProductConstraintMaxLimit, Amount: money("50000.00", "USD")},
+			},
+			Eligibility: []models.ProductEligibility{
+				{Type: models.EligibilityMinAge, AdditionalValue: "18"},
+				{Type: models.EligibilityMinIncome, AdditionalValue: "50000.00"},
+			},
+			Fees: []models.ProductFee{
+				{
+					Name:             "Annual fee",
+					Type:             models.FeeTypePeriodic,
+					Amount:           fee("249.00", "USD"),
+					AccrualFrequency: "P1Y",
+				},
+				{
+					Name:            "Cash advance fee",
+					Type:            models.FeeTypeWithdrawal,
+					TransactionRate: ratePtr("0.02"),
+				},
+				{
+					Name:   "Late payment fee",
+					Type:   models.FeeTypeEvent,
+					Amount: fee("30.00", "USD"),
+				},
+			},
+			LendingRates: []models.LendingRate{
+				{
+					Type:                 models.LendingRatePurchase,
+					Rate:                 rate("0.2099"),
+					CalculationFrequency: "P1D",
+					ApplicationFrequency: "P1M",
+				},
+				{
+					Type:                 models.LendingRateCashAdvance,
+					Rate:                 rate("0.2199"),
+					CalculationFrequency: "P1D",
+					ApplicationFrequency: "P1M",
+				},
+			},
+		},
+		{
+			ID:            "prod-004",
+			Category:      models.ProductCategoryTermDeposits,
+			Name:          "12 Month Term Deposit",
+			Description:   "Fixed rate term deposit, interest paid at maturity",
+			Brand:         "BIAN Go Bank",
+			EffectiveFrom: now.AddDate(0, 1, 0),
+			LastUpdated:   lastUpdated,
+			Constraints: []models.ProductConstraint{
+				{Type: models.ProductConstraintOpeningBalance, Amount: money("5000.00", "AUD")},
+			},
+			Eligibility: []models.ProductEligibility{
+				{Type: models.EligibilityNaturalPerson},
+			},
+			Fees: []models.ProductFee{
+				{
+					Name:           "Early withdrawal adjustment",
+					Type:           models.FeeTypeVariable,
+					AdditionalInfo: "Interest reduced by up to 90% when withdrawn before maturity",
+				},
+			},
+			DepositRates: []models.DepositRate{
+				{
+					Type:                 models.DepositRateFixed,
+					Rate:                 rate("0.0475"),
+					CalculationFrequency: "P1D",
+					ApplicationFrequency: "P12M",
+					Tiers: []models.RateTier{{
+						Name:          "12 months",
+						UnitOfMeasure: models.TierUnitMonth,
+						MinimumValue:  rate("12"),
+						MaximumValue:  ratePtr("12"),
+					}},
+				},
+			},
+		},
+	}
+	for _, product := range products {
+		p.products[product.ID] = product
+	}
 }
\ No newline at end of file
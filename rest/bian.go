@@ -0,0 +1,155 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/serverlesscloud/bian-go/domains/bian/currentaccount"
+	"github.com/serverlesscloud/bian-go/domains/bian/paymentexecution"
+)
+
+// BIAN semantic API
+//
+// These routes follow the BIAN semantic API path convention
+// /{ServiceDomain}/{cr-reference-id}[/{BehaviorQualifier}/{bq-reference-id}]/{ServiceOperation}
+// and wrap each result in a control record or behaviour qualifier envelope,
+// so BIAN certification tooling can run against the same domain services as
+// the friendly /v1 routes. Records are the types generated from the vendored
+// BIAN release in domains/bian, so they track that release rather than the
+// API version and are not version-prefixed.
+
+// BIANRequest identifies the control record, and optionally the behaviour
+// qualifier, a semantic API call addresses. It is read from the request path.
+type BIANRequest struct {
+	ServiceDomain                      string `json:"ServiceDomain"`
+	ServiceOperation                   string `json:"ServiceOperation"`
+	ControlRecordInstanceReference     string `json:"ControlRecordInstanceReference"`
+	BehaviorQualifierType              string `json:"BehaviorQualifierType,omitempty"`
+	BehaviorQualifierInstanceReference string `json:"BehaviorQualifierInstanceReference,omitempty"`
+}
+
+// BIANResponse echoes the request and carries the retrieved record under
+// its BIAN name: the behaviour qualifier type when one was addressed,
+// otherwise the control record type
+type BIANResponse struct {
+	BIANRequest
+	ControlRecordType string      `json:"ControlRecordType"`
+	Record            interface{} `json:"-"`
+}
+
+// RecordName returns the key the record is encoded under
+func (r BIANResponse) RecordName() string {
+	if r.BehaviorQualifierType != "" {
+		return r.BehaviorQualifierType
+	}
+	return r.ControlRecordType
+}
+
+// MarshalJSON encodes the envelope fields followed by the record
+func (r BIANResponse) MarshalJSON() ([]byte, error) {
+	type envelope BIANResponse
+	header, err := json.Marshal(envelope(r))
+	if err != nil {
+		return nil, err
+	}
+	name, err := json.Marshal(r.RecordName())
+	if err != nil {
+		return nil, err
+	}
+	record, err := json.Marshal(r.Record)
+	if err != nil {
+		return nil, err
+	}
+
+	out := append(header[:len(header)-1], ',')
+	out = append(out, name...)
+	out = append(out, ':')
+	out = append(out, record...)
+	return append(out, '}'), nil
+}
+
+// bianRoutes returns the BIAN semantic API routes. ServeMux wildcards must
+// be Go identifiers, so {cr-reference-id} is matched as {crReferenceID}.
+func (s *Server) bianRoutes() []Route {
+	return []Route{
+		{Method: "GET", Pattern: "/CurrentAccount/{crReferenceID}/Retrieve", Handler: http.HandlerFunc(s.handlers.RetrieveCurrentAccountFacility)},
+		{Method: "GET", Pattern: "/CurrentAccount/{crReferenceID}/Payments/{bqReferenceID}/Retrieve", Handler: http.HandlerFunc(s.handlers.RetrieveCurrentAccountPayments)},
+		{Method: "GET", Pattern: "/PaymentExecution/{crReferenceID}/Retrieve", Handler: http.HandlerFunc(s.handlers.RetrievePaymentExecutionProcedure)},
+	}
+}
+
+// bianRequest reads the control record and behaviour qualifier references from the path
+func bianRequest(r *http.Request, serviceDomain, behaviorQualifier string) BIANRequest {
+	request := BIANRequest{
+		ServiceDomain:                  serviceDomain,
+		ServiceOperation:               "Retrieve",
+		ControlRecordInstanceReference: r.PathValue("crReferenceID"),
+	}
+	if behaviorQualifier != "" {
+		request.BehaviorQualifierType = behaviorQualifier
+		request.BehaviorQualifierInstanceReference = r.PathValue("bqReferenceID")
+	}
+	return request
+}
+
+// RetrieveCurrentAccountFacility handles GET /CurrentAccount/{cr-reference-id}/Retrieve
+func (h *Handlers) RetrieveCurrentAccountFacility(w http.ResponseWriter, r *http.Request) {
+	request := bianRequest(r, "CurrentAccount", "")
+
+	account, err := h.accountService.RetrieveCurrentAccount(r.Context(), request.ControlRecordInstanceReference)
+	if err != nil {
+		WriteServiceError(w, err, "CurrentAccountFacility", request.ControlRecordInstanceReference)
+		return
+	}
+
+	writeBIAN(w, BIANResponse{
+		BIANRequest:       request,
+		ControlRecordType: "CurrentAccountFacility",
+		Record:            currentaccount.CurrentAccountFacilityFromModel(account),
+	})
+}
+
+// RetrieveCurrentAccountPayments handles
+// GET /CurrentAccount/{cr-reference-id}/Payments/{bq-reference-id}/Retrieve.
+// Payments posted to other accounts are reported as not found.
+func (h *Handlers) RetrieveCurrentAccountPayments(w http.ResponseWriter, r *http.Request) {
+	request := bianRequest(r, "CurrentAccount", "Payments")
+
+	transaction, err := h.transactionService.RetrievePaymentTransaction(r.Context(), request.BehaviorQualifierInstanceReference)
+	if err == nil && transaction.AccountID != request.ControlRecordInstanceReference {
+		WriteNotFoundError(w, "Payments", request.BehaviorQualifierInstanceReference)
+		return
+	}
+	if err != nil {
+		WriteServiceError(w, err, "Payments", request.BehaviorQualifierInstanceReference)
+		return
+	}
+
+	writeBIAN(w, BIANResponse{
+		BIANRequest:       request,
+		ControlRecordType: "CurrentAccountFacility",
+		Record:            currentaccount.PaymentsFromModel(transaction),
+	})
+}
+
+// RetrievePaymentExecutionProcedure handles GET /PaymentExecution/{cr-reference-id}/Retrieve
+func (h *Handlers) RetrievePaymentExecutionProcedure(w http.ResponseWriter, r *http.Request) {
+	request := bianRequest(r, "PaymentExecution", "")
+
+	transaction, err := h.transactionService.RetrievePaymentTransaction(r.Context(), request.ControlRecordInstanceReference)
+	if err != nil {
+		WriteServiceError(w, err, "PaymentExecutionProcedure", request.ControlRecordInstanceReference)
+		return
+	}
+
+	writeBIAN(w, BIANResponse{
+		BIANRequest:       request,
+		ControlRecordType: "PaymentExecutionProcedure",
+		Record:            paymentexecution.PaymentExecutionProcedureFromModel(transaction),
+	})
+}
+
+func writeBIAN(w http.ResponseWriter, response BIANResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBIANRetrieve(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantRecord string
+		wantRef    string
+	}{
+		{
+			name:       "current account control record",
+			path:       "/CurrentAccount/acc-001/Retrieve",
+			wantStatus: http.StatusOK,
+			wantRecord: "CurrentAccountFacility",
+			wantRef:    "acc-001",
+		},
+		{
+			name:       "payments behaviour qualifier",
+			path:       "/CurrentAccount/acc-001/Payments/tx-001/Retrieve",
+			wantStatus: http.StatusOK,
+			wantRecord: "Payments",
+			wantRef:    "tx-001",
+		},
+		{
+			name:       "payment on another account",
+			path:       "/CurrentAccount/acc-002/Payments/tx-001/Retrieve",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "payment execution control record",
+			path:       "/PaymentExecution/tx-002/Retrieve",
+			wantStatus: http.StatusOK,
+			wantRecord: "PaymentExecutionProcedure",
+			wantRef:    "tx-002",
+		},
+		{
+			name:       "unknown control record",
+			path:       "/CurrentAccount/nope/Retrieve",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var body map[string]json.RawMessage
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if _, ok := body[tt.wantRecord]; !ok {
+				t.Errorf("response has no %s record: %s", tt.wantRecord, rec.Body.String())
+			}
+
+			var envelope BIANRequest
+			json.Unmarshal(rec.Body.Bytes(), &envelope)
+			ref := envelope.ControlRecordInstanceReference
+			if envelope.BehaviorQualifierType != "" {
+				ref = envelope.BehaviorQualifierInstanceReference
+			}
+			if ref != tt.wantRef || envelope.ServiceOperation != "Retrieve" {
+				t.Errorf("envelope = %+v, want reference %s", envelope, tt.wantRef)
+			}
+		})
+	}
+}
+
+func TestBIANRetrieve_EnumValues(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	tests := []struct {
+		path   string
+		record string
+		want   map[string]string
+	}{
+		{"/CurrentAccount/acc-001/Retrieve", "CurrentAccountFacility", map[string]string{"AccountType": "Checking", "AccountStatus": "Open"}},
+		{"/CurrentAccount/acc-003/Retrieve", "CurrentAccountFacility", map[string]string{"AccountType": "CreditCard"}},
+		{"/CurrentAccount/acc-001/Payments/tx-001/Retrieve", "Payments", map[string]string{"PaymentTransactionType": "Debit", "PaymentTransactionReference": "tx-001"}},
+		{"/PaymentExecution/tx-002/Retrieve", "PaymentExecutionProcedure", map[string]string{"PaymentExecutionStatus": "Executed", "PayeeProductInstanceReference": "acc-001"}},
+	}
+
+	// Values follow the BIAN spec rather than the models enums
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+
+		var body map[string]json.RawMessage
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Fatalf("%s: failed to decode response: %v", tt.path, err)
+		}
+		var record map[string]any
+		json.Unmarshal(body[tt.record], &record)
+		for field, want := range tt.want {
+			if got := record[field]; got != want {
+				t.Errorf("%s: %s = %v, want %s", tt.path, field, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+func TestCards(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	tests := []struct {
+		name        string
+		path        string
+		wantStatus  int
+		wantMessage string
+	}{
+		{name: "card", path: "/v1/cards/card-001", wantStatus: http.StatusOK},
+		{name: "account card", path: "/v1/accounts/acc-003/card", wantStatus: http.StatusOK},
+		{name: "unknown card", path: "/v1/cards/card-999", wantStatus: http.StatusNotFound, wantMessage: "card not found"},
+		{name: "account without a card", path: "/v1/accounts/acc-001/card", wantStatus: http.StatusNotFound, wantMessage: "card not found"},
+		{name: "unknown account", path: "/v1/accounts/acc-999/card", wantStatus: http.StatusNotFound, wantMessage: "account not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+
+			if tt.wantStatus != http.StatusOK {
+				if detail := decodeError(t, rec); detail.Code != ErrorCodeNotFound || detail.Message != tt.wantMessage {
+					t.Errorf("error = %+v, want NOT_FOUND %q", detail, tt.wantMessage)
+				}
+				return
+			}
+			var card models.Card
+			if err := json.Unmarshal(rec.Body.Bytes(), &card); err != nil {
+				t.Fatal(err)
+			}
+			// Only the last four digits of the card number are exposed
+			if card.ID != "card-001" || card.MaskedPAN != "************4242" || card.CreditFacility == nil {
+				t.Errorf("card = %+v", card)
+			}
+		})
+	}
+}
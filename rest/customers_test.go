@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/shopspring/decimal"
+)
+
+func TestCustomerBalances(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/v1/customers/cust-001/balances?currency=AUD", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200 (body %s)", rec.Code, rec.Body.String())
+	}
+
+	var summary models.CustomerBalanceSummary
+	if err := json.Unmarshal(rec.Body.Bytes(), &summary); err != nil {
+		t.Fatal(err)
+	}
+	if len(summary.Accounts) != 3 || summary.Total.Currency != "AUD" {
+		t.Fatalf("summary = %+v, want three accounts totalled in AUD", summary)
+	}
+
+	sum := decimal.Zero
+	for _, account := range summary.Accounts {
+		if account.ConvertedBalance.Currency != "AUD" {
+			t.Errorf("%s converted to %s", account.AccountID, account.ConvertedBalance.Currency)
+		}
+		// The USD credit card is converted; the AUD accounts are not
+		if converted := account.ExchangeRate != nil; converted != (account.Balance.Currency == "USD") {
+			t.Errorf("%s (%s) exchange rate = %v", account.AccountID, account.Balance.Currency, account.ExchangeRate)
+		}
+		sum = sum.Add(account.ConvertedBalance.Amount)
+	}
+	if !summary.Total.Amount.Equal(sum) {
+		t.Errorf("total = %s, want the sum of converted balances %s", summary.Total.Amount, sum)
+	}
+}
+
+func TestCustomerBalances_Errors(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	tests := []struct {
+		name      string
+		path      string
+		wantCode  int
+		wantField string
+	}{
+		{name: "missing currency", path: "/v1/customers/cust-001/balances", wantCode: http.StatusBadRequest, wantField: "currency"},
+		{name: "unknown currency", path: "/v1/customers/cust-001/balances?currency=XYZ", wantCode: http.StatusBadRequest, wantField: "currency"},
+		// KWD is a valid ISO 4217 code, but the bundled rates do not price it
+		{name: "currency without rate", path: "/v1/customers/cust-001/balances?currency=KWD", wantCode: http.StatusBadRequest, wantField: "currency"},
+		{name: "unknown customer", path: "/v1/customers/cust-999/balances?currency=AUD", wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantCode, rec.Body.String())
+			}
+
+			var response ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantField == "" {
+				return
+			}
+			if len(response.Error.Fields) != 1 || response.Error.Fields[0].Field != tt.wantField {
+				t.Errorf("fields = %+v, want one error on %s", response.Error.Fields, tt.wantField)
+			}
+		})
+	}
+}
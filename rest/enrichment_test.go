@@ -0,0 +1,70 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/providers/enrich"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+func TestTransactions_Enriched(t *testing.T) {
+	provider := mock.NewProvider()
+	transactions := domains.NewValidatingTransactionService(
+		domains.NewEnrichingTransactionService(provider, enrich.NewDefaultRulesEnricher()),
+	)
+	handler := NewServer(provider, transactions, provider, provider).Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/v1/accounts/acc-001/transactions", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200 (body %s)", rec.Code, rec.Body.String())
+	}
+
+	var transactionList []models.Transaction
+	if err := json.Unmarshal(rec.Body.Bytes(), &transactionList); err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]models.Transaction, len(transactionList))
+	for _, tx := range transactionList {
+		got[tx.ID] = tx
+	}
+
+	tests := []struct {
+		id       string
+		category models.TransactionCategory
+		mcc      string
+		merchant string
+	}{
+		{"tx-001", models.TransactionCategoryGroceries, "5411", "Woolworths"},
+		{"tx-002", models.TransactionCategorySalary, "", "ACME Corp"},
+		{"tx-003", models.TransactionCategoryDining, "5814", "Local Cafe"},
+		{"tx-004", models.TransactionCategoryUtilities, "4900", "EnergyAustralia"},
+		{"tx-005", models.TransactionCategoryCash, "6011", "ANZ ATM"},
+	}
+	for _, tt := range tests {
+		tx, ok := got[tt.id]
+		if !ok {
+			t.Errorf("missing %s", tt.id)
+			continue
+		}
+		if tx.Category != tt.category || tx.MerchantCategoryCode != tt.mcc || tx.CleanMerchantName != tt.merchant {
+			t.Errorf("%s enriched as %s/%q/%q, want %s/%q/%q", tt.id,
+				tx.Category, tx.MerchantCategoryCode, tx.CleanMerchantName, tt.category, tt.mcc, tt.merchant)
+		}
+	}
+
+	// Enrichment works on copies and leaves the provider's data untouched
+	stored, err := provider.RetrievePaymentTransaction(context.Background(), "tx-001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Category != "" {
+		t.Errorf("provider transaction was modified: category %s", stored.Category)
+	}
+}
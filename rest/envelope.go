@@ -0,0 +1,133 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// EnvelopeMediaType requests enveloped responses when listed in Accept
+const EnvelopeMediaType = "application/vnd.api+json"
+
+// Envelope wraps a response body with paging metadata and links, in the
+// style of JSON:API top-level documents
+type Envelope struct {
+	Data  interface{} `json:"data"`
+	Meta  *Meta       `json:"meta,omitempty"`
+	Links Links       `json:"links"`
+}
+
+// Meta describes the page of a collection response
+type Meta struct {
+	// Number of items in this page
+	Count int `json:"count"`
+
+	// Total number of items, when known: on the last page, and omitted on
+	// earlier pages and on empty pages past the end
+	TotalCount *int `json:"totalCount,omitempty"`
+
+	// Page size requested (0 when unbounded) and items skipped
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+
+	// Whether another page follows
+	HasMore bool `json:"hasMore"`
+}
+
+// Links point to the response itself, adjacent pages and related resources
+type Links struct {
+	Self    string            `json:"self"`
+	Next    string            `json:"next,omitempty"`
+	Prev    string            `json:"prev,omitempty"`
+	Related map[string]string `json:"related,omitempty"`
+}
+
+// WithEnvelope makes enveloped responses the default for every client, not
+// only those that send Accept: application/vnd.api+json
+func WithEnvelope() Option {
+	return func(h *Handlers) {
+		h.envelope = true
+	}
+}
+
+// wantsEnvelope reports whether the response to r should be enveloped
+func (h *Handlers) wantsEnvelope(r *http.Request) bool {
+	return h.envelope || accepts(r, EnvelopeMediaType)
+}
+
+// accepts reports whether the request's Accept header lists mediaType
+func accepts(r *http.Request, mediaType string) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			name, _, _ := strings.Cut(mediaRange, ";")
+			if strings.EqualFold(strings.TrimSpace(name), mediaType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// writeResource writes a single resource, enveloped with links to related
+// resources when requested. Related paths are given without a version prefix.
+func (h *Handlers) writeResource(w http.ResponseWriter, r *http.Request, data interface{}, related map[string]string) {
+	h.write(w, r, http.StatusOK, data, nil, related)
+}
+
+// writeCreated writes a newly created resource with 201 Created and a
+// Location header. The location is given without a version prefix.
+func (h *Handlers) writeCreated(w http.ResponseWriter, r *http.Request, data interface{}, location string, related map[string]string) {
+	w.Header().Set("Location", pathPrefix(r)+location)
+	h.write(w, r, http.StatusCreated, data, nil, related)
+}
+
+// writeCollection writes a page of a collection, enveloped with paging
+// metadata and next/prev links when requested
+func (h *Handlers) writeCollection(w http.ResponseWriter, r *http.Request, data interface{}, meta Meta, related map[string]string) {
+	h.write(w, r, http.StatusOK, data, &meta, related)
+}
+
+func (h *Handlers) write(w http.ResponseWriter, r *http.Request, status int, data interface{}, meta *Meta, related map[string]string) {
+	w.Header().Add("Vary", "Accept")
+	if !h.wantsEnvelope(r) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(data)
+		return
+	}
+
+	links := Links{Self: r.URL.RequestURI()}
+	if meta != nil && meta.Limit > 0 {
+		if meta.HasMore {
+			links.Next = pageLink(r, meta.Offset+meta.Limit)
+		}
+		if meta.Offset > 0 {
+			links.Prev = pageLink(r, max(meta.Offset-meta.Limit, 0))
+		}
+	}
+	if len(related) > 0 {
+		prefix := pathPrefix(r)
+		links.Related = make(map[string]string, len(related))
+		for name, path := range related {
+			links.Related[name] = prefix + path
+		}
+	}
+
+	w.Header().Set("Content-Type", EnvelopeMediaType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(Envelope{Data: data, Meta: meta, Links: links})
+}
+
+// pageLink returns the request URI with its offset replaced
+func pageLink(r *http.Request, offset int) string {
+	query := r.URL.Query()
+	if offset > 0 {
+		query.Set("offset", strconv.Itoa(offset))
+	} else {
+		query.Del("offset")
+	}
+	link := url.URL{Path: r.URL.Path, RawQuery: query.Encode()}
+	return link.String()
+}
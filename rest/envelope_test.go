@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// getEnvelope requests path from handler as an enveloped response
+func getEnvelope(t *testing.T, handler http.Handler, path string) Envelope {
+	t.Helper()
+	req := httptest.NewRequest("GET", path, nil)
+	req.Header.Set("Accept", EnvelopeMediaType)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Content-Type"); got != EnvelopeMediaType {
+		t.Fatalf("Content-Type = %q, want %q", got, EnvelopeMediaType)
+	}
+	var envelope Envelope
+	if err := json.Unmarshal(rec.Body.Bytes(), &envelope); err != nil {
+		t.Fatalf("failed to decode envelope: %v", err)
+	}
+	return envelope
+}
+
+func TestEnvelope_TransactionPages(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	get := func(path string) Envelope {
+		t.Helper()
+		return getEnvelope(t, handler, path)
+	}
+
+	first := get("/v1/accounts/acc-001/transactions?limit=1")
+	if !first.Meta.HasMore || first.Meta.Count != 1 || first.Meta.TotalCount != nil {
+		t.Errorf("first page meta = %+v, want one item with more to follow", first.Meta)
+	}
+	if first.Links.Next != "/v1/accounts/acc-001/transactions?limit=1&offset=1" {
+		t.Errorf("next = %q", first.Links.Next)
+	}
+	if first.Links.Prev != "" {
+		t.Errorf("prev = %q, want none on the first page", first.Links.Prev)
+	}
+	if first.Links.Related["account"] != "/v1/accounts/acc-001" {
+		t.Errorf("related account = %q", first.Links.Related["account"])
+	}
+
+	// Follow next links to the end; the last page reports the total
+	pages := 1
+	for envelope := first; envelope.Meta.HasMore; pages++ {
+		if pages > 100 {
+			t.Fatal("pagination did not terminate")
+		}
+		envelope = get(envelope.Links.Next)
+		if envelope.Links.Prev == "" {
+			t.Errorf("page %d has no prev link", pages+1)
+		}
+		if !envelope.Meta.HasMore {
+			if envelope.Meta.TotalCount == nil || *envelope.Meta.TotalCount != pages+1 {
+				t.Errorf("last page totalCount = %v, want %d", envelope.Meta.TotalCount, pages+1)
+			}
+		}
+	}
+}
+
+func TestEnvelope_OffsetPastEnd(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	// An empty page past the end cannot tell how many items precede it
+	for _, path := range []string{
+		"/v1/accounts/acc-001/transactions?limit=10&offset=1000",
+		"/v1/accounts/acc-001/transactions?offset=1000",
+	} {
+		meta := getEnvelope(t, handler, path).Meta
+		if meta.Count != 0 || meta.HasMore || meta.TotalCount != nil {
+			t.Errorf("%s: meta = %+v, want an empty page without totalCount", path, meta)
+		}
+	}
+}
+
+func TestEnvelope_BareByDefault(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/accounts/acc-001", nil)
+	rec := httptest.NewRecorder()
+	newTestServer().Handler().ServeHTTP(rec, req)
+
+	var account map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &account); err != nil {
+		t.Fatalf("failed to decode account: %v", err)
+	}
+	if account["id"] != "acc-001" {
+		t.Errorf("bare response = %v, want the account itself", account)
+	}
+}
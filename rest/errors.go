@@ -2,16 +2,27 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+
+	"github.com/serverlesscloud/bian-go/apierr"
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
 )
 
-// ErrorCode represents standard error codes
-type ErrorCode string
+// ErrorCode represents standard error codes, shared with GraphQL through apierr
+type ErrorCode = apierr.Code
 
 const (
-	ErrorCodeNotFound      ErrorCode = "NOT_FOUND"
-	ErrorCodeInvalidInput  ErrorCode = "INVALID_INPUT"
-	ErrorCodeInternalError ErrorCode = "INTERNAL_ERROR"
+	ErrorCodeNotFound      = apierr.NotFound
+	ErrorCodeForbidden     = apierr.Forbidden
+	ErrorCodeInvalidInput  = apierr.InvalidInput
+	ErrorCodeRateLimited   = apierr.RateLimited
+	ErrorCodeInternalError = apierr.InternalError
+	
+	// REST-only codes: GraphQL has a single, unversioned endpoint
+	ErrorCodeMethodNotAllowed   ErrorCode = "METHOD_NOT_ALLOWED"
+	ErrorCodeUnsupportedVersion ErrorCode = "UNSUPPORTED_VERSION"
 )
 
 // ErrorResponse represents the standard error response format
@@ -21,9 +32,10 @@ type ErrorResponse struct {
 
 // ErrorDetail contains error information
 type ErrorDetail struct {
-	Code    ErrorCode `json:"code"`
-	Message string    `json:"message"`
-	Details string    `json:"details,omitempty"`
+	Code    ErrorCode           `json:"code"`
+	Message string              `json:"message"`
+	Details string              `json:"details,omitempty"`
+	Fields  []models.FieldError `json:"fields,omitempty"`
 }
 
 // WriteErrorResponse writes a standardized error response
@@ -42,6 +54,23 @@ func WriteErrorResponse(w http.ResponseWriter, code ErrorCode, message, details
 	json.NewEncoder(w).Encode(response)
 }
 
+// WriteValidationError writes a 400 error response with field-level details
+func WriteValidationError(w http.ResponseWriter, err *models.ValidationError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	
+	response := ErrorResponse{
+		Error: ErrorDetail{
+			Code:    ErrorCodeInvalidInput,
+			Message: "Invalid input",
+			Details: err.Error(),
+			Fields:  err.Fields,
+		},
+	}
+	
+	json.NewEncoder(w).Encode(response)
+}
+
 // WriteNotFoundError writes a 404 error response
 func WriteNotFoundError(w http.ResponseWriter, resource, id string) {
 	WriteErrorResponse(w, ErrorCodeNotFound, 
@@ -58,6 +87,36 @@ func WriteInvalidInputError(w http.ResponseWriter, message string) {
 		http.StatusBadRequest)
 }
 
+// WriteServiceError maps a domain service error to the matching error response:
+// not found errors become 404, access denied 403, rate limiting 429, invalid
+// caller input 400 with field details, and anything else (including invalid
+// provider output) 500
+func WriteServiceError(w http.ResponseWriter, err error, resource, id string) {
+	if domains.IsNotFound(err) {
+		WriteNotFoundError(w, resource, id)
+		return
+	}
+	if errors.Is(err, domains.ErrForbidden) {
+		WriteErrorResponse(w, ErrorCodeForbidden, 
+			"Access denied", 
+			"Access to "+resource+" "+id+" is not permitted", 
+			http.StatusForbidden)
+		return
+	}
+	if errors.Is(err, domains.ErrRateLimited) {
+		WriteErrorResponse(w, ErrorCodeRateLimited, 
+			"Rate limit exceeded", 
+			"Too many requests, retry later", 
+			http.StatusTooManyRequests)
+		return
+	}
+	if ve, ok := models.AsValidationError(err); ok && !errors.Is(err, domains.ErrInvalidProviderOutput) {
+		WriteValidationError(w, ve)
+		return
+	}
+	WriteInternalError(w, err)
+}
+
 // WriteInternalError writes a 500 error response
 func WriteInternalError(w http.ResponseWriter, err error) {
 	WriteErrorResponse(w, ErrorCodeInternalError, 
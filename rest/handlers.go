@@ -2,20 +2,94 @@ package rest
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
 )
 
 // Handlers contains all REST endpoint handlers
 type Handlers struct {
-	accountService     domains.AccountService
-	transactionService domains.TransactionService
-	balanceService     domains.BalanceService
-	consentService     domains.ConsentService
+	accountService         domains.AccountService
+	transactionService     domains.TransactionService
+	balanceService         domains.BalanceService
+	consentService         domains.ConsentService
+	balanceAggregator      *domains.BalanceAggregator
+	analyticsService       domains.AnalyticsService
+	customerService        domains.CustomerService
+	fxService              domains.FXService
+	cardService            domains.CardService
+	standingOrderService   domains.StandingOrderService
+	directDebitService     domains.DirectDebitService
+	payeeService           domains.PayeeService
+	productService         domains.ProductService
+	apiVersions            []APIVersion
+	unversionedDeprecation time.Time
+	unversionedSunset      time.Time
+	envelope               bool
+}
+
+// Option configures optional domain services on the handlers
+type Option func(*Handlers)
+
+// WithCustomerService enables customer position endpoints
+func WithCustomerService(customerService domains.CustomerService) Option {
+	return func(h *Handlers) {
+		h.customerService = customerService
+	}
+}
+
+// WithFXService enables currency conversion for aggregated balances
+func WithFXService(fxService domains.FXService) Option {
+	return func(h *Handlers) {
+		h.fxService = fxService
+	}
+}
+
+// WithCardService enables card and credit facility endpoints
+func WithCardService(cardService domains.CardService) Option {
+	return func(h *Handlers) {
+		h.cardService = cardService
+	}
+}
+
+// WithStandingOrderService enables standing order endpoints
+func WithStandingOrderService(standingOrderService domains.StandingOrderService) Option {
+	return func(h *Handlers) {
+		h.standingOrderService = standingOrderService
+	}
+}
+
+// WithDirectDebitService enables direct debit endpoints
+func WithDirectDebitService(directDebitService domains.DirectDebitService) Option {
+	return func(h *Handlers) {
+		h.directDebitService = directDebitService
+	}
+}
+
+// WithPayeeService enables payee endpoints
+func WithPayeeService(payeeService domains.PayeeService) Option {
+	return func(h *Handlers) {
+		h.payeeService = payeeService
+	}
+}
+
+// WithProductService enables product catalogue endpoints
+func WithProductService(productService domains.ProductService) Option {
+	return func(h *Handlers) {
+		h.productService = productService
+	}
+}
+
+// WithAnalyticsService replaces the default insights, which aggregate the
+// transaction service's history
+func WithAnalyticsService(analyticsService domains.AnalyticsService) Option {
+	return func(h *Handlers) {
+		h.analyticsService = analyticsService
+	}
 }
 
 // NewHandlers creates a new handlers instance
@@ -24,232 +98,525 @@ func NewHandlers(
 	transactionService domains.TransactionService,
 	balanceService domains.BalanceService,
 	consentService domains.ConsentService,
+	opts ...Option,
 ) *Handlers {
-	return &Handlers{
-		accountService:     accountService,
-		transactionService: transactionService,
-		balanceService:     balanceService,
-		consentService:     consentService,
+	h := &Handlers{
+		accountService:         accountService,
+		transactionService:     transactionService,
+		balanceService:         balanceService,
+		consentService:         consentService,
+		unversionedDeprecation: DefaultUnversionedDeprecation,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	if len(h.apiVersions) == 0 {
+		h.apiVersions = DefaultAPIVersions()
 	}
+	if h.customerService != nil && h.fxService != nil {
+		h.balanceAggregator = domains.NewBalanceAggregator(h.customerService, accountService, h.fxService)
+	}
+	if h.analyticsService == nil {
+		h.analyticsService = domains.NewTransactionAnalytics(transactionService)
+	}
+	return h
 }
 
 // Account handlers
 
 // GetAccount handles GET /accounts/{id}
 func (h *Handlers) GetAccount(w http.ResponseWriter, r *http.Request) {
-	accountID := strings.TrimPrefix(r.URL.Path, "/accounts/")
-	if accountID == "" || accountID == "/accounts/" {
-		WriteInvalidInputError(w, "Account ID is required")
-		return
-	}
+	accountID := r.PathValue("id")
 	
 	account, err := h.accountService.RetrieveCurrentAccount(r.Context(), accountID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			WriteNotFoundError(w, "account", accountID)
-			return
-		}
-		WriteInternalError(w, err)
+		WriteServiceError(w, err, "account", accountID)
 		return
 	}
 	
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(account)
+	h.writeResource(w, r, account, map[string]string{
+		"balance":      "/accounts/" + accountID + "/balance",
+		"balances":     "/accounts/" + accountID + "/balances",
+		"transactions": "/accounts/" + accountID + "/transactions",
+		"insights":     "/accounts/" + accountID + "/insights",
+	})
 }
 
 // GetAccountBalance handles GET /accounts/{id}/balance
 func (h *Handlers) GetAccountBalance(w http.ResponseWriter, r *http.Request) {
-	// Extract account ID from path like /accounts/{id}/balance
-	path := strings.TrimPrefix(r.URL.Path, "/accounts/")
-	parts := strings.Split(path, "/")
-	if len(parts) < 2 || parts[0] == "" {
-		WriteInvalidInputError(w, "Account ID is required")
-		return
-	}
-	accountID := parts[0]
+	accountID := r.PathValue("id")
 	
 	balance, err := h.accountService.RetrieveCurrentAccountBalance(r.Context(), accountID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			WriteNotFoundError(w, "account", accountID)
-			return
-		}
-		WriteInternalError(w, err)
+		WriteServiceError(w, err, "account", accountID)
 		return
 	}
 	
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(balance)
+	h.writeResource(w, r, balance, map[string]string{
+		"account": "/accounts/" + accountID,
+	})
 }
 
 // Transaction handlers
 
 // GetTransaction handles GET /transactions/{id}
 func (h *Handlers) GetTransaction(w http.ResponseWriter, r *http.Request) {
-	transactionID := strings.TrimPrefix(r.URL.Path, "/transactions/")
-	if transactionID == "" || transactionID == "/transactions/" {
-		WriteInvalidInputError(w, "Transaction ID is required")
-		return
-	}
+	transactionID := r.PathValue("id")
 	
 	transaction, err := h.transactionService.RetrievePaymentTransaction(r.Context(), transactionID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			WriteNotFoundError(w, "transaction", transactionID)
-			return
-		}
-		WriteInternalError(w, err)
+		WriteServiceError(w, err, "transaction", transactionID)
 		return
 	}
 	
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(transaction)
+	h.writeResource(w, r, transaction, map[string]string{
+		"account": "/accounts/" + transaction.AccountID,
+	})
 }
 
 // GetAccountTransactions handles GET /accounts/{id}/transactions
 func (h *Handlers) GetAccountTransactions(w http.ResponseWriter, r *http.Request) {
-	// Extract account ID from path like /accounts/{id}/transactions
-	path := strings.TrimPrefix(r.URL.Path, "/accounts/")
-	parts := strings.Split(path, "/")
-	if len(parts) < 2 || parts[0] == "" {
-		WriteInvalidInputError(w, "Account ID is required")
-		return
-	}
-	accountID := parts[0]
+	accountID := r.PathValue("id")
 	
-	// Parse query parameters
+	// Parse query parameters, collecting every invalid field
 	opts := domains.HistoryOptions{}
+	var fields models.FieldErrors
+	query := r.URL.Query()
 	
-	if fromDateStr := r.URL.Query().Get("fromDate"); fromDateStr != "" {
+	if fromDateStr := query.Get("fromDate"); fromDateStr != "" {
 		fromDate, err := time.Parse("2006-01-02", fromDateStr)
 		if err != nil {
-			WriteInvalidInputError(w, "Invalid fromDate format, use YYYY-MM-DD")
-			return
+			fields.Add("fromDate", "must be a date in YYYY-MM-DD format")
+		} else {
+			opts.FromDate = &fromDate
 		}
-		opts.FromDate = &fromDate
 	}
 	
-	if toDateStr := r.URL.Query().Get("toDate"); toDateStr != "" {
+	if toDateStr := query.Get("toDate"); toDateStr != "" {
 		toDate, err := time.Parse("2006-01-02", toDateStr)
 		if err != nil {
-			WriteInvalidInputError(w, "Invalid toDate format, use YYYY-MM-DD")
-			return
+			fields.Add("toDate", "must be a date in YYYY-MM-DD format")
+		} else {
+			opts.ToDate = &toDate
 		}
-		opts.ToDate = &toDate
 	}
 	
-	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+	if limitStr := query.Get("limit"); limitStr != "" {
 		limit, err := strconv.Atoi(limitStr)
 		if err != nil || limit <= 0 {
-			WriteInvalidInputError(w, "Invalid limit, must be a positive integer")
-			return
-		}
-		if limit > 500 {
-			WriteInvalidInputError(w, "Limit cannot exceed 500")
-			return
+			fields.Add("limit", "must be a positive integer")
+		} else {
+			opts.Limit = limit
 		}
-		opts.Limit = limit
 	}
 	
-	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+	if offsetStr := query.Get("offset"); offsetStr != "" {
 		offset, err := strconv.Atoi(offsetStr)
 		if err != nil || offset < 0 {
-			WriteInvalidInputError(w, "Invalid offset, must be a non-negative integer")
-			return
+			fields.Add("offset", "must be a non-negative integer")
+		} else {
+			opts.Offset = offset
 		}
-		opts.Offset = offset
 	}
 	
-	transactions, err := h.transactionService.RetrievePaymentTransactionHistory(r.Context(), accountID, opts)
+	fields.Nested("", opts.Validate())
+	if err := fields.Err(); err != nil {
+		ve, _ := models.AsValidationError(err)
+		WriteValidationError(w, ve)
+		return
+	}
+	
+	// Enveloped responses fetch one extra transaction to learn whether another page follows
+	probe := h.wantsEnvelope(r) && opts.Limit > 0 && opts.Limit < domains.MaxHistoryLimit
+	fetch := opts
+	if probe {
+		fetch.Limit++
+	}
+	
+	transactions, err := h.transactionService.RetrievePaymentTransactionHistory(r.Context(), accountID, fetch)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			WriteNotFoundError(w, "account", accountID)
-			return
+		WriteServiceError(w, err, "account", accountID)
+		return
+	}
+	
+	meta := Meta{Limit: opts.Limit, Offset: opts.Offset}
+	if probe && len(transactions) > opts.Limit {
+		transactions = transactions[:opts.Limit]
+		meta.HasMore = true
+	} else if !probe && opts.Limit > 0 && len(transactions) == opts.Limit {
+		// A full page at the maximum size may or may not be followed by another
+		meta.HasMore = true
+	}
+	meta.Count = len(transactions)
+	// The last page gives the total, unless the offset is past the end and
+	// the number of items is unknown
+	if !meta.HasMore && (len(transactions) > 0 || opts.Offset == 0) {
+		total := opts.Offset + len(transactions)
+		meta.TotalCount = &total
+	}
+	
+	h.writeCollection(w, r, transactions, meta, map[string]string{
+		"account": "/accounts/" + accountID,
+	})
+}
+
+// GetAccountInsights handles GET /accounts/{id}/insights?fromDate=&toDate=&period=DAY|WEEK|MONTH
+func (h *Handlers) GetAccountInsights(w http.ResponseWriter, r *http.Request) {
+	accountID := r.PathValue("id")
+	
+	// Parse query parameters, collecting every invalid field
+	opts := domains.InsightsOptions{}
+	var fields models.FieldErrors
+	query := r.URL.Query()
+	
+	if fromDateStr := query.Get("fromDate"); fromDateStr != "" {
+		fromDate, err := time.Parse("2006-01-02", fromDateStr)
+		if err != nil {
+			fields.Add("fromDate", "must be a date in YYYY-MM-DD format")
+		} else {
+			opts.FromDate = &fromDate
 		}
-		WriteInternalError(w, err)
+	}
+	
+	if toDateStr := query.Get("toDate"); toDateStr != "" {
+		toDate, err := time.Parse("2006-01-02", toDateStr)
+		if err != nil {
+			fields.Add("toDate", "must be a date in YYYY-MM-DD format")
+		} else {
+			opts.ToDate = &toDate
+		}
+	}
+	
+	opts.Period = models.InsightPeriod(query.Get("period"))
+	
+	fields.Nested("", opts.Validate())
+	if err := fields.Err(); err != nil {
+		ve, _ := models.AsValidationError(err)
+		WriteValidationError(w, ve)
 		return
 	}
 	
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(transactions)
+	insights, err := h.analyticsService.RetrieveAccountInsights(r.Context(), accountID, opts)
+	if err != nil {
+		WriteServiceError(w, err, "account", accountID)
+		return
+	}
+	
+	h.writeResource(w, r, insights, map[string]string{
+		"account":      "/accounts/" + accountID,
+		"transactions": "/accounts/" + accountID + "/transactions",
+	})
 }
 
 // Balance handlers
 
 // GetBalances handles GET /accounts/{id}/balances (all balance types)
 func (h *Handlers) GetBalances(w http.ResponseWriter, r *http.Request) {
-	// Extract account ID from path like /accounts/{id}/balances
-	path := strings.TrimPrefix(r.URL.Path, "/accounts/")
-	parts := strings.Split(path, "/")
-	if len(parts) < 2 || parts[0] == "" {
-		WriteInvalidInputError(w, "Account ID is required")
-		return
-	}
-	accountID := parts[0]
+	accountID := r.PathValue("id")
 	
 	balances, err := h.balanceService.RetrieveAccountBalance(r.Context(), accountID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			WriteNotFoundError(w, "account", accountID)
-			return
-		}
-		WriteInternalError(w, err)
+		WriteServiceError(w, err, "account", accountID)
 		return
 	}
 	
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(balances)
+	h.writeResource(w, r, balances, map[string]string{
+		"account": "/accounts/" + accountID,
+	})
 }
 
 // Consent handlers
 
 // GetConsent handles GET /consents/{id}
 func (h *Handlers) GetConsent(w http.ResponseWriter, r *http.Request) {
-	consentID := strings.TrimPrefix(r.URL.Path, "/consents/")
-	if consentID == "" || consentID == "/consents/" {
-		WriteInvalidInputError(w, "Consent ID is required")
-		return
-	}
+	consentID := r.PathValue("id")
 	
 	consent, err := h.consentService.RetrieveConsent(r.Context(), consentID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			WriteNotFoundError(w, "consent", consentID)
-			return
-		}
-		WriteInternalError(w, err)
+		WriteServiceError(w, err, "consent", consentID)
 		return
 	}
 	
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(consent)
+	h.writeResource(w, r, consent, map[string]string{
+		"status": "/consents/" + consentID + "/status",
+	})
 }
 
 // GetConsentStatus handles GET /consents/{id}/status
 func (h *Handlers) GetConsentStatus(w http.ResponseWriter, r *http.Request) {
-	// Extract consent ID from path like /consents/{id}/status
-	path := strings.TrimPrefix(r.URL.Path, "/consents/")
-	parts := strings.Split(path, "/")
-	if len(parts) < 2 || parts[0] == "" {
-		WriteInvalidInputError(w, "Consent ID is required")
+	consentID := r.PathValue("id")
+	
+	status, err := h.consentService.RetrieveConsentStatus(r.Context(), consentID)
+	if err != nil {
+		WriteServiceError(w, err, "consent", consentID)
 		return
 	}
-	consentID := parts[0]
 	
-	status, err := h.consentService.RetrieveConsentStatus(r.Context(), consentID)
+	response := map[string]interface{}{
+		"status": status,
+	}
+	
+	h.writeResource(w, r, response, map[string]string{
+		"consent": "/consents/" + consentID,
+	})
+}
+
+// Card handlers
+
+// GetCard handles GET /cards/{id}
+func (h *Handlers) GetCard(w http.ResponseWriter, r *http.Request) {
+	cardID := r.PathValue("id")
+	
+	card, err := h.cardService.RetrieveCard(r.Context(), cardID)
+	if err != nil {
+		WriteServiceError(w, err, "card", cardID)
+		return
+	}
+	
+	h.writeResource(w, r, card, map[string]string{
+		"account": "/accounts/" + card.AccountID,
+	})
+}
+
+// GetAccountCard handles GET /accounts/{id}/card
+func (h *Handlers) GetAccountCard(w http.ResponseWriter, r *http.Request) {
+	accountID := r.PathValue("id")
+	
+	card, err := h.cardService.RetrieveAccountCard(r.Context(), accountID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
-			WriteNotFoundError(w, "consent", consentID)
+		if !domains.IsNotFound(err) {
+			WriteServiceError(w, err, "account", accountID)
+			return
+		}
+		// Tell an unknown account apart from an account without a card
+		if _, err := h.accountService.RetrieveCurrentAccount(r.Context(), accountID); err != nil {
+			WriteServiceError(w, err, "account", accountID)
 			return
 		}
+		WriteErrorResponse(w, ErrorCodeNotFound, 
+			"card not found", 
+			"No card is issued on account "+accountID, 
+			http.StatusNotFound)
+		return
+	}
+	
+	h.writeResource(w, r, card, map[string]string{
+		"account": "/accounts/" + accountID,
+		"card":    "/cards/" + card.ID,
+	})
+}
+
+// Scheduled payment handlers
+
+// GetStandingOrder handles GET /standing-orders/{id}
+func (h *Handlers) GetStandingOrder(w http.ResponseWriter, r *http.Request) {
+	standingOrderID := r.PathValue("id")
+	
+	standingOrder, err := h.standingOrderService.RetrieveStandingOrder(r.Context(), standingOrderID)
+	if err != nil {
+		WriteServiceError(w, err, "standing order", standingOrderID)
+		return
+	}
+	
+	h.writeResource(w, r, standingOrder, map[string]string{
+		"account": "/accounts/" + standingOrder.AccountID,
+	})
+}
+
+// GetAccountStandingOrders handles GET /accounts/{id}/standing-orders
+func (h *Handlers) GetAccountStandingOrders(w http.ResponseWriter, r *http.Request) {
+	accountID := r.PathValue("id")
+	
+	standingOrders, err := h.standingOrderService.RetrieveAccountStandingOrders(r.Context(), accountID)
+	if err != nil {
+		WriteServiceError(w, err, "account", accountID)
+		return
+	}
+	
+	h.writeResource(w, r, standingOrders, map[string]string{
+		"account": "/accounts/" + accountID,
+	})
+}
+
+// GetDirectDebit handles GET /direct-debits/{id}
+func (h *Handlers) GetDirectDebit(w http.ResponseWriter, r *http.Request) {
+	directDebitID := r.PathValue("id")
+	
+	directDebit, err := h.directDebitService.RetrieveDirectDebit(r.Context(), directDebitID)
+	if err != nil {
+		WriteServiceError(w, err, "direct debit", directDebitID)
+		return
+	}
+	
+	h.writeResource(w, r, directDebit, map[string]string{
+		"account": "/accounts/" + directDebit.AccountID,
+	})
+}
+
+// GetAccountDirectDebits handles GET /accounts/{id}/direct-debits
+func (h *Handlers) GetAccountDirectDebits(w http.ResponseWriter, r *http.Request) {
+	accountID := r.PathValue("id")
+	
+	directDebits, err := h.directDebitService.RetrieveAccountDirectDebits(r.Context(), accountID)
+	if err != nil {
+		WriteServiceError(w, err, "account", accountID)
+		return
+	}
+	
+	h.writeResource(w, r, directDebits, map[string]string{
+		"account": "/accounts/" + accountID,
+	})
+}
+
+// Payee handlers
+
+// maxPayeeBodyBytes bounds the size of a payee creation request body
+const maxPayeeBodyBytes = 64 << 10
+
+// GetPayees handles GET /customers/{id}/payees
+func (h *Handlers) GetPayees(w http.ResponseWriter, r *http.Request) {
+	customerID := r.PathValue("id")
+	
+	payees, err := h.payeeService.RetrievePayees(r.Context(), customerID)
+	if err != nil {
+		WriteServiceError(w, err, "customer", customerID)
+		return
+	}
+	
+	h.writeResource(w, r, payees, nil)
+}
+
+// CreatePayee handles POST /customers/{id}/payees
+func (h *Handlers) CreatePayee(w http.ResponseWriter, r *http.Request) {
+	customerID := r.PathValue("id")
+	
+	var payee models.Payee
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPayeeBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&payee); err != nil {
+		WriteInvalidInputError(w, "Request body must be a JSON payee: "+err.Error())
+		return
+	}
+	
+	// The customer comes from the path; identification is assigned on creation
+	payee.CustomerID = customerID
+	payee.ID = ""
+	if err := payee.ValidateInput(); err != nil {
+		ve, _ := models.AsValidationError(err)
+		WriteValidationError(w, ve)
+		return
+	}
+	
+	created, err := h.payeeService.CreatePayee(r.Context(), &payee)
+	if err != nil {
+		WriteServiceError(w, err, "customer", customerID)
+		return
+	}
+	
+	h.writeCreated(w, r, created, "/payees/"+created.ID, map[string]string{
+		"payees": "/customers/" + customerID + "/payees",
+	})
+}
+
+// GetPayee handles GET /payees/{id}
+func (h *Handlers) GetPayee(w http.ResponseWriter, r *http.Request) {
+	payeeID := r.PathValue("id")
+	
+	payee, err := h.payeeService.RetrievePayee(r.Context(), payeeID)
+	if err != nil {
+		WriteServiceError(w, err, "payee", payeeID)
+		return
+	}
+	
+	h.writeResource(w, r, payee, map[string]string{
+		"payees": "/customers/" + payee.CustomerID + "/payees",
+	})
+}
+
+// DeletePayee handles DELETE /payees/{id}
+func (h *Handlers) DeletePayee(w http.ResponseWriter, r *http.Request) {
+	payeeID := r.PathValue("id")
+	
+	if err := h.payeeService.DeletePayee(r.Context(), payeeID); err != nil {
+		WriteServiceError(w, err, "payee", payeeID)
+		return
+	}
+	
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Product handlers
+
+// GetProducts handles GET /products?category=XXX&effective=CURRENT|FUTURE|ALL
+func (h *Handlers) GetProducts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := domains.ProductFilter{
+		Category:  models.ProductCategory(query.Get("category")),
+		Effective: domains.ProductEffective(query.Get("effective")),
+	}
+	if err := filter.Validate(); err != nil {
+		ve, _ := models.AsValidationError(err)
+		WriteValidationError(w, ve)
+		return
+	}
+	
+	products, err := h.productService.RetrieveProducts(r.Context(), filter)
+	if err != nil {
 		WriteInternalError(w, err)
 		return
 	}
 	
-	response := map[string]interface{}{
-		"status": status,
+	h.writeResource(w, r, products, nil)
+}
+
+// GetProduct handles GET /products/{id}
+func (h *Handlers) GetProduct(w http.ResponseWriter, r *http.Request) {
+	productID := r.PathValue("id")
+	
+	product, err := h.productService.RetrieveProduct(r.Context(), productID)
+	if err != nil {
+		WriteServiceError(w, err, "product", productID)
+		return
+	}
+	
+	h.writeResource(w, r, product, nil)
+}
+
+// Customer handlers
+
+// GetCustomerBalances handles GET /customers/{id}/balances?currency=XXX
+func (h *Handlers) GetCustomerBalances(w http.ResponseWriter, r *http.Request) {
+	customerID := r.PathValue("id")
+	
+	var fields models.FieldErrors
+	currency := r.URL.Query().Get("currency")
+	if currency == "" {
+		fields.Add("currency", "is required")
+	} else if _, err := models.CurrencyExponent(currency); err != nil {
+		fields.Add("currency", "must be an ISO 4217 currency code")
+	}
+	if err := fields.Err(); err != nil {
+		ve, _ := models.AsValidationError(err)
+		WriteValidationError(w, ve)
+		return
 	}
 	
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(response)
-}
\ No newline at end of file
+	summary, err := h.balanceAggregator.AggregateCustomerBalances(r.Context(), customerID, currency)
+	if err != nil {
+		// The client chose the reporting currency, so a missing rate is a request error
+		if errors.Is(err, domains.ErrRateUnavailable) {
+			fields.Add("currency", "no exchange rate is available into %s", currency)
+			ve, _ := models.AsValidationError(fields.Err())
+			WriteValidationError(w, ve)
+			return
+		}
+		WriteServiceError(w, err, "customer", customerID)
+		return
+	}
+	
+	related := make(map[string]string, len(summary.Accounts))
+	for _, account := range summary.Accounts {
+		related[account.AccountID] = "/accounts/" + account.AccountID
+	}
+	h.writeResource(w, r, summary, related)
+}
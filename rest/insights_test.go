@@ -0,0 +1,125 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/providers/enrich"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+	"github.com/shopspring/decimal"
+)
+
+func TestAccountInsights(t *testing.T) {
+	provider := mock.NewProvider()
+	transactions := domains.NewEnrichingTransactionService(provider, enrich.NewDefaultRulesEnricher())
+	handler := NewServer(provider, transactions, provider, provider).Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/v1/accounts/acc-001/insights?period=WEEK", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200 (body %s)", rec.Code, rec.Body.String())
+	}
+
+	var insights models.AccountInsights
+	if err := json.Unmarshal(rec.Body.Bytes(), &insights); err != nil {
+		t.Fatal(err)
+	}
+	if insights.Period != models.InsightPeriodWeek {
+		t.Errorf("period = %s, want WEEK", insights.Period)
+	}
+
+	// Five sample transactions: one 2500.00 credit and four debits
+	if len(insights.Totals) != 1 {
+		t.Fatalf("totals = %+v, want one currency", insights.Totals)
+	}
+	totals := insights.Totals[0]
+	if totals.Currency != "AUD" || totals.Count != 5 {
+		t.Errorf("totals = %s x%d, want AUD x5", totals.Currency, totals.Count)
+	}
+	for name, tt := range map[string]struct{ got, want string }{
+		"inflow":  {totals.Inflow.Amount.String(), "2500"},
+		"outflow": {totals.Outflow.Amount.String(), "173.29"},
+		"net":     {totals.Net.Amount.String(), "2326.71"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s = %s, want %s", name, tt.got, tt.want)
+		}
+	}
+
+	// Every transaction falls in exactly one period
+	count := 0
+	for _, period := range insights.Periods {
+		for _, flow := range period.CashFlow {
+			count += flow.Count
+		}
+	}
+	if count != 5 {
+		t.Errorf("periods hold %d transactions, want 5", count)
+	}
+
+	categories := make(map[string]models.CashFlow, len(insights.ByCategory))
+	for _, group := range insights.ByCategory {
+		categories[group.Key] = group.CashFlow[0]
+	}
+	if groceries := categories["GROCERIES"]; groceries.Count != 1 || !groceries.Outflow.Amount.Equal(decimal.RequireFromString("45.50")) {
+		t.Errorf("GROCERIES = %+v, want one 45.50 debit", groceries)
+	}
+	if salary := categories["SALARY"]; !salary.Inflow.Amount.Equal(decimal.RequireFromString("2500")) {
+		t.Errorf("SALARY = %+v, want 2500.00 inflow", salary)
+	}
+	if _, ok := categories[models.UncategorisedKey]; ok {
+		t.Errorf("enriched transactions grouped as %s", models.UncategorisedKey)
+	}
+
+	if len(insights.ByMerchant) != 5 || insights.ByMerchant[0].Key != "ACME Corp" {
+		t.Errorf("byMerchant = %+v, want five merchants starting with ACME Corp", insights.ByMerchant)
+	}
+}
+
+func TestAccountInsights_Errors(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{name: "unknown period", path: "/v1/accounts/acc-001/insights?period=YEAR", want: http.StatusBadRequest},
+		{name: "reversed range", path: "/v1/accounts/acc-001/insights?fromDate=2024-02-01&toDate=2024-01-01", want: http.StatusBadRequest},
+		{name: "too many periods", path: "/v1/accounts/acc-001/insights?fromDate=2020-01-01&toDate=2024-01-01&period=DAY", want: http.StatusBadRequest},
+		{name: "malformed date", path: "/v1/accounts/acc-001/insights?fromDate=01/02/2024", want: http.StatusBadRequest},
+		{name: "unknown account", path: "/v1/accounts/acc-999/insights", want: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d (body %s)", rec.Code, tt.want, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestAccountInsights_AllFieldErrors(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	// A malformed date and an unknown period are reported together
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/v1/accounts/acc-001/insights?fromDate=01/02/2024&period=YEAR", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400 (body %s)", rec.Code, rec.Body.String())
+	}
+	got := make(map[string]bool)
+	for _, field := range decodeError(t, rec).Fields {
+		got[field.Field] = true
+	}
+	if !got["fromDate"] || !got["period"] {
+		t.Errorf("fields = %v, want fromDate and period", got)
+	}
+}
@@ -1,25 +1,36 @@
 package rest
 
 import (
+	"bufio"
 	"log"
+	"net"
 	"net/http"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/serverlesscloud/bian-go/apierr"
 )
 
 // Middleware represents an HTTP middleware function
 type Middleware func(http.Handler) http.Handler
 
-// RequestLoggingMiddleware logs HTTP requests with request ID and timing
+// Wrap applies the middleware shared by every route: request logging, panic
+// recovery and CORS
+func Wrap(handler http.Handler, allowedOrigins []string) http.Handler {
+	// Apply middleware in reverse order (last applied = first executed)
+	handler = CORSMiddleware(allowedOrigins)(handler)
+	handler = ErrorRecoveryMiddleware(handler)
+	handler = RequestLoggingMiddleware(handler)
+	
+	return handler
+}
+
+// RequestLoggingMiddleware logs HTTP requests with request ID and timing. The
+// request ID is assigned by apierr.RequestIDMiddleware, so handlers and
+// GraphQL errors report the same ID as the log.
 func RequestLoggingMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return apierr.RequestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
-		requestID := uuid.New().String()[:8]
-		
-		// Add request ID to context for potential use in handlers
-		r.Header.Set("X-Request-ID", requestID)
-		w.Header().Set("X-Request-ID", requestID)
+		requestID := apierr.RequestIDFromContext(r.Context())
 		
 		// Wrap response writer to capture status code
 		wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -29,7 +40,7 @@ func RequestLoggingMiddleware(next http.Handler) http.Handler {
 		duration := time.Since(start)
 		log.Printf("[%s] %s %s - %d - %v", 
 			requestID, r.Method, r.URL.Path, wrapped.statusCode, duration)
-	})
+	}))
 }
 
 // ErrorRecoveryMiddleware recovers from panics and returns 500 error
@@ -68,8 +79,8 @@ func CORSMiddleware(allowedOrigins []string) Middleware {
 			}
 			
 			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID")
-			w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Request-ID, API-Version")
+			w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, API-Version, Deprecation, Sunset, Link")
 			
 			// Handle preflight requests
 			if r.Method == "OPTIONS" {
@@ -105,4 +116,22 @@ type responseWriter struct {
 func (rw *responseWriter) WriteHeader(code int) {
 	rw.statusCode = code
 	rw.ResponseWriter.WriteHeader(code)
-}
\ No newline at end of file
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+// Flush supports streaming responses such as Server-Sent Events
+func (rw *responseWriter) Flush() {
+	if flusher, ok := rw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack supports WebSocket upgrades
+func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	rw.statusCode = http.StatusSwitchingProtocols
+	return http.NewResponseController(rw.ResponseWriter).Hijack()
+}
@@ -0,0 +1,1010 @@
+package rest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/serverlesscloud/bian-go/buildinfo"
+	"github.com/serverlesscloud/bian-go/domains"
+)
+
+// OpenAPI is the subset of an OpenAPI 3.1 document used to describe the REST API
+type OpenAPI struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       OpenAPIInfo          `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components OpenAPIComponents    `json:"components"`
+}
+
+// OpenAPIInfo describes the API
+type OpenAPIInfo struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// OpenAPIComponents holds reusable schemas and responses
+type OpenAPIComponents struct {
+	Schemas   map[string]*Schema   `json:"schemas"`
+	Responses map[string]*Response `json:"responses"`
+}
+
+// PathItem holds the operations available on a path, keyed by lower-case HTTP method
+type PathItem map[string]*Operation
+
+// Operation describes a single API operation on a path
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+	Deprecated  bool                 `json:"deprecated,omitempty"`
+}
+
+// RequestBody describes the body an operation accepts
+type RequestBody struct {
+	Description string                `json:"description,omitempty"`
+	Required    bool                  `json:"required,omitempty"`
+	Content     map[string]*MediaType `json:"content"`
+}
+
+// Parameter describes a path or query parameter
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Response describes a response body, or references a shared response
+type Response struct {
+	Ref         string                `json:"$ref,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Headers     map[string]*Header    `json:"headers,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// Header describes a response header
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// MediaType holds the schema of a request or response body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is a JSON Schema (2020-12) object as used by OpenAPI 3.1
+type Schema struct {
+	Ref         string             `json:"$ref,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Description string             `json:"description,omitempty"`
+	ReadOnly    bool               `json:"readOnly,omitempty"`
+	Enum        []string           `json:"enum,omitempty"`
+	Pattern     string             `json:"pattern,omitempty"`
+	Minimum     *int               `json:"minimum,omitempty"`
+	Maximum     *int               `json:"maximum,omitempty"`
+	Examples    []string           `json:"examples,omitempty"`
+	AllOf       []*Schema          `json:"allOf,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+}
+
+// OpenAPISpec describes the routes this server exposes. Resource operations
+// appear under every API version prefix and, marked deprecated, on their
+// unprefixed aliases. Customer endpoints are only included when
+// CustomerService and FXService are configured; card, standing order,
+// direct debit and payee endpoints when their services are.
+func (s *Server) OpenAPISpec() *OpenAPI {
+	spec := &OpenAPI{
+		OpenAPI: "3.1.0",
+		Info: OpenAPIInfo{
+			Title:   "bian-go REST API",
+			Version: buildinfo.Version,
+			Description: "BIAN " + buildinfo.BIANVersion + " aligned banking API. Monetary amounts are decimal strings, never floating point numbers. " +
+				"Resources are versioned by path prefix (/v1/...); unprefixed paths select a version with the API-Version header or an " +
+				"application/vnd.bian-go.{version}+json Accept media type. " +
+				"Send Accept: application/vnd.api+json to receive responses in a data/meta/links envelope. " +
+				"BIAN semantic paths (/{ServiceDomain}/{cr-reference-id}/Retrieve) return control record envelopes.",
+		},
+		Paths: map[string]*PathItem{
+			"/health": {
+				"get": {
+					OperationID: "getHealth",
+					Summary:     "Service health and build versions",
+					Tags:        []string{"Health"},
+					Responses: map[string]*Response{
+						"200": jsonResponse("Service is healthy", ref("Health")),
+					},
+				},
+			},
+		},
+		Components: OpenAPIComponents{
+			Schemas:   openAPISchemas(),
+			Responses: openAPIErrorResponses(),
+		},
+	}
+
+	resources := map[string]*PathItem{
+		"/accounts/{id}": {
+			"get": {
+				OperationID: "getAccount",
+				Summary:     "Retrieve a current account",
+				Tags:        []string{"Accounts"},
+				Parameters:  []*Parameter{pathParam("id", "Account ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The account", ref("Account")),
+				}, "400", "403", "404", "429", "500"),
+			},
+		},
+		"/accounts/{id}/balance": {
+			"get": {
+				OperationID: "getAccountBalance",
+				Summary:     "Retrieve the current balance of an account",
+				Tags:        []string{"Balances"},
+				Parameters:  []*Parameter{pathParam("id", "Account ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The current balance", ref("Balance")),
+				}, "400", "403", "404", "429", "500"),
+			},
+		},
+		"/accounts/{id}/balances": {
+			"get": {
+				OperationID: "getAccountBalances",
+				Summary:     "Retrieve all balance types of an account",
+				Tags:        []string{"Balances"},
+				Parameters:  []*Parameter{pathParam("id", "Account ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("Current, available and pending balances", arrayOf(ref("Balance"))),
+				}, "400", "403", "404", "429", "500"),
+			},
+		},
+		"/accounts/{id}/transactions": {
+			"get": {
+				OperationID: "getAccountTransactions",
+				Summary:     "Retrieve the transaction history of an account",
+				Description: "Transactions are returned newest first. The date range is inclusive.",
+				Tags:        []string{"Transactions"},
+				Parameters: []*Parameter{
+					pathParam("id", "Account ID"),
+					queryParam("fromDate", "Earliest posting date (inclusive)", dateSchema()),
+					queryParam("toDate", "Latest posting date (inclusive)", dateSchema()),
+					queryParam("limit", "Maximum number of transactions to return", intSchema(1, domains.MaxHistoryLimit)),
+					queryParam("offset", "Number of transactions to skip", intSchema(0, 0)),
+				},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("Transactions for the account", arrayOf(ref("Transaction"))),
+				}, "400", "403", "404", "429", "500"),
+			},
+		},
+		"/accounts/{id}/insights": {
+			"get": {
+				OperationID: "getAccountInsights",
+				Summary:     "Retrieve cash-flow insights for an account",
+				Description: "Aggregates the transaction history by period, transaction type, category and merchant. " +
+					"Amounts are totalled per currency and never converted. The date range is inclusive and may cover at most " +
+					strconv.Itoa(domains.MaxInsightPeriods) + " periods.",
+				Tags: []string{"Insights"},
+				Parameters: []*Parameter{
+					pathParam("id", "Account ID"),
+					queryParam("fromDate", "First day of the range (default three months before toDate)", dateSchema()),
+					queryParam("toDate", "Last day of the range (default today)", dateSchema()),
+					queryParam("period", "Length of each period (default MONTH)", ref("InsightPeriod")),
+				},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("Cash-flow insights for the account", ref("AccountInsights")),
+				}, "400", "403", "404", "429", "500"),
+			},
+		},
+		"/transactions/{id}": {
+			"get": {
+				OperationID: "getTransaction",
+				Summary:     "Retrieve a payment transaction",
+				Tags:        []string{"Transactions"},
+				Parameters:  []*Parameter{pathParam("id", "Transaction ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The transaction", ref("Transaction")),
+				}, "400", "403", "404", "429", "500"),
+			},
+		},
+		"/consents/{id}": {
+			"get": {
+				OperationID: "getConsent",
+				Summary:     "Retrieve a customer consent",
+				Tags:        []string{"Consents"},
+				Parameters:  []*Parameter{pathParam("id", "Consent ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The consent", ref("Consent")),
+				}, "400", "403", "404", "429", "500"),
+			},
+		},
+		"/consents/{id}/status": {
+			"get": {
+				OperationID: "getConsentStatus",
+				Summary:     "Retrieve the status of a customer consent",
+				Tags:        []string{"Consents"},
+				Parameters:  []*Parameter{pathParam("id", "Consent ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The consent status", ref("ConsentStatusResponse")),
+				}, "400", "403", "404", "429", "500"),
+			},
+		},
+	}
+
+	if s.handlers.balanceAggregator != nil {
+		resources["/customers/{id}/balances"] = &PathItem{
+			"get": {
+				OperationID: "getCustomerBalances",
+				Summary:     "Aggregate a customer's balances in a reporting currency",
+				Tags:        []string{"Customers"},
+				Parameters: []*Parameter{
+					pathParam("id", "Customer ID"),
+					{
+						Name:        "currency",
+						In:          "query",
+						Description: "ISO 4217 reporting currency; 400 when no exchange rate into it is available",
+						Required:    true,
+						Schema:      currencySchema(),
+					},
+				},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("Balances converted to the reporting currency", ref("CustomerBalanceSummary")),
+				}, "400", "404", "500"),
+			},
+		}
+	}
+
+	if s.handlers.cardService != nil {
+		resources["/cards/{id}"] = &PathItem{
+			"get": {
+				OperationID: "getCard",
+				Summary:     "Retrieve a payment card",
+				Tags:        []string{"Cards"},
+				Parameters:  []*Parameter{pathParam("id", "Card ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The card, with credit terms for credit card accounts", ref("Card")),
+				}, "400", "403", "404", "429", "500"),
+			},
+		}
+		resources["/accounts/{id}/card"] = &PathItem{
+			"get": {
+				OperationID: "getAccountCard",
+				Summary:     "Retrieve the card issued on an account",
+				Description: "Accounts without a card return 404.",
+				Tags:        []string{"Cards"},
+				Parameters:  []*Parameter{pathParam("id", "Account ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The card, with credit terms for credit card accounts", ref("Card")),
+				}, "400", "403", "404", "429", "500"),
+			},
+		}
+	}
+
+	if s.handlers.standingOrderService != nil {
+		resources["/standing-orders/{id}"] = scheduledPaymentPath("getStandingOrder", "Retrieve a standing order", "Standing order ID", "The standing order")
+		resources["/accounts/{id}/standing-orders"] = scheduledPaymentsPath("getAccountStandingOrders", "Retrieve the standing orders paid from an account")
+	}
+	if s.handlers.directDebitService != nil {
+		resources["/direct-debits/{id}"] = scheduledPaymentPath("getDirectDebit", "Retrieve a direct debit", "Direct debit ID", "The direct debit")
+		resources["/accounts/{id}/direct-debits"] = scheduledPaymentsPath("getAccountDirectDebits", "Retrieve the direct debits collected from an account")
+	}
+
+	if s.handlers.payeeService != nil {
+		created := resourceResponse("The created payee", ref("Payee"))
+		created.Headers = map[string]*Header{
+			"Location": {Description: "Path of the created payee", Schema: stringSchema("")},
+		}
+		resources["/customers/{id}/payees"] = &PathItem{
+			"get": {
+				OperationID: "getPayees",
+				Summary:     "Retrieve a customer's saved payees",
+				Description: "Payees are ordered by nickname.",
+				Tags:        []string{"Payees"},
+				Parameters:  []*Parameter{pathParam("id", "Customer ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The customer's payees", arrayOf(ref("Payee"))),
+				}, "400", "403", "404", "429", "500"),
+			},
+			"post": {
+				OperationID: "createPayee",
+				Summary:     "Save a new payee for a customer",
+				Description: "IBANs and ABA routing numbers must have valid check digits; BSBs and sort codes, which have none, must be well formed.",
+				Tags:        []string{"Payees"},
+				Parameters:  []*Parameter{pathParam("id", "Customer ID")},
+				RequestBody: &RequestBody{
+					Required: true,
+					Content:  map[string]*MediaType{"application/json": {Schema: ref("Payee")}},
+				},
+				Responses: withErrors(map[string]*Response{
+					"201": created,
+				}, "400", "403", "404", "429", "500"),
+			},
+		}
+		resources["/payees/{id}"] = &PathItem{
+			"get": {
+				OperationID: "getPayee",
+				Summary:     "Retrieve a saved payee",
+				Tags:        []string{"Payees"},
+				Parameters:  []*Parameter{pathParam("id", "Payee ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The payee", ref("Payee")),
+				}, "400", "403", "404", "429", "500"),
+			},
+			"delete": {
+				OperationID: "deletePayee",
+				Summary:     "Delete a saved payee",
+				Tags:        []string{"Payees"},
+				Parameters:  []*Parameter{pathParam("id", "Payee ID")},
+				Responses: withErrors(map[string]*Response{
+					"204": {Description: "The payee was deleted"},
+				}, "400", "403", "404", "429", "500"),
+			},
+		}
+	}
+
+	if s.handlers.productService != nil {
+		resources["/products"] = &PathItem{
+			"get": {
+				OperationID: "getProducts",
+				Summary:     "List the product catalogue",
+				Description: "Products follow CDR BankingProductV4 and are ordered by name. Only products offered now are returned unless effective is FUTURE or ALL.",
+				Tags:        []string{"Products"},
+				Parameters: []*Parameter{
+					queryParam("category", "Only products in this category", productCategorySchema()),
+					queryParam("effective", "Offering period to select (default CURRENT)", enum("CURRENT", "FUTURE", "ALL")),
+				},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("Matching products", arrayOf(ref("Product"))),
+				}, "400", "403", "429", "500"),
+			},
+		}
+		resources["/products/{id}"] = &PathItem{
+			"get": {
+				OperationID: "getProduct",
+				Summary:     "Retrieve a product with its rates, fees, constraints and eligibility",
+				Tags:        []string{"Products"},
+				Parameters:  []*Parameter{pathParam("id", "Product ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": resourceResponse("The product", ref("Product")),
+				}, "400", "403", "404", "429", "500"),
+			},
+		}
+	}
+
+	current := s.handlers.currentVersion()
+	for path, item := range resources {
+		for _, version := range s.handlers.apiVersions {
+			suffix := ""
+			if version.Name != current.Name {
+				suffix = strings.ToUpper(version.Name)
+			}
+			spec.Paths[version.Prefix()+path] = item.variant(suffix, version.Deprecated())
+		}
+		spec.Paths[path] = item.variant("Unversioned", true)
+	}
+
+	for path, item := range bianPaths() {
+		spec.Paths[path] = item
+	}
+
+	return spec
+}
+
+// scheduledPaymentPath documents retrieval of one standing order or direct debit
+func scheduledPaymentPath(operationID, summary, idDescription, description string) *PathItem {
+	return &PathItem{
+		"get": {
+			OperationID: operationID,
+			Summary:     summary,
+			Tags:        []string{"Scheduled Payments"},
+			Parameters:  []*Parameter{pathParam("id", idDescription)},
+			Responses: withErrors(map[string]*Response{
+				"200": resourceResponse(description, ref("ScheduledPayment")),
+			}, "400", "403", "404", "429", "500"),
+		},
+	}
+}
+
+// scheduledPaymentsPath documents listing an account's standing orders or direct debits
+func scheduledPaymentsPath(operationID, summary string) *PathItem {
+	return &PathItem{
+		"get": {
+			OperationID: operationID,
+			Summary:     summary,
+			Description: "Ordered by next execution date; schedules that have ended come last.",
+			Tags:        []string{"Scheduled Payments"},
+			Parameters:  []*Parameter{pathParam("id", "Account ID")},
+			Responses: withErrors(map[string]*Response{
+				"200": resourceResponse("Scheduled payments for the account", arrayOf(ref("ScheduledPayment"))),
+			}, "400", "403", "404", "429", "500"),
+		},
+	}
+}
+
+// bianPaths returns the BIAN semantic API operations
+func bianPaths() map[string]*PathItem {
+	return map[string]*PathItem{
+		"/CurrentAccount/{cr-reference-id}/Retrieve": {
+			"get": {
+				OperationID: "retrieveCurrentAccountFacility",
+				Summary:     "Retrieve a CurrentAccount control record",
+				Tags:        []string{"BIAN"},
+				Parameters:  []*Parameter{pathParam("cr-reference-id", "Account ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": jsonResponse("The CurrentAccountFacility control record", bianResponse("CurrentAccountFacility")),
+				}, "403", "404", "429", "500"),
+			},
+		},
+		"/CurrentAccount/{cr-reference-id}/Payments/{bq-reference-id}/Retrieve": {
+			"get": {
+				OperationID: "retrieveCurrentAccountPayments",
+				Summary:     "Retrieve a CurrentAccount Payments behaviour qualifier",
+				Description: "Payments posted to a different account are reported as not found.",
+				Tags:        []string{"BIAN"},
+				Parameters: []*Parameter{
+					pathParam("cr-reference-id", "Account ID"),
+					pathParam("bq-reference-id", "Transaction ID"),
+				},
+				Responses: withErrors(map[string]*Response{
+					"200": jsonResponse("The Payments behaviour qualifier", bianResponse("Payments")),
+				}, "403", "404", "429", "500"),
+			},
+		},
+		"/PaymentExecution/{cr-reference-id}/Retrieve": {
+			"get": {
+				OperationID: "retrievePaymentExecutionProcedure",
+				Summary:     "Retrieve a PaymentExecution control record",
+				Tags:        []string{"BIAN"},
+				Parameters:  []*Parameter{pathParam("cr-reference-id", "Transaction ID")},
+				Responses: withErrors(map[string]*Response{
+					"200": jsonResponse("The PaymentExecutionProcedure control record", bianResponse("PaymentExecutionProcedure")),
+				}, "403", "404", "429", "500"),
+			},
+		},
+	}
+}
+
+// bianResponse documents a BIAN envelope carrying the named record
+func bianResponse(record string) *Schema {
+	return &Schema{
+		AllOf: []*Schema{
+			ref("BIANResponse"),
+			object(map[string]*Schema{record: ref(record)}, record),
+		},
+	}
+}
+
+// variant copies a resource path item for one version, making operation IDs
+// unique and adding the 406 returned for unsupported versions
+func (p PathItem) variant(suffix string, deprecated bool) *PathItem {
+	item := make(PathItem, len(p))
+	for method, op := range p {
+		copied := *op
+		copied.OperationID += suffix
+		copied.Deprecated = deprecated
+		copied.Responses = make(map[string]*Response, len(op.Responses)+1)
+		for status, response := range op.Responses {
+			copied.Responses[status] = response
+		}
+		withErrors(copied.Responses, "406")
+		item[method] = &copied
+	}
+	return &item
+}
+
+// openAPISchemas returns the component schemas, mirroring the JSON encoding of the models
+func openAPISchemas() map[string]*Schema {
+	return map[string]*Schema{
+		"Money": object(map[string]*Schema{
+			"amount": {
+				Type:        "string",
+				Format:      "decimal",
+				Description: "Exact decimal amount encoded as a string to avoid floating point rounding",
+				Pattern:     `^-?\d+(\.\d+)?$`,
+				Examples:    []string{"1234.50"},
+			},
+			"currency": currencySchema(),
+		}, "amount", "currency"),
+		"Account": object(map[string]*Schema{
+			"id":            stringSchema(""),
+			"accountNumber": stringSchema(""),
+			"identifier":    ref("AccountIdentifier"),
+			"accountType":   enum("CHECKING", "SAVINGS", "CREDIT_CARD", "INVESTMENT"),
+			"productName":   stringSchema(""),
+			"nickname":      stringSchema("Customer-assigned name, omitted when unset"),
+			"productId":     stringSchema("Product the account was opened with, omitted when unknown"),
+			"status":        enum("OPEN", "CLOSED", "SUSPENDED"),
+			"openDate":      dateTimeSchema(),
+			"closeDate":     dateTimeSchema(),
+			"currency":      currencySchema(),
+		}, "id", "accountNumber", "accountType", "productName", "status", "openDate", "currency"),
+		"AccountIdentifier": object(map[string]*Schema{
+			"scheme":        enum("IBAN", "BSB", "SORT_CODE", "ABA", "CA_TRANSIT"),
+			"bankCode":      stringSchema("BSB (062-000), sort code (40-47-84), ABA routing number or Canadian transit-institution (12345-003); omitted for IBAN"),
+			"accountNumber": stringSchema("Omitted for IBAN"),
+			"iban":          stringSchema("Electronic format, IBAN scheme only"),
+		}, "scheme"),
+		"Product": object(map[string]*Schema{
+			"id":             stringSchema(""),
+			"category":       productCategorySchema(),
+			"name":           stringSchema(""),
+			"description":    stringSchema(""),
+			"brand":          stringSchema(""),
+			"effectiveFrom":  dateTimeSchema(),
+			"effectiveTo":    dateTimeSchema(),
+			"lastUpdated":    dateTimeSchema(),
+			"isTailored":     {Type: "boolean", Description: "Terms are negotiated per customer; published rates and fees are indicative"},
+			"applicationUri": {Type: "string", Format: "uri"},
+			"features":       arrayOf(ref("ProductFeature")),
+			"constraints":    arrayOf(ref("ProductConstraint")),
+			"eligibility":    arrayOf(ref("ProductEligibility")),
+			"fees":           arrayOf(ref("ProductFee")),
+			"depositRates":   arrayOf(ref("DepositRate")),
+			"lendingRates":   arrayOf(ref("LendingRate")),
+		}, "id", "category", "name", "description", "brand", "effectiveFrom", "lastUpdated", "isTailored"),
+		"ProductFeature": object(map[string]*Schema{
+			"featureType": enum("CARD_ACCESS", "DIGITAL_WALLET", "FREE_TXNS", "UNLIMITED_TXNS", "NPP_PAYID",
+				"INTEREST_FREE", "ADDITIONAL_CARDS", "BONUS_REWARDS", "OTHER"),
+			"additionalValue": stringSchema("Value for the feature type, e.g. the number of FREE_TXNS"),
+			"additionalInfo":  stringSchema(""),
+		}, "featureType"),
+		"ProductConstraint": object(map[string]*Schema{
+			"constraintType": enum("MIN_BALANCE", "MAX_BALANCE", "OPENING_BALANCE", "MIN_LIMIT", "MAX_LIMIT"),
+			"amount":         ref("Money"),
+			"additionalInfo": stringSchema(""),
+		}, "constraintType", "amount"),
+		"ProductEligibility": object(map[string]*Schema{
+			"eligibilityType": enum("BUSINESS", "MIN_AGE", "MAX_AGE", "MIN_INCOME", "NATURAL_PERSON",
+				"PENSION_RECIPIENT", "RESIDENCY_STATUS", "STAFF", "STUDENT", "OTHER"),
+			"additionalValue": stringSchema("Value for the eligibility type, e.g. the age for MIN_AGE"),
+			"additionalInfo":  stringSchema(""),
+		}, "eligibilityType"),
+		"ProductFee": object(map[string]*Schema{
+			"name": stringSchema(""),
+			"feeType": enum("PERIODIC", "TRANSACTION", "WITHDRAWAL", "DEPOSIT", "PAYMENT", "PURCHASE",
+				"EVENT", "UPFRONT", "VARIABLE", "EXIT"),
+			"amount":           ref("Money"),
+			"balanceRate":      rateSchema("Fee as a fraction of the balance"),
+			"transactionRate":  rateSchema("Fee as a fraction of the transaction amount"),
+			"accrualFrequency": durationSchema("How often a PERIODIC fee is charged"),
+			"additionalInfo":   stringSchema(""),
+		}, "name", "feeType"),
+		"DepositRate": object(map[string]*Schema{
+			"depositRateType":      enum("FIXED", "VARIABLE", "BONUS", "BUNDLE_BONUS", "INTRODUCTORY", "FLOATING", "MARKET_LINKED"),
+			"rate":                 rateSchema("Annual rate"),
+			"calculationFrequency": durationSchema("How often interest is calculated"),
+			"applicationFrequency": durationSchema("How often interest is paid"),
+			"tiers":                arrayOf(ref("RateTier")),
+			"additionalInfo":       stringSchema(""),
+		}, "depositRateType", "rate"),
+		"LendingRate": object(map[string]*Schema{
+			"lendingRateType":      enum("FIXED", "VARIABLE", "INTRODUCTORY", "DISCOUNT", "PENALTY", "CASH_ADVANCE", "PURCHASE"),
+			"rate":                 rateSchema("Annual rate"),
+			"comparisonRate":       rateSchema("Annual rate including fees"),
+			"calculationFrequency": durationSchema("How often interest is calculated"),
+			"applicationFrequency": durationSchema("How often interest is charged"),
+			"tiers":                arrayOf(ref("RateTier")),
+			"additionalInfo":       stringSchema(""),
+		}, "lendingRateType", "rate"),
+		"RateTier": object(map[string]*Schema{
+			"name":                  stringSchema(""),
+			"unitOfMeasure":         enum("DOLLAR", "PERCENT", "MONTH", "DAY"),
+			"minimumValue":          {Type: "string", Format: "decimal", Description: "Inclusive lower bound"},
+			"maximumValue":          {Type: "string", Format: "decimal", Description: "Exclusive upper bound (inclusive when equal to minimumValue), omitted for an open-ended tier"},
+			"rateApplicationMethod": enum("WHOLE_BALANCE", "PER_TIER"),
+		}, "name", "unitOfMeasure", "minimumValue"),
+		"Balance": object(map[string]*Schema{
+			"balanceType": enum("CURRENT", "AVAILABLE", "PENDING"),
+			"amount":      ref("Money"),
+			"timestamp":   dateTimeSchema(),
+		}, "balanceType", "amount", "timestamp"),
+		"Transaction": object(map[string]*Schema{
+			"id":                   stringSchema(""),
+			"reference":            stringSchema(""),
+			"transactionType":      enum("DEBIT", "CREDIT", "TRANSFER", "PAYMENT", "FEE"),
+			"amount":               ref("Money"),
+			"description":          stringSchema(""),
+			"merchantName":         stringSchema(""),
+			"category":             ref("TransactionCategory"),
+			"merchantCategoryCode": {Type: "string", Pattern: "^[0-9]{4}$", Description: "ISO 18245 merchant category code"},
+			"cleanMerchantName":    stringSchema("Merchant name with store numbers and processor prefixes removed"),
+			"postingDate":          dateTimeSchema(),
+			"valueDate":            dateTimeSchema(),
+			"runningBalance":       ref("Money"),
+			"accountId":            stringSchema(""),
+		}, "id", "transactionType", "amount", "description", "postingDate", "valueDate", "accountId"),
+		"Consent": object(map[string]*Schema{
+			"id":             stringSchema(""),
+			"status":         ref("ConsentStatus"),
+			"scopes":         arrayOf(stringSchema("")),
+			"accountIds":     arrayOf(stringSchema("")),
+			"grantDate":      dateTimeSchema(),
+			"expiryDate":     dateTimeSchema(),
+			"revocationDate": dateTimeSchema(),
+		}, "id", "status", "scopes", "grantDate", "expiryDate"),
+		"ConsentStatus": enum("ACTIVE", "EXPIRED", "REVOKED", "PENDING"),
+		"TransactionCategory": enum("SALARY", "INCOME", "INTEREST", "TRANSFER", "CASH", "FEES", "GROCERIES", "DINING",
+			"TRANSPORT", "FUEL", "SHOPPING", "UTILITIES", "HOUSING", "HEALTH", "ENTERTAINMENT", "TRAVEL", "OTHER"),
+		"InsightPeriod": enum("DAY", "WEEK", "MONTH"),
+		"AccountInsights": object(map[string]*Schema{
+			"accountId":      stringSchema(""),
+			"fromDate":       dateTimeSchema(),
+			"toDate":         dateTimeSchema(),
+			"period":         ref("InsightPeriod"),
+			"totals":         arrayOf(ref("CashFlow")),
+			"periodAverages": arrayOf(ref("PeriodAverage")),
+			"periods":        arrayOf(ref("PeriodInsight")),
+			"byType":         arrayOf(ref("InsightGroup")),
+			"byCategory":     arrayOf(ref("InsightGroup")),
+			"byMerchant":     arrayOf(ref("InsightGroup")),
+		}, "accountId", "fromDate", "toDate", "period", "totals", "periodAverages", "periods", "byType", "byCategory", "byMerchant"),
+		"CashFlow": object(map[string]*Schema{
+			"currency": currencySchema(),
+			"count":    intSchema(0, 0),
+			"inflow":   ref("Money"),
+			"outflow":  ref("Money"),
+			"net":      ref("Money"),
+			"average":  ref("Money"),
+		}, "currency", "count", "inflow", "outflow", "net", "average"),
+		"PeriodAverage": object(map[string]*Schema{
+			"currency": currencySchema(),
+			"inflow":   ref("Money"),
+			"outflow":  ref("Money"),
+			"net":      ref("Money"),
+		}, "currency", "inflow", "outflow", "net"),
+		"PeriodInsight": object(map[string]*Schema{
+			"startDate": dateTimeSchema(),
+			"endDate":   dateTimeSchema(),
+			"cashFlow":  arrayOf(ref("CashFlow")),
+		}, "startDate", "endDate", "cashFlow"),
+		"InsightGroup": object(map[string]*Schema{
+			"key":      stringSchema("Transaction type, category (UNCATEGORISED when unset) or merchant name"),
+			"cashFlow": arrayOf(ref("CashFlow")),
+		}, "key", "cashFlow"),
+		"ConsentStatusResponse": object(map[string]*Schema{
+			"status": ref("ConsentStatus"),
+		}, "status"),
+		"ExchangeRate": object(map[string]*Schema{
+			"baseCurrency":  currencySchema(),
+			"quoteCurrency": currencySchema(),
+			"rate":          {Type: "string", Format: "decimal", Description: "Units of quote currency per unit of base currency"},
+			"timestamp":     dateTimeSchema(),
+			"source":        stringSchema(""),
+		}, "baseCurrency", "quoteCurrency", "rate", "timestamp"),
+		"AccountBalanceSummary": object(map[string]*Schema{
+			"accountId":        stringSchema(""),
+			"balance":          ref("Money"),
+			"convertedBalance": ref("Money"),
+			"exchangeRate":     ref("ExchangeRate"),
+		}, "accountId", "balance", "convertedBalance"),
+		"CustomerBalanceSummary": object(map[string]*Schema{
+			"customerId":        stringSchema(""),
+			"reportingCurrency": currencySchema(),
+			"total":             ref("Money"),
+			"accounts":          arrayOf(ref("AccountBalanceSummary")),
+			"timestamp":         dateTimeSchema(),
+		}, "customerId", "reportingCurrency", "total", "accounts", "timestamp"),
+		"Card": object(map[string]*Schema{
+			"id":             stringSchema(""),
+			"accountId":      stringSchema(""),
+			"maskedPan":      stringSchema("Card number with all but the last four digits masked"),
+			"scheme":         enum("VISA", "MASTERCARD", "AMEX"),
+			"cardholderName": stringSchema(""),
+			"status":         enum("ACTIVE", "BLOCKED", "EXPIRED", "CANCELLED"),
+			"expiryMonth":    intSchema(1, 12),
+			"expiryYear":     intSchema(2000, 0),
+			"creditFacility": ref("CreditFacility"),
+		}, "id", "accountId", "maskedPan", "scheme", "cardholderName", "status", "expiryMonth", "expiryYear"),
+		"CreditFacility": object(map[string]*Schema{
+			"creditLimit":      ref("Money"),
+			"availableCredit":  ref("Money"),
+			"statementBalance": ref("Money"),
+			"minimumPayment":   ref("Money"),
+			"paymentDueDate":   dateTimeSchema(),
+			"statementCycle":   ref("StatementCycle"),
+		}, "creditLimit", "availableCredit", "statementBalance", "minimumPayment", "paymentDueDate", "statementCycle"),
+		"StatementCycle": object(map[string]*Schema{
+			"statementDay":      intSchema(1, 28),
+			"lastStatementDate": dateTimeSchema(),
+			"nextStatementDate": dateTimeSchema(),
+		}, "statementDay", "lastStatementDate", "nextStatementDate"),
+		"ScheduledPayment": object(map[string]*Schema{
+			"id":                stringSchema(""),
+			"accountId":         stringSchema(""),
+			"type":              enum("STANDING_ORDER", "DIRECT_DEBIT"),
+			"status":            enum("ACTIVE", "SUSPENDED", "CANCELLED", "COMPLETED"),
+			"counterpartyName":  stringSchema("Payee of a standing order, or creditor collecting a direct debit"),
+			"reference":         stringSchema(""),
+			"mandateReference":  stringSchema("Direct debit mandate, direct debits only"),
+			"amount":            ref("Money"),
+			"frequency":         enum("WEEKLY", "FORTNIGHTLY", "MONTHLY", "LAST_BUSINESS_DAY"),
+			"startDate":         dateTimeSchema(),
+			"endDate":           dateTimeSchema(),
+			"lastExecutionDate": dateTimeSchema(),
+			"nextExecutionDate": dateTimeSchema(),
+		}, "id", "accountId", "type", "status", "counterpartyName", "amount", "frequency", "startDate"),
+		"Payee": object(map[string]*Schema{
+			"id":            {Type: "string", ReadOnly: true},
+			"customerId":    {Type: "string", ReadOnly: true, Description: "Taken from the request path on creation"},
+			"creationDate":  {Type: "string", Format: "date-time", ReadOnly: true},
+			"nickname":      stringSchema(""),
+			"type":          enum("DOMESTIC", "INTERNATIONAL", "BILLER"),
+			"domestic":      ref("DomesticPayee"),
+			"international": ref("InternationalPayee"),
+			"biller":        ref("BillerPayee"),
+		}, "id", "customerId", "creationDate", "nickname", "type"),
+		"DomesticPayee": object(map[string]*Schema{
+			"accountName":   stringSchema(""),
+			"scheme":        enum("BSB", "SORT_CODE", "ABA"),
+			"bankCode":      stringSchema("BSB (062-000), sort code (40-47-84) or ABA routing number, per scheme"),
+			"accountNumber": stringSchema("5 to 9 digits for BSB, 8 for sort code, 4 to 17 for ABA"),
+		}, "accountName", "scheme", "bankCode", "accountNumber"),
+		"InternationalPayee": object(map[string]*Schema{
+			"beneficiaryName": stringSchema(""),
+			"country":         {Type: "string", Description: "ISO 3166-1 alpha-2 country of the beneficiary's bank", Pattern: `^[A-Z]{2}$`},
+			"iban":            stringSchema("Required unless accountNumber is set"),
+			"accountNumber":   stringSchema("For countries that do not use IBANs"),
+			"bic":             {Type: "string", Pattern: `^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`},
+		}, "beneficiaryName", "country", "bic"),
+		"BillerPayee": object(map[string]*Schema{
+			"billerCode": {Type: "string", Pattern: `^[0-9]{3,10}$`},
+			"billerName": stringSchema(""),
+			"crn":        {Type: "string", Description: "Customer Reference Number", Pattern: `^[0-9]{2,20}$`},
+		}, "billerCode", "billerName", "crn"),
+		"Health": object(map[string]*Schema{
+			"status":      stringSchema(""),
+			"service":     stringSchema(""),
+			"version":     stringSchema("Service release, set at build time"),
+			"commit":      stringSchema("Source revision, set at build time"),
+			"bianVersion": stringSchema("BIAN Service Landscape release the API follows"),
+			"apiVersions": arrayOf(stringSchema("")),
+		}, "status", "service", "version", "commit", "bianVersion", "apiVersions"),
+		"Envelope": object(map[string]*Schema{
+			"data":  {Description: "The resource or collection page"},
+			"meta":  ref("Meta"),
+			"links": ref("Links"),
+		}, "data", "links"),
+		"Meta": object(map[string]*Schema{
+			"count":      intSchema(0, 0),
+			"totalCount": {Type: "integer", Description: "Total number of items; omitted when more pages follow or the offset is past the end"},
+			"limit":      intSchema(0, domains.MaxHistoryLimit),
+			"offset":     intSchema(0, 0),
+			"hasMore":    {Type: "boolean"},
+		}, "count", "limit", "offset", "hasMore"),
+		"Links": object(map[string]*Schema{
+			"self": stringSchema(""),
+			"next": stringSchema("Next page, when one follows"),
+			"prev": stringSchema("Previous page, when not on the first"),
+			"related": {
+				Type:        "object",
+				Description: "Related resources by name, e.g. account",
+			},
+		}, "self"),
+		"ErrorResponse": object(map[string]*Schema{
+			"error": ref("ErrorDetail"),
+		}, "error"),
+		"ErrorDetail": object(map[string]*Schema{
+			"code": enum(
+				string(ErrorCodeNotFound),
+				string(ErrorCodeForbidden),
+				string(ErrorCodeInvalidInput),
+				string(ErrorCodeRateLimited),
+				string(ErrorCodeMethodNotAllowed),
+				string(ErrorCodeUnsupportedVersion),
+				string(ErrorCodeInternalError),
+			),
+			"message": stringSchema(""),
+			"details": stringSchema(""),
+			"fields":  arrayOf(ref("FieldError")),
+		}, "code", "message"),
+		"BIANResponse": object(map[string]*Schema{
+			"ServiceDomain":                      stringSchema("e.g. CurrentAccount"),
+			"ServiceOperation":                   enum("Retrieve"),
+			"ControlRecordInstanceReference":     stringSchema("The {cr-reference-id} path segment"),
+			"BehaviorQualifierType":              stringSchema("Set when a behaviour qualifier was addressed"),
+			"BehaviorQualifierInstanceReference": stringSchema("The {bq-reference-id} path segment"),
+			"ControlRecordType":                  stringSchema("e.g. CurrentAccountFacility"),
+		}, "ServiceDomain", "ServiceOperation", "ControlRecordInstanceReference", "ControlRecordType"),
+		"BIANAmount": object(map[string]*Schema{
+			"AmountValue":    {Type: "string", Format: "decimal", Pattern: `^-?\d+(\.\d+)?$`},
+			"AmountCurrency": currencySchema(),
+		}, "AmountValue", "AmountCurrency"),
+		"CurrentAccountFacility": object(map[string]*Schema{
+			"ProductInstanceReference": stringSchema("Account ID"),
+			"AccountIdentification": object(map[string]*Schema{
+				"AccountIdentificationType": enum("BBAN", "IBAN", "UPIC", "BSB"),
+				"AccountIdentification":     stringSchema("IBAN, BSB and account number (062-000 123456789) or BBAN"),
+			}),
+			"AccountType":       enum("Checking", "Savings", "CreditCard", "Investment"),
+			"ProductName":       stringSchema(""),
+			"AccountStatus":     enum("Open", "Closed", "Suspended"),
+			"AccountCurrency":   currencySchema(),
+			"AccountDateOpened": dateTimeSchema(),
+			"AccountDateClosed": dateTimeSchema(),
+			"CustomerReference": stringSchema(""),
+			"PositionLimitSettings": object(map[string]*Schema{
+				"PositionLimitType":  stringSchema(""),
+				"PositionLimitValue": ref("BIANAmount"),
+			}),
+		}, "ProductInstanceReference", "AccountType", "AccountCurrency"),
+		"Payments": object(map[string]*Schema{
+			"PaymentTransactionReference":   stringSchema("Transaction ID"),
+			"PaymentTransactionType":        enum("Debit", "Credit", "Transfer", "Payment", "Fee"),
+			"PaymentTransactionAmount":      ref("BIANAmount"),
+			"PaymentTransactionDescription": stringSchema(""),
+			"PaymentTransactionDate":        dateTimeSchema(),
+			"PaymentTransactionValueDate":   dateTimeSchema(),
+			"PayeeReference":                stringSchema(""),
+			"PayeeBankReference":            stringSchema(""),
+		}, "PaymentTransactionAmount"),
+		"PaymentExecutionProcedure": object(map[string]*Schema{
+			"PayerProductInstanceReference": stringSchema("Account ID of an outgoing payment"),
+			"PayeeProductInstanceReference": stringSchema("Account ID of an incoming payment"),
+			"PayerReference":                stringSchema(""),
+			"PayeeReference":                stringSchema(""),
+			"PaymentExecutionStatus":        enum("Initiated", "Executed", "Rejected", "Returned"),
+			"PaymentTransaction": object(map[string]*Schema{
+				"PaymentTransactionReference":   stringSchema("Transaction ID"),
+				"PaymentTransactionType":        enum("Debit", "Credit", "Transfer", "Payment", "Fee"),
+				"PaymentTransactionAmount":      ref("BIANAmount"),
+				"PaymentTransactionDescription": stringSchema(""),
+				"PaymentTransactionDate":        dateTimeSchema(),
+				"PaymentTransactionValueDate":   dateTimeSchema(),
+			}, "PaymentTransactionAmount"),
+		}, "PaymentTransaction"),
+		"FieldError": object(map[string]*Schema{
+			"field":   stringSchema("Dotted path of the invalid field, e.g. amount.currency"),
+			"message": stringSchema(""),
+		}, "field", "message"),
+	}
+}
+
+// openAPIErrorResponses returns the shared error responses keyed by status code
+func openAPIErrorResponses() map[string]*Response {
+	return map[string]*Response{
+		"400": errorResponse("INVALID_INPUT: the request is malformed; fields lists each invalid parameter"),
+		"403": errorResponse("FORBIDDEN: access to the resource is not permitted"),
+		"404": errorResponse("NOT_FOUND: the resource does not exist"),
+		"406": errorResponse("UNSUPPORTED_VERSION: the requested API version is not served at this path"),
+		"405": errorResponse("METHOD_NOT_ALLOWED: the HTTP method is not supported on this path; the Allow header lists supported methods"),
+		"429": errorResponse("RATE_LIMITED: too many requests, retry later"),
+		"500": errorResponse("INTERNAL_ERROR: an unexpected server or provider failure"),
+	}
+}
+
+func ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func object(properties map[string]*Schema, required ...string) *Schema {
+	return &Schema{Type: "object", Properties: properties, Required: required}
+}
+
+func arrayOf(items *Schema) *Schema {
+	return &Schema{Type: "array", Items: items}
+}
+
+func enum(values ...string) *Schema {
+	return &Schema{Type: "string", Enum: values}
+}
+
+func productCategorySchema() *Schema {
+	return enum("TRANS_AND_SAVINGS_ACCOUNTS", "TERM_DEPOSITS", "TRAVEL_CARDS", "CRED_AND_CHRG_CARDS",
+		"PERS_LOANS", "RESIDENTIAL_MORTGAGES", "OVERDRAFTS", "BUSINESS_LOANS")
+}
+
+func rateSchema(description string) *Schema {
+	return &Schema{
+		Type:        "string",
+		Format:      "decimal",
+		Description: description + ", as a decimal fraction (0.0125 is 1.25%)",
+		Pattern:     `^\d+(\.\d+)?$`,
+	}
+}
+
+func durationSchema(description string) *Schema {
+	return &Schema{Type: "string", Format: "duration", Description: description + " (ISO 8601 duration, e.g. P1M)"}
+}
+
+func stringSchema(description string) *Schema {
+	return &Schema{Type: "string", Description: description}
+}
+
+func currencySchema() *Schema {
+	return &Schema{Type: "string", Description: "ISO 4217 currency code", Pattern: "^[A-Z]{3}$", Examples: []string{"AUD"}}
+}
+
+func dateTimeSchema() *Schema {
+	return &Schema{Type: "string", Format: "date-time"}
+}
+
+func dateSchema() *Schema {
+	return &Schema{Type: "string", Format: "date", Examples: []string{"2024-01-31"}}
+}
+
+// intSchema returns an integer schema; a zero max means unbounded
+func intSchema(min, max int) *Schema {
+	s := &Schema{Type: "integer", Minimum: &min}
+	if max > 0 {
+		s.Maximum = &max
+	}
+	return s
+}
+
+func pathParam(name, description string) *Parameter {
+	return &Parameter{Name: name, In: "path", Description: description, Required: true, Schema: stringSchema("")}
+}
+
+func queryParam(name, description string, schema *Schema) *Parameter {
+	return &Parameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+func jsonResponse(description string, schema *Schema) *Response {
+	return &Response{
+		Description: description,
+		Content:     map[string]*MediaType{"application/json": {Schema: schema}},
+	}
+}
+
+// resourceResponse documents a resource body in both the bare and enveloped formats
+func resourceResponse(description string, schema *Schema) *Response {
+	response := jsonResponse(description, schema)
+	response.Content[EnvelopeMediaType] = &MediaType{
+		Schema: &Schema{
+			AllOf: []*Schema{
+				ref("Envelope"),
+				object(map[string]*Schema{"data": schema}, "data"),
+			},
+		},
+	}
+	return response
+}
+
+func errorResponse(description string) *Response {
+	return jsonResponse(description, ref("ErrorResponse"))
+}
+
+// withErrors adds references to the shared error responses for the given status codes
+func withErrors(responses map[string]*Response, statuses ...string) map[string]*Response {
+	for _, status := range statuses {
+		responses[status] = &Response{Ref: "#/components/responses/" + status}
+	}
+	return responses
+}
+
+// serveOpenAPI handles GET /openapi.json
+func (s *Server) serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.OpenAPISpec())
+}
+
+// docsPage renders the OpenAPI document with Redoc
+const docsPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>bian-go API Reference</title>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+</head>
+<body>
+  <redoc spec-url="/openapi.json"></redoc>
+  <script src="https://cdn.redoc.ly/redoc/latest/bundles/redoc.standalone.js"></script>
+</body>
+</html>
+`
+
+// serveDocs handles GET /docs
+func (s *Server) serveDocs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	io.WriteString(w, docsPage)
+}
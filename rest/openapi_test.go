@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/providers/fx"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+// undocumentedRoutes serve the documentation itself
+var undocumentedRoutes = map[string]bool{
+	"GET /openapi.json": true,
+	"GET /docs":         true,
+}
+
+func newTestServer() *Server {
+	provider := mock.NewProvider()
+	return NewServer(provider, provider, provider, provider,
+		WithCustomerService(provider),
+		WithFXService(fx.NewDefaultStaticProvider()),
+		WithCardService(provider),
+		WithStandingOrderService(provider),
+		WithDirectDebitService(provider),
+		WithPayeeService(provider),
+		WithProductService(provider),
+	)
+}
+
+// wildcards matches path parameters, whose names differ between ServeMux
+// patterns ({crReferenceID}) and OpenAPI paths ({cr-reference-id})
+var wildcards = regexp.MustCompile(`\{[^}]+\}`)
+
+func TestOpenAPISpec_CoversEveryRoute(t *testing.T) {
+	server := newTestServer()
+	spec := server.OpenAPISpec()
+
+	paths := make(map[string]*PathItem, len(spec.Paths))
+	for path, item := range spec.Paths {
+		paths[wildcards.ReplaceAllString(path, "{}")] = item
+	}
+
+	declared := make(map[string]bool)
+	for _, route := range server.Routes() {
+		name := route.Method + " " + route.Pattern
+		declared[route.Method+" "+wildcards.ReplaceAllString(route.Pattern, "{}")] = true
+		if undocumentedRoutes[name] {
+			continue
+		}
+		item, ok := paths[wildcards.ReplaceAllString(route.Pattern, "{}")]
+		if !ok {
+			t.Errorf("route %s has no OpenAPI path entry", name)
+			continue
+		}
+		if _, ok := (*item)[strings.ToLower(route.Method)]; !ok {
+			t.Errorf("route %s has no OpenAPI operation", name)
+		}
+	}
+
+	for path, item := range spec.Paths {
+		for method := range *item {
+			route := strings.ToUpper(method) + " " + wildcards.ReplaceAllString(path, "{}")
+			if !declared[route] {
+				t.Errorf("OpenAPI operation %s %s has no route", strings.ToUpper(method), path)
+			}
+		}
+	}
+}
+
+func TestOpenAPISpec_ReferencesResolve(t *testing.T) {
+	spec := newTestServer().OpenAPISpec()
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("failed to marshal spec: %v", err)
+	}
+
+	refs := regexp.MustCompile(`"\$ref":"#/components/(schemas|responses)/(\w+)"`).FindAllStringSubmatch(string(data), -1)
+	for _, ref := range refs {
+		switch ref[1] {
+		case "schemas":
+			if spec.Components.Schemas[ref[2]] == nil {
+				t.Errorf("unresolved schema reference %s", ref[2])
+			}
+		case "responses":
+			if spec.Components.Responses[ref[2]] == nil {
+				t.Errorf("unresolved response reference %s", ref[2])
+			}
+		}
+	}
+}
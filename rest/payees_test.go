@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/models"
+)
+
+func TestPayees_Lifecycle(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do("POST", "/v1/customers/cust-001/payees", `{
+		"nickname": "Sister",
+		"type": "INTERNATIONAL",
+		"international": {"beneficiaryName": "S Citizen", "country": "DE", "iban": "DE89 3704 0044 0532 0130 00", "bic": "COBADEFFXXX"}
+	}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201 (body %s)", rec.Code, rec.Body.String())
+	}
+	var created models.Payee
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == "" || created.CustomerID != "cust-001" || created.CreationDate.IsZero() {
+		t.Errorf("created payee = %+v, want ID, customer and creation date assigned", created)
+	}
+	location := rec.Header().Get("Location")
+	if location != "/v1/payees/"+created.ID {
+		t.Errorf("Location = %q", location)
+	}
+
+	if rec := do("GET", location, ""); rec.Code != http.StatusOK {
+		t.Errorf("get created status = %d, want 200", rec.Code)
+	}
+	if rec := do("DELETE", location, ""); rec.Code != http.StatusNoContent {
+		t.Errorf("delete status = %d, want 204", rec.Code)
+	}
+	if rec := do("GET", location, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("get deleted status = %d, want 404", rec.Code)
+	}
+	if rec := do("DELETE", location, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("delete again status = %d, want 404", rec.Code)
+	}
+}
+
+func TestPayees_CreateRejectsInvalidDetails(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	tests := []struct {
+		name       string
+		path       string
+		body       string
+		wantStatus int
+		wantField  string
+	}{
+		{
+			name:       "IBAN check digits",
+			path:       "/v1/customers/cust-001/payees",
+			body:       `{"nickname": "Sister", "type": "INTERNATIONAL", "international": {"beneficiaryName": "S Citizen", "country": "DE", "iban": "DE88370400440532013000", "bic": "COBADEFFXXX"}}`,
+			wantStatus: http.StatusBadRequest,
+			wantField:  "international.iban",
+		},
+		{
+			name:       "BSB format",
+			path:       "/v1/customers/cust-001/payees",
+			body:       `{"nickname": "Rent", "type": "DOMESTIC", "domestic": {"accountName": "J Citizen", "scheme": "BSB", "bankCode": "06200", "accountNumber": "12345678"}}`,
+			wantStatus: http.StatusBadRequest,
+			wantField:  "domestic.bankCode",
+		},
+		{
+			name:       "unknown field",
+			path:       "/v1/customers/cust-001/payees",
+			body:       `{"nickname": "Rent", "bsb": "062000"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown customer",
+			path:       "/v1/customers/cust-999/payees",
+			body:       `{"nickname": "Power", "type": "BILLER", "biller": {"billerCode": "23796", "billerName": "Energy Australia", "crn": "4001234567"}}`,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantField == "" {
+				return
+			}
+			var response ErrorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range response.Error.Fields {
+				if f.Field == tt.wantField {
+					return
+				}
+			}
+			t.Errorf("fields = %v, want an error for %s", response.Error.Fields, tt.wantField)
+		})
+	}
+}
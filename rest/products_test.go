@@ -0,0 +1,81 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+func TestProducts_Filter(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "current by default", query: "", want: []string{"prod-001", "prod-002", "prod-003"}},
+		{name: "category", query: "?category=CRED_AND_CHRG_CARDS", want: []string{"prod-003"}},
+		{name: "future", query: "?effective=FUTURE", want: []string{"prod-004"}},
+		{name: "all in category", query: "?effective=ALL&category=TERM_DEPOSITS", want: []string{"prod-004"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", "/v1/products"+tt.query, nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200 (body %s)", rec.Code, rec.Body.String())
+			}
+
+			var products []models.Product
+			if err := json.Unmarshal(rec.Body.Bytes(), &products); err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[string]bool, len(products))
+			for _, product := range products {
+				got[product.ID] = true
+			}
+			if len(products) != len(tt.want) {
+				t.Errorf("got %d products %v, want %v", len(products), got, tt.want)
+			}
+			for _, id := range tt.want {
+				if !got[id] {
+					t.Errorf("missing %s in %v", id, got)
+				}
+			}
+		})
+	}
+}
+
+// failingProductService fails every catalogue lookup
+type failingProductService struct {
+	domains.ProductService
+}
+
+func (failingProductService) RetrieveProducts(ctx context.Context, filter domains.ProductFilter) ([]*models.Product, error) {
+	return nil, errors.New("catalogue unavailable")
+}
+
+func TestProducts_ListFailure(t *testing.T) {
+	provider := mock.NewProvider()
+	handler := NewServer(provider, provider, provider, provider,
+		WithProductService(failingProductService{provider}),
+	).Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/v1/products", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want 500 (body %s)", rec.Code, rec.Body.String())
+	}
+	if detail := decodeError(t, rec); detail.Code != ErrorCodeInternalError {
+		t.Errorf("code = %s, want INTERNAL_ERROR", detail.Code)
+	}
+}
@@ -0,0 +1,195 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Route binds an HTTP method and a ServeMux path pattern (e.g.
+// "/accounts/{id}/balances") to a handler. An empty Method accepts every
+// method, for handlers such as GraphQL that negotiate their own.
+type Route struct {
+	Method  string
+	Pattern string
+	Handler http.Handler
+}
+
+// Routes returns the REST route table: resource routes under every API
+// version prefix, their deprecated unprefixed aliases, the BIAN semantic
+// routes and the unversioned service routes. Customer endpoints are only
+// included when CustomerService and FXService are configured; card, standing
+// order, direct debit, payee and product endpoints when their services are.
+func (s *Server) Routes() []Route {
+	routes := []Route{
+		{Method: "GET", Pattern: "/health", Handler: http.HandlerFunc(s.healthCheck)},
+		{Method: "GET", Pattern: "/openapi.json", Handler: http.HandlerFunc(s.serveOpenAPI)},
+		{Method: "GET", Pattern: "/docs", Handler: http.HandlerFunc(s.serveDocs)},
+	}
+
+	resources := s.resourceRoutes()
+	for _, version := range s.handlers.apiVersions {
+		for _, route := range resources {
+			routes = append(routes, Route{
+				Method:  route.Method,
+				Pattern: version.Prefix() + route.Pattern,
+				Handler: s.versioned(version, route.Handler),
+			})
+		}
+	}
+
+	// Unprefixed paths predate versioning and remain as deprecated aliases
+	for _, route := range resources {
+		routes = append(routes, Route{
+			Method:  route.Method,
+			Pattern: route.Pattern,
+			Handler: s.unversioned(route.Handler),
+		})
+	}
+
+	// BIAN semantic routes follow the BIAN release, not the API version
+	routes = append(routes, s.bianRoutes()...)
+
+	// Request body checks apply to REST routes only, not to routes such as
+	// GraphQL that share the router
+	for i := range routes {
+		routes[i].Handler = ContentTypeMiddleware(routes[i].Handler)
+	}
+
+	return routes
+}
+
+// resourceRoutes returns the versioned resource routes without a version prefix
+func (s *Server) resourceRoutes() []Route {
+	routes := []Route{
+		// Account endpoints
+		{Method: "GET", Pattern: "/accounts/{id}", Handler: http.HandlerFunc(s.handlers.GetAccount)},
+		{Method: "GET", Pattern: "/accounts/{id}/balance", Handler: http.HandlerFunc(s.handlers.GetAccountBalance)},
+		{Method: "GET", Pattern: "/accounts/{id}/balances", Handler: http.HandlerFunc(s.handlers.GetBalances)},
+		{Method: "GET", Pattern: "/accounts/{id}/transactions", Handler: http.HandlerFunc(s.handlers.GetAccountTransactions)},
+		{Method: "GET", Pattern: "/accounts/{id}/insights", Handler: http.HandlerFunc(s.handlers.GetAccountInsights)},
+
+		// Transaction endpoints
+		{Method: "GET", Pattern: "/transactions/{id}", Handler: http.HandlerFunc(s.handlers.GetTransaction)},
+
+		// Consent endpoints
+		{Method: "GET", Pattern: "/consents/{id}", Handler: http.HandlerFunc(s.handlers.GetConsent)},
+		{Method: "GET", Pattern: "/consents/{id}/status", Handler: http.HandlerFunc(s.handlers.GetConsentStatus)},
+	}
+
+	// Customer endpoints (require CustomerService and FXService)
+	if s.handlers.balanceAggregator != nil {
+		routes = append(routes, Route{Method: "GET", Pattern: "/customers/{id}/balances", Handler: http.HandlerFunc(s.handlers.GetCustomerBalances)})
+	}
+
+	// Card endpoints (require CardService)
+	if s.handlers.cardService != nil {
+		routes = append(routes,
+			Route{Method: "GET", Pattern: "/cards/{id}", Handler: http.HandlerFunc(s.handlers.GetCard)},
+			Route{Method: "GET", Pattern: "/accounts/{id}/card", Handler: http.HandlerFunc(s.handlers.GetAccountCard)},
+		)
+	}
+
+	// Standing order endpoints (require StandingOrderService)
+	if s.handlers.standingOrderService != nil {
+		routes = append(routes,
+			Route{Method: "GET", Pattern: "/standing-orders/{id}", Handler: http.HandlerFunc(s.handlers.GetStandingOrder)},
+			Route{Method: "GET", Pattern: "/accounts/{id}/standing-orders", Handler: http.HandlerFunc(s.handlers.GetAccountStandingOrders)},
+		)
+	}
+
+	// Direct debit endpoints (require DirectDebitService)
+	if s.handlers.directDebitService != nil {
+		routes = append(routes,
+			Route{Method: "GET", Pattern: "/direct-debits/{id}", Handler: http.HandlerFunc(s.handlers.GetDirectDebit)},
+			Route{Method: "GET", Pattern: "/accounts/{id}/direct-debits", Handler: http.HandlerFunc(s.handlers.GetAccountDirectDebits)},
+		)
+	}
+
+	// Payee endpoints (require PayeeService)
+	if s.handlers.payeeService != nil {
+		routes = append(routes,
+			Route{Method: "GET", Pattern: "/customers/{id}/payees", Handler: http.HandlerFunc(s.handlers.GetPayees)},
+			Route{Method: "POST", Pattern: "/customers/{id}/payees", Handler: http.HandlerFunc(s.handlers.CreatePayee)},
+			Route{Method: "GET", Pattern: "/payees/{id}", Handler: http.HandlerFunc(s.handlers.GetPayee)},
+			Route{Method: "DELETE", Pattern: "/payees/{id}", Handler: http.HandlerFunc(s.handlers.DeletePayee)},
+		)
+	}
+
+	// Product catalogue endpoints (require ProductService)
+	if s.handlers.productService != nil {
+		routes = append(routes,
+			Route{Method: "GET", Pattern: "/products", Handler: http.HandlerFunc(s.handlers.GetProducts)},
+			Route{Method: "GET", Pattern: "/products/{id}", Handler: http.HandlerFunc(s.handlers.GetProduct)},
+		)
+	}
+
+	return routes
+}
+
+// NewRouter registers routes on a ServeMux as "METHOD /pattern" entries.
+// Requests to a registered path with an unsupported method receive 405 with
+// an Allow header, and unknown paths 404, both in the standard error format.
+func NewRouter(routes []Route) *http.ServeMux {
+	mux := http.NewServeMux()
+
+	var patterns []string
+	methods := make(map[string][]string)
+	catchAll := false
+
+	for _, route := range routes {
+		if route.Method == "" {
+			mux.Handle(route.Pattern, route.Handler)
+			catchAll = catchAll || route.Pattern == "/"
+			continue
+		}
+
+		mux.Handle(route.Method+" "+route.Pattern, route.Handler)
+		if _, seen := methods[route.Pattern]; !seen {
+			patterns = append(patterns, route.Pattern)
+		}
+		methods[route.Pattern] = append(methods[route.Pattern], route.Method)
+	}
+
+	// Method-less patterns are less specific than "METHOD /pattern", so they
+	// only see requests whose method has no route
+	for _, pattern := range patterns {
+		mux.Handle(pattern, methodNotAllowed(allowHeader(methods[pattern])))
+	}
+
+	if !catchAll {
+		mux.HandleFunc("/", routeNotFound)
+	}
+
+	return mux
+}
+
+// allowHeader lists methods for an Allow header; GET routes also serve HEAD
+func allowHeader(methods []string) string {
+	allow := append([]string(nil), methods...)
+	for _, method := range methods {
+		if method == "GET" {
+			allow = append(allow, "HEAD")
+			break
+		}
+	}
+	return strings.Join(allow, ", ")
+}
+
+// methodNotAllowed responds 405 with the methods the path supports
+func methodNotAllowed(allow string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", allow)
+		WriteErrorResponse(w, ErrorCodeMethodNotAllowed,
+			"Method not allowed",
+			r.Method+" is not supported on "+r.URL.Path+"; use "+allow,
+			http.StatusMethodNotAllowed)
+	})
+}
+
+// routeNotFound responds 404 for paths with no route
+func routeNotFound(w http.ResponseWriter, r *http.Request) {
+	WriteErrorResponse(w, ErrorCodeNotFound,
+		"Not found",
+		"No route matches "+r.URL.Path,
+		http.StatusNotFound)
+}
@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRouter(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		wantAllow  string
+	}{
+		{name: "account", method: "GET", path: "/accounts/acc-001", wantStatus: http.StatusOK},
+		{name: "balances", method: "GET", path: "/accounts/acc-001/balances", wantStatus: http.StatusOK},
+		{name: "head", method: "HEAD", path: "/accounts/acc-001", wantStatus: http.StatusOK},
+		{name: "card", method: "GET", path: "/v1/cards/card-001", wantStatus: http.StatusOK},
+		{name: "account card", method: "GET", path: "/v1/accounts/acc-003/card", wantStatus: http.StatusOK},
+		{name: "account without card", method: "GET", path: "/v1/accounts/acc-001/card", wantStatus: http.StatusNotFound},
+		{name: "standing orders", method: "GET", path: "/v1/accounts/acc-001/standing-orders", wantStatus: http.StatusOK},
+		{name: "direct debit", method: "GET", path: "/v1/direct-debits/dd-001", wantStatus: http.StatusOK},
+		{name: "standing order by direct debit id", method: "GET", path: "/v1/standing-orders/dd-001", wantStatus: http.StatusNotFound},
+		{name: "product", method: "GET", path: "/v1/products/prod-001", wantStatus: http.StatusOK},
+		{name: "unknown product", method: "GET", path: "/v1/products/prod-999", wantStatus: http.StatusNotFound},
+		{name: "unknown product category", method: "GET", path: "/v1/products?category=CRYPTO", wantStatus: http.StatusBadRequest},
+		{name: "unknown account", method: "GET", path: "/accounts/acc-999", wantStatus: http.StatusNotFound},
+		{name: "extra segment", method: "GET", path: "/accounts/acc-001/balance/extra", wantStatus: http.StatusNotFound},
+		{name: "missing id", method: "GET", path: "/accounts/", wantStatus: http.StatusNotFound},
+		{name: "unknown path", method: "GET", path: "/nope", wantStatus: http.StatusNotFound},
+		{name: "wrong method", method: "POST", path: "/accounts/acc-001/balance", wantStatus: http.StatusMethodNotAllowed, wantAllow: "GET, HEAD"},
+		{name: "wrong method on health", method: "DELETE", path: "/health", wantStatus: http.StatusMethodNotAllowed, wantAllow: "GET, HEAD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if allow := rec.Header().Get("Allow"); allow != tt.wantAllow {
+				t.Errorf("Allow = %q, want %q", allow, tt.wantAllow)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/domains"
+	"github.com/serverlesscloud/bian-go/models"
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+// unpostedProvider returns transactions without a posting date
+type unpostedProvider struct {
+	*mock.Provider
+}
+
+func (p unpostedProvider) RetrievePaymentTransaction(ctx context.Context, transactionID string) (*models.Transaction, error) {
+	tx, err := p.Provider.RetrievePaymentTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	unposted := *tx
+	unposted.PostingDate = time.Time{}
+	return &unposted, nil
+}
+
+func decodeError(t *testing.T, rec *httptest.ResponseRecorder) ErrorDetail {
+	t.Helper()
+	var response ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+		t.Fatalf("decode error response: %v (body %s)", err, rec.Body.String())
+	}
+	return response.Error
+}
+
+func TestErrorResponse_Fields(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/v1/accounts/acc-001/transactions?limit=-1&fromDate=01/02/2026", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400 (body %s)", rec.Code, rec.Body.String())
+	}
+
+	detail := decodeError(t, rec)
+	if detail.Code != ErrorCodeInvalidInput {
+		t.Errorf("code = %s, want %s", detail.Code, ErrorCodeInvalidInput)
+	}
+	// Every invalid parameter is reported at once, each with its own message
+	fields := make([]string, len(detail.Fields))
+	for i, f := range detail.Fields {
+		fields[i] = f.Field
+		if f.Message == "" {
+			t.Errorf("field %s has no message", f.Field)
+		}
+	}
+	sort.Strings(fields)
+	if len(fields) != 2 || fields[0] != "fromDate" || fields[1] != "limit" {
+		t.Errorf("fields = %v, want [fromDate limit]", fields)
+	}
+}
+
+func TestErrorResponse_InvalidProviderOutput(t *testing.T) {
+	provider := mock.NewProvider()
+	transactions := domains.NewValidatingTransactionService(unpostedProvider{provider})
+	handler := NewServer(provider, transactions, provider, provider).Handler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/v1/transactions/tx-001", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want 500 (body %s)", rec.Code, rec.Body.String())
+	}
+
+	// The provider broke its contract, so the caller gets no field details to fix
+	detail := decodeError(t, rec)
+	if detail.Code != ErrorCodeInternalError || len(detail.Fields) != 0 {
+		t.Errorf("error = %+v, want %s without fields", detail, ErrorCodeInternalError)
+	}
+}
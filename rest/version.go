@@ -0,0 +1,234 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// APIVersion is a major version of the REST API. Each version is served under
+// its own path prefix (/v1/...) and vendor media type
+// (application/vnd.bian-go.v1+json).
+type APIVersion struct {
+	// Name is the path segment, e.g. "v1"
+	Name string
+
+	// Deprecation is when the version was deprecated; zero while supported
+	Deprecation time.Time
+
+	// Sunset is when the version will be removed; zero if not scheduled
+	Sunset time.Time
+}
+
+// Prefix returns the version's path prefix, e.g. "/v1"
+func (v APIVersion) Prefix() string {
+	return "/" + v.Name
+}
+
+// MediaType returns the version's vendor media type
+func (v APIVersion) MediaType() string {
+	return "application/vnd.bian-go." + v.Name + "+json"
+}
+
+// Deprecated reports whether the version has been deprecated
+func (v APIVersion) Deprecated() bool {
+	return !v.Deprecation.IsZero()
+}
+
+// APIVersionHeader selects a version on unprefixed paths and reports the
+// version that served every versioned response
+const APIVersionHeader = "API-Version"
+
+// DefaultAPIVersions returns the versions served unless WithAPIVersions is used
+func DefaultAPIVersions() []APIVersion {
+	return []APIVersion{{Name: "v1"}}
+}
+
+// DefaultUnversionedDeprecation is the release date of 1.0, which superseded
+// the unprefixed paths (/accounts/...) with /v1. It is announced in Deprecation
+// headers unless WithUnversionedDeprecation sets a deployment's own date.
+var DefaultUnversionedDeprecation = time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+// WithAPIVersions sets the served API versions, oldest first. The newest
+// version that is not deprecated is the current version.
+func WithAPIVersions(versions ...APIVersion) Option {
+	return func(h *Handlers) {
+		h.apiVersions = versions
+	}
+}
+
+// WithUnversionedDeprecation sets when the unprefixed paths were deprecated,
+// for deployments that adopted /v1 after DefaultUnversionedDeprecation
+func WithUnversionedDeprecation(deprecation time.Time) Option {
+	return func(h *Handlers) {
+		h.unversionedDeprecation = deprecation
+	}
+}
+
+// WithUnversionedSunset announces when the deprecated unprefixed paths will be removed
+func WithUnversionedSunset(sunset time.Time) Option {
+	return func(h *Handlers) {
+		h.unversionedSunset = sunset
+	}
+}
+
+// currentVersion returns the newest supported version
+func (h *Handlers) currentVersion() APIVersion {
+	for i := len(h.apiVersions) - 1; i >= 0; i-- {
+		if !h.apiVersions[i].Deprecated() {
+			return h.apiVersions[i]
+		}
+	}
+	return h.apiVersions[len(h.apiVersions)-1]
+}
+
+// findVersion looks up a served version by name
+func (h *Handlers) findVersion(name string) (APIVersion, bool) {
+	for _, v := range h.apiVersions {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return APIVersion{}, false
+}
+
+// vendorMediaType matches versioned media types in an Accept header
+var vendorMediaType = regexp.MustCompile(`^application/vnd\.bian-go\.(v\d+)\+json$`)
+
+// requestedVersion returns the version a request negotiates through the
+// API-Version header or a vendor media type in Accept, and whether that media
+// type was used. An empty name means the client did not ask for a version.
+func requestedVersion(r *http.Request) (name string, viaAccept bool) {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, _, _ := strings.Cut(mediaRange, ";")
+			if m := vendorMediaType.FindStringSubmatch(strings.TrimSpace(mediaType)); m != nil {
+				return m[1], true
+			}
+		}
+	}
+	return strings.TrimSpace(r.Header.Get(APIVersionHeader)), false
+}
+
+type pathPrefixKey struct{}
+
+// pathPrefix returns the version prefix of the path the request arrived on,
+// so links in responses stay within the same version
+func pathPrefix(r *http.Request) string {
+	prefix, _ := r.Context().Value(pathPrefixKey{}).(string)
+	return prefix
+}
+
+// versioned serves a route under a version prefix. Requests negotiating a
+// different version are rejected with 406.
+func (s *Server) versioned(v APIVersion, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, viaAccept := requestedVersion(r)
+		if name != "" && name != v.Name {
+			writeUnsupportedVersion(w, name, r.URL.Path+" is served by "+v.Name)
+			return
+		}
+
+		var successor string
+		if v.Deprecated() {
+			successor = s.handlers.currentVersion().Prefix() + strings.TrimPrefix(r.URL.Path, v.Prefix())
+		}
+		writeVersionHeaders(w, v, v.Deprecation, v.Sunset, successor)
+		ctx := context.WithValue(r.Context(), pathPrefixKey{}, v.Prefix())
+		next.ServeHTTP(negotiatedWriter(w, v, viaAccept), r.WithContext(ctx))
+	})
+}
+
+// unversioned serves a route on its deprecated unprefixed path, using the
+// version the request negotiates or the current version
+func (s *Server) unversioned(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := s.handlers.currentVersion()
+		name, viaAccept := requestedVersion(r)
+		if name != "" {
+			var ok bool
+			if v, ok = s.handlers.findVersion(name); !ok {
+				writeUnsupportedVersion(w, name, "supported versions are "+s.handlers.versionNames())
+				return
+			}
+		}
+
+		w.Header().Add("Vary", "Accept, "+APIVersionHeader)
+		writeVersionHeaders(w, v, s.handlers.unversionedDeprecation, s.handlers.unversionedSunset, v.Prefix()+r.URL.Path)
+		next.ServeHTTP(negotiatedWriter(w, v, viaAccept), r)
+	})
+}
+
+// versionNames lists the served versions for error messages
+func (h *Handlers) versionNames() string {
+	names := make([]string, len(h.apiVersions))
+	for i, v := range h.apiVersions {
+		names[i] = v.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+// writeVersionHeaders reports the serving version and, for deprecated
+// routes, the Deprecation (RFC 9745), Sunset (RFC 8594) and successor Link headers
+func writeVersionHeaders(w http.ResponseWriter, v APIVersion, deprecation, sunset time.Time, successor string) {
+	w.Header().Set(APIVersionHeader, v.Name)
+	if deprecation.IsZero() {
+		return
+	}
+	w.Header().Set("Deprecation", "@"+strconv.FormatInt(deprecation.Unix(), 10))
+	if !sunset.IsZero() {
+		w.Header().Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+	}
+	if successor != "" {
+		w.Header().Add("Link", "<"+successor+`>; rel="successor-version"`)
+	}
+}
+
+// writeUnsupportedVersion writes a 406 for a version the server does not serve
+func writeUnsupportedVersion(w http.ResponseWriter, name, details string) {
+	WriteErrorResponse(w, ErrorCodeUnsupportedVersion,
+		"Unsupported API version "+name,
+		details,
+		http.StatusNotAcceptable)
+}
+
+// negotiatedWriter labels JSON responses with the version's media type when
+// the client asked for it in Accept
+func negotiatedWriter(w http.ResponseWriter, v APIVersion, viaAccept bool) http.ResponseWriter {
+	if !viaAccept {
+		return w
+	}
+	return &mediaTypeWriter{ResponseWriter: w, mediaType: v.MediaType()}
+}
+
+// mediaTypeWriter replaces an application/json Content-Type when the response is written
+type mediaTypeWriter struct {
+	http.ResponseWriter
+	mediaType string
+	written   bool
+}
+
+func (mw *mediaTypeWriter) WriteHeader(code int) {
+	if !mw.written {
+		mw.written = true
+		if mw.Header().Get("Content-Type") == "application/json" {
+			mw.Header().Set("Content-Type", mw.mediaType)
+		}
+	}
+	mw.ResponseWriter.WriteHeader(code)
+}
+
+func (mw *mediaTypeWriter) Write(b []byte) (int, error) {
+	if !mw.written {
+		mw.WriteHeader(http.StatusOK)
+	}
+	return mw.ResponseWriter.Write(b)
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (mw *mediaTypeWriter) Unwrap() http.ResponseWriter {
+	return mw.ResponseWriter
+}
@@ -0,0 +1,129 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/serverlesscloud/bian-go/providers/mock"
+)
+
+func TestVersionNegotiation(t *testing.T) {
+	handler := newTestServer().Handler()
+
+	tests := []struct {
+		name            string
+		path            string
+		headers         map[string]string
+		wantStatus      int
+		wantContentType string
+		wantDeprecated  bool
+		wantLink        string
+	}{
+		{
+			name:            "versioned path",
+			path:            "/v1/accounts/acc-001",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json",
+		},
+		{
+			name:            "vendor media type",
+			path:            "/v1/accounts/acc-001",
+			headers:         map[string]string{"Accept": "application/vnd.bian-go.v1+json"},
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/vnd.bian-go.v1+json",
+		},
+		{
+			name:            "conflicting media type",
+			path:            "/v1/accounts/acc-001",
+			headers:         map[string]string{"Accept": "application/vnd.bian-go.v2+json"},
+			wantStatus:      http.StatusNotAcceptable,
+			wantContentType: "application/json",
+		},
+		{
+			name:            "unversioned path",
+			path:            "/accounts/acc-001",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json",
+			wantDeprecated:  true,
+			wantLink:        `</v1/accounts/acc-001>; rel="successor-version"`,
+		},
+		{
+			name:            "unversioned path with header",
+			path:            "/accounts/acc-001",
+			headers:         map[string]string{"API-Version": "v1"},
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json",
+			wantDeprecated:  true,
+			wantLink:        `</v1/accounts/acc-001>; rel="successor-version"`,
+		},
+		{
+			name:            "unversioned path with unknown version",
+			path:            "/accounts/acc-001",
+			headers:         map[string]string{"API-Version": "v9"},
+			wantStatus:      http.StatusNotAcceptable,
+			wantContentType: "application/json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tt.path, nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (body %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.wantContentType)
+			}
+			if got := rec.Header().Get("Deprecation") != ""; got != tt.wantDeprecated {
+				t.Errorf("Deprecation present = %v, want %v", got, tt.wantDeprecated)
+			}
+			if got := rec.Header().Get("Link"); got != tt.wantLink {
+				t.Errorf("Link = %q, want %q", got, tt.wantLink)
+			}
+			if tt.wantStatus == http.StatusOK && rec.Header().Get("API-Version") != "v1" {
+				t.Errorf("API-Version = %q, want v1", rec.Header().Get("API-Version"))
+			}
+		})
+	}
+}
+
+func TestUnversionedDeprecationHeaders(t *testing.T) {
+	provider := mock.NewProvider()
+	deprecation := time.Date(2027, time.January, 4, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2027, time.July, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		opts            []Option
+		wantDeprecation time.Time
+		wantSunset      string
+	}{
+		{"defaults", nil, DefaultUnversionedDeprecation, ""},
+		{"configured", []Option{WithUnversionedDeprecation(deprecation), WithUnversionedSunset(sunset)}, deprecation, "Mon, 05 Jul 2027 00:00:00 GMT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewServer(provider, provider, provider, provider, tt.opts...).Handler()
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", "/accounts/acc-001", nil))
+
+			if got, want := rec.Header().Get("Deprecation"), "@"+strconv.FormatInt(tt.wantDeprecation.Unix(), 10); got != want {
+				t.Errorf("Deprecation = %q, want %q", got, want)
+			}
+			if got := rec.Header().Get("Sunset"); got != tt.wantSunset {
+				t.Errorf("Sunset = %q, want %q", got, tt.wantSunset)
+			}
+		})
+	}
+}
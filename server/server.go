@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
+	"github.com/serverlesscloud/bian-go/buildinfo"
 	"github.com/serverlesscloud/bian-go/domains"
 	"github.com/serverlesscloud/bian-go/graphql"
 	"github.com/serverlesscloud/bian-go/rest"
@@ -24,22 +26,157 @@ type Server struct {
 // Config holds server configuration
 type Config struct {
 	Port                string
+	Production          bool
 	EnablePlayground    bool
 	AllowedCORSOrigins  []string
 	ReadTimeout         time.Duration
 	WriteTimeout        time.Duration
 	IdleTimeout         time.Duration
+	
+	// ValidateProviderOutput wraps every domain service in a validating
+	// decorator so malformed provider data is rejected before it is served
+	ValidateProviderOutput bool
+	
+	// GraphQL operation limits (0 disables a limit)
+	GraphQLMaxComplexity int
+	GraphQLMaxDepth      int
+	
+	// PersistedQueriesFile points to a {"<sha256>": "<query>"} manifest; when
+	// set, GraphQL only runs the operations it lists
+	PersistedQueriesFile string
+	
+	// UnversionedDeprecation is when the unprefixed REST paths were deprecated;
+	// the zero time keeps rest.DefaultUnversionedDeprecation
+	UnversionedDeprecation time.Time
+	
+	// UnversionedSunset announces when the deprecated unprefixed REST paths
+	// (/accounts/... rather than /v1/accounts/...) will be removed
+	UnversionedSunset time.Time
+	
+	// ResponseEnvelope wraps every REST response in a data/meta/links
+	// envelope; otherwise only clients that ask for it receive one
+	ResponseEnvelope bool
 }
 
 // DefaultConfig returns default server configuration
 func DefaultConfig() *Config {
 	return &Config{
 		Port:               getEnv("PORT", "8080"),
+		Production:         getEnv("ENVIRONMENT", "development") == "production",
 		EnablePlayground:   getEnv("ENABLE_PLAYGROUND", "true") == "true",
 		AllowedCORSOrigins: []string{"*"}, // Allow all origins for development
 		ReadTimeout:        30 * time.Second,
 		WriteTimeout:       30 * time.Second,
 		IdleTimeout:        60 * time.Second,
+		
+		ValidateProviderOutput: getEnv("VALIDATE_PROVIDER_OUTPUT", "true") == "true",
+		
+		GraphQLMaxComplexity: getEnvInt("GRAPHQL_MAX_COMPLEXITY", graphql.DefaultLimits().MaxComplexity),
+		GraphQLMaxDepth:      getEnvInt("GRAPHQL_MAX_DEPTH", graphql.DefaultLimits().MaxDepth),
+		PersistedQueriesFile: os.Getenv("GRAPHQL_PERSISTED_QUERIES"),
+		
+		UnversionedDeprecation: getEnvDate("API_UNVERSIONED_DEPRECATION"),
+		UnversionedSunset:      getEnvDate("API_UNVERSIONED_SUNSET"),
+		ResponseEnvelope:       getEnv("REST_RESPONSE_ENVELOPE", "false") == "true",
+	}
+}
+
+// Option configures optional domain services on the unified server
+type Option func(*options)
+
+// options collects the optional domain services
+type options struct {
+	customerService      domains.CustomerService
+	fxService            domains.FXService
+	eventSource          domains.EventSource
+	accountConsents      domains.AccountConsentRetriever
+	cardService          domains.CardService
+	standingOrderService domains.StandingOrderService
+	directDebitService   domains.DirectDebitService
+	payeeService         domains.PayeeService
+	productService       domains.ProductService
+	enricher             domains.Enricher
+	analyticsService     domains.AnalyticsService
+}
+
+// WithCustomerService enables customer position endpoints
+func WithCustomerService(customerService domains.CustomerService) Option {
+	return func(o *options) {
+		o.customerService = customerService
+	}
+}
+
+// WithFXService enables currency conversion for aggregated balances
+func WithFXService(fxService domains.FXService) Option {
+	return func(o *options) {
+		o.fxService = fxService
+	}
+}
+
+// WithEventSource enables GraphQL subscriptions fed by the given event source
+func WithEventSource(eventSource domains.EventSource) Option {
+	return func(o *options) {
+		o.eventSource = eventSource
+	}
+}
+
+// WithAccountConsentRetriever lists the consents covering an account for
+// Account.consents. By default the ConsentService is used when it implements
+// domains.AccountConsentRetriever.
+func WithAccountConsentRetriever(accountConsents domains.AccountConsentRetriever) Option {
+	return func(o *options) {
+		o.accountConsents = accountConsents
+	}
+}
+
+// WithCardService enables card and credit facility endpoints and Account.card
+func WithCardService(cardService domains.CardService) Option {
+	return func(o *options) {
+		o.cardService = cardService
+	}
+}
+
+// WithStandingOrderService enables standing order endpoints and Account.standingOrders
+func WithStandingOrderService(standingOrderService domains.StandingOrderService) Option {
+	return func(o *options) {
+		o.standingOrderService = standingOrderService
+	}
+}
+
+// WithDirectDebitService enables direct debit endpoints and Account.directDebits
+func WithDirectDebitService(directDebitService domains.DirectDebitService) Option {
+	return func(o *options) {
+		o.directDebitService = directDebitService
+	}
+}
+
+// WithPayeeService enables payee endpoints, the payee queries and the payee mutations
+func WithPayeeService(payeeService domains.PayeeService) Option {
+	return func(o *options) {
+		o.payeeService = payeeService
+	}
+}
+
+// WithProductService enables product catalogue endpoints, the product queries and Account.product
+func WithProductService(productService domains.ProductService) Option {
+	return func(o *options) {
+		o.productService = productService
+	}
+}
+
+// WithEnricher enriches every transaction served with a category, merchant
+// category code and clean merchant name, e.g. enrich.NewDefaultRulesEnricher()
+func WithEnricher(enricher domains.Enricher) Option {
+	return func(o *options) {
+		o.enricher = enricher
+	}
+}
+
+// WithAnalyticsService replaces the default account insights, which aggregate
+// the (enriched) transaction history
+func WithAnalyticsService(analyticsService domains.AnalyticsService) Option {
+	return func(o *options) {
+		o.analyticsService = analyticsService
 	}
 }
 
@@ -50,38 +187,163 @@ func NewServer(
 	balanceService domains.BalanceService,
 	consentService domains.ConsentService,
 	config *Config,
+	opts ...Option,
 ) *Server {
 	if config == nil {
 		config = DefaultConfig()
 	}
 	
-	// Create REST server
-	restServer := rest.NewServer(accountService, transactionService, balanceService, consentService)
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
 	
-	// Create GraphQL server
-	graphqlServer := graphql.NewServer(accountService, transactionService, balanceService, consentService)
+	// Detect the optional capability before decorators hide it
+	if o.accountConsents == nil {
+		o.accountConsents, _ = consentService.(domains.AccountConsentRetriever)
+	}
+	
+	// Enrich transactions before validation so enricher output is checked too
+	if o.enricher != nil {
+		transactionService = domains.NewEnrichingTransactionService(transactionService, o.enricher)
+		if o.eventSource != nil {
+			o.eventSource = domains.NewEnrichingEventSource(o.eventSource, o.enricher)
+		}
+	}
+	
+	// Validate provider output before it reaches either API
+	if config.ValidateProviderOutput {
+		accountService = domains.NewValidatingAccountService(accountService)
+		transactionService = domains.NewValidatingTransactionService(transactionService)
+		balanceService = domains.NewValidatingBalanceService(balanceService)
+		consentService = domains.NewValidatingConsentService(consentService)
+		if o.accountConsents != nil {
+			o.accountConsents = domains.NewValidatingAccountConsentRetriever(o.accountConsents)
+		}
+		if o.eventSource != nil {
+			o.eventSource = domains.NewValidatingEventSource(o.eventSource)
+		}
+		if o.customerService != nil {
+			o.customerService = domains.NewValidatingCustomerService(o.customerService)
+		}
+		if o.fxService != nil {
+			o.fxService = domains.NewValidatingFXService(o.fxService)
+		}
+		if o.cardService != nil {
+			o.cardService = domains.NewValidatingCardService(o.cardService)
+		}
+		if o.standingOrderService != nil {
+			o.standingOrderService = domains.NewValidatingStandingOrderService(o.standingOrderService)
+		}
+		if o.directDebitService != nil {
+			o.directDebitService = domains.NewValidatingDirectDebitService(o.directDebitService)
+		}
+		if o.payeeService != nil {
+			o.payeeService = domains.NewValidatingPayeeService(o.payeeService)
+		}
+		if o.productService != nil {
+			o.productService = domains.NewValidatingProductService(o.productService)
+		}
+	}
+	
+	// Both APIs share one analytics service over the final transaction service
+	if o.analyticsService == nil {
+		o.analyticsService = domains.NewTransactionAnalytics(transactionService)
+	}
+	
+	restOpts := []rest.Option{rest.WithAnalyticsService(o.analyticsService)}
+	if o.customerService != nil {
+		restOpts = append(restOpts, rest.WithCustomerService(o.customerService))
+	}
+	if o.fxService != nil {
+		restOpts = append(restOpts, rest.WithFXService(o.fxService))
+	}
+	if o.cardService != nil {
+		restOpts = append(restOpts, rest.WithCardService(o.cardService))
+	}
+	if o.standingOrderService != nil {
+		restOpts = append(restOpts, rest.WithStandingOrderService(o.standingOrderService))
+	}
+	if o.directDebitService != nil {
+		restOpts = append(restOpts, rest.WithDirectDebitService(o.directDebitService))
+	}
+	if o.payeeService != nil {
+		restOpts = append(restOpts, rest.WithPayeeService(o.payeeService))
+	}
+	if o.productService != nil {
+		restOpts = append(restOpts, rest.WithProductService(o.productService))
+	}
+	if config.ResponseEnvelope {
+		restOpts = append(restOpts, rest.WithEnvelope())
+	}
+	if !config.UnversionedDeprecation.IsZero() {
+		restOpts = append(restOpts, rest.WithUnversionedDeprecation(config.UnversionedDeprecation))
+	}
+	if !config.UnversionedSunset.IsZero() {
+		restOpts = append(restOpts, rest.WithUnversionedSunset(config.UnversionedSunset))
+	}
 	
-	// Create main HTTP mux
-	mux := http.NewServeMux()
+	limits := graphql.DefaultLimits()
+	limits.MaxComplexity = config.GraphQLMaxComplexity
+	limits.MaxDepth = config.GraphQLMaxDepth
+	graphqlOpts := []graphql.Option{
+		graphql.WithLimits(limits),
+		graphql.WithHideInternalErrors(config.Production),
+		graphql.WithAnalyticsService(o.analyticsService),
+	}
 	
-	// Mount REST API endpoints
-	mux.Handle("/accounts/", restServer.Handler())
-	mux.Handle("/transactions/", restServer.Handler())
-	mux.Handle("/consents/", restServer.Handler())
-	mux.Handle("/health", restServer.Handler())
+	if config.PersistedQueriesFile != "" {
+		queries, err := graphql.LoadPersistedQueriesFile(config.PersistedQueriesFile)
+		if err != nil {
+			// Fail closed: an unreadable allowlist admits no operations
+			log.Printf("⚠️  GraphQL persisted queries not loaded, rejecting all operations: %v", err)
+			queries = graphql.PersistedQueries{}
+		}
+		graphqlOpts = append(graphqlOpts, graphql.WithPersistedQueryAllowlist(queries))
+	}
+	if o.eventSource != nil {
+		graphqlOpts = append(graphqlOpts, graphql.WithEventSource(o.eventSource))
+	}
+	if o.accountConsents != nil {
+		graphqlOpts = append(graphqlOpts, graphql.WithAccountConsentRetriever(o.accountConsents))
+	}
+	if o.cardService != nil {
+		graphqlOpts = append(graphqlOpts, graphql.WithCardService(o.cardService))
+	}
+	if o.standingOrderService != nil {
+		graphqlOpts = append(graphqlOpts, graphql.WithStandingOrderService(o.standingOrderService))
+	}
+	if o.directDebitService != nil {
+		graphqlOpts = append(graphqlOpts, graphql.WithDirectDebitService(o.directDebitService))
+	}
+	if o.payeeService != nil {
+		graphqlOpts = append(graphqlOpts, graphql.WithPayeeService(o.payeeService))
+	}
+	if o.productService != nil {
+		graphqlOpts = append(graphqlOpts, graphql.WithProductService(o.productService))
+	}
 	
-	// Mount GraphQL endpoint
-	mux.Handle("/graphql", graphqlServer.Handler())
+	// Create REST server
+	restServer := rest.NewServer(accountService, transactionService, balanceService, consentService, restOpts...)
+	
+	// Create GraphQL server
+	graphqlServer := graphql.NewServer(accountService, transactionService, balanceService, consentService, graphqlOpts...)
+	
+	// Both APIs share one route table and router
+	routes := restServer.Routes()
+	routes = append(routes, rest.Route{Pattern: "/graphql", Handler: graphqlServer.Handler()})
 	
 	// Mount GraphQL Playground (development only)
 	if config.EnablePlayground {
-		mux.Handle("/playground", graphqlServer.PlaygroundHandler())
+		routes = append(routes, rest.Route{Method: "GET", Pattern: "/playground", Handler: graphqlServer.PlaygroundHandler()})
 	}
 	
+	handler := rest.Wrap(rest.NewRouter(routes), config.AllowedCORSOrigins)
+	
 	// Create HTTP server
 	httpServer := &http.Server{
 		Addr:         ":" + config.Port,
-		Handler:      mux,
+		Handler:      handler,
 		ReadTimeout:  config.ReadTimeout,
 		WriteTimeout: config.WriteTimeout,
 		IdleTimeout:  config.IdleTimeout,
@@ -97,11 +359,12 @@ func NewServer(
 func (s *Server) Start() error {
 	// Start server in a goroutine
 	go func() {
-		log.Printf("🚀 Server starting on port %s", s.port)
-		log.Printf("📊 REST API: http://localhost:%s/", s.port)
+		log.Printf("🚀 Server %s starting on port %s", buildinfo.String(), s.port)
+		log.Printf("📊 REST API: http://localhost:%s/v1/", s.port)
 		log.Printf("🎮 GraphQL API: http://localhost:%s/graphql", s.port)
 		log.Printf("🛝 GraphQL Playground: http://localhost:%s/playground", s.port)
 		log.Printf("❤️  Health Check: http://localhost:%s/health", s.port)
+		log.Printf("📖 API Docs: http://localhost:%s/docs", s.port)
 		
 		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			log.Fatalf("Server failed to start: %v", err)
@@ -142,4 +405,21 @@ func getEnv(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+// getEnvInt gets an integer environment variable with fallback to default value
+func getEnvInt(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+// getEnvDate gets a YYYY-MM-DD environment variable as a UTC date, or the zero time when unset or invalid
+func getEnvDate(key string) time.Time {
+	date, err := time.Parse("2006-01-02", os.Getenv(key))
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}